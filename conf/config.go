@@ -1,6 +1,11 @@
 package conf
 
 import (
+	"errors"
+	"fmt"
+	gohttp "net/http"
+	"os"
+	"strings"
 	"time"
 
 	"github.com/caarlos0/env/v6"
@@ -10,7 +15,10 @@ import (
 	"go-store/utils/broker"
 	"go-store/utils/cachestore"
 	"go-store/utils/database"
+	"go-store/utils/health"
 	"go-store/utils/http"
+	"go-store/utils/jwt"
+	"go-store/utils/oidc"
 )
 
 type Configs struct {
@@ -20,6 +28,13 @@ type Configs struct {
 	Grpc         Grpc
 	TokenConf    TokenConf
 	BrokerConfig BrokerConfig
+	CartReminder CartReminder
+	Health       Health
+	Reset        PasswordReset
+	Verification Verification
+	Mfa          Mfa
+	Lockout      Lockout
+	Oidc         Oidc
 }
 
 type Datastore struct {
@@ -42,9 +57,12 @@ type Cachestore struct {
 	PoolTimeout  int    `env:"CACHE_POOLTIMEOUT"`
 }
 
+// Server trusted proxies are the addresses or CIDRs of the reverse proxies whose X-Forwarded-For
+// is believed, the client address is the peer one without them
 type Server struct {
-	Host string `env:"SERVER_HOST"`
-	Port string `env:"SERVER_PORT"`
+	Host           string   `env:"SERVER_HOST"`
+	Port           string   `env:"SERVER_PORT"`
+	TrustedProxies []string `env:"SERVER_TRUSTED_PROXIES" envSeparator:","`
 }
 
 type Grpc struct {
@@ -52,12 +70,17 @@ type Grpc struct {
 	Port string `env:"GRPC_PORT"`
 }
 
+// TokenConf access keys are the kid=path entries of the PEM private keys, all of them verify
+// the access tokens and are published by the jwks endpoint, the SigningKey one signs them,
+// the first one by default
 type TokenConf struct {
-	AccesTokenTimeout   int    `env:"ACCESS_TOKEN_TIMEOUT"`
-	RefreshTokenTimeout int    `env:"REFRESH_TOKEN_TIMEOUT"`
-	AutoLogoffTimeout   int    `env:"AUTO_LOGOFF_TIMEOUT"`
-	AccessSecret        string `env:"ACCESS_SECRET"`
-	RefreshSecret       string `env:"REFRESH_SECRET"`
+	AccesTokenTimeout   int      `env:"ACCESS_TOKEN_TIMEOUT"`
+	RefreshTokenTimeout int      `env:"REFRESH_TOKEN_TIMEOUT"`
+	AutoLogoffTimeout   int      `env:"AUTO_LOGOFF_TIMEOUT"`
+	AccessKeys          []string `env:"JWT_KEYS" envSeparator:","`
+	SigningKey          string   `env:"JWT_SIGNING_KEY"`
+	RefreshSecret       string   `env:"REFRESH_SECRET"`
+	CartSecret          string   `env:"CART_SECRET"`
 }
 
 type BrokerConfig struct {
@@ -68,6 +91,84 @@ type BrokerConfig struct {
 	Partition  int    `env:"BROKER_PARTITION"`
 }
 
+// CartReminder stages are idle minutes after the last cart update, the coupon is sent
+// with the CouponStage reminder, 0 sends no coupons
+type CartReminder struct {
+	Stages        []int   `env:"CART_REMINDER_STAGES" envSeparator:"," envDefault:"60,1440,4320"`
+	Interval      int     `env:"CART_REMINDER_INTERVAL" envDefault:"15"`
+	RestoreURL    string  `env:"CART_RESTORE_URL"`
+	CouponStage   int     `env:"CART_COUPON_STAGE"`
+	CouponPercent float32 `env:"CART_COUPON_PERCENT"`
+	CouponTTL     int     `env:"CART_COUPON_TTL" envDefault:"72"`
+}
+
+// PasswordReset token timeout and rate window are in minutes, the limits count the
+// reset requests of an account and of an IP within the window
+type PasswordReset struct {
+	Secret       string `env:"RESET_SECRET"`
+	TokenTimeout int    `env:"RESET_TOKEN_TIMEOUT" envDefault:"30"`
+	ResetURL     string `env:"RESET_URL"`
+	RateWindow   int    `env:"RESET_RATE_WINDOW" envDefault:"60"`
+	AccountLimit int    `env:"RESET_ACCOUNT_LIMIT" envDefault:"3"`
+	IPLimit      int    `env:"RESET_IP_LIMIT" envDefault:"10"`
+}
+
+// Verification code ttl and rate window are in minutes and the resend cooldown in seconds,
+// the IP limit counts the resend requests of an IP within the window
+type Verification struct {
+	CodeLength     int `env:"VERIFY_CODE_LENGTH" envDefault:"6"`
+	CodeTTL        int `env:"VERIFY_CODE_TTL" envDefault:"15"`
+	MaxAttempts    int `env:"VERIFY_MAX_ATTEMPTS" envDefault:"5"`
+	ResendCooldown int `env:"VERIFY_RESEND_COOLDOWN" envDefault:"60"`
+	RateWindow     int `env:"VERIFY_RATE_WINDOW" envDefault:"60"`
+	IPLimit        int `env:"VERIFY_IP_LIMIT" envDefault:"10"`
+}
+
+// Mfa challenge ttl is in minutes, the enforced roles can't log in without the second factor
+type Mfa struct {
+	Issuer        string   `env:"MFA_ISSUER" envDefault:"go-store"`
+	EnforcedRoles []string `env:"MFA_ENFORCED_ROLES" envSeparator:"," envDefault:"ADMIN"`
+	ChallengeTTL  int      `env:"MFA_CHALLENGE_TTL" envDefault:"5"`
+	MaxAttempts   int      `env:"MFA_MAX_ATTEMPTS" envDefault:"5"`
+	RecoveryCodes int      `env:"MFA_RECOVERY_CODES" envDefault:"10"`
+}
+
+// Lockout window and lock duration are in minutes and the backoff and its max in seconds, the failed logins
+// of a username lock it after MaxAttempts and the ones of an IP block it after IPMaxAttempts
+type Lockout struct {
+	MaxAttempts   int `env:"LOGIN_MAX_ATTEMPTS" envDefault:"5"`
+	IPMaxAttempts int `env:"LOGIN_IP_MAX_ATTEMPTS" envDefault:"20"`
+	Window        int `env:"LOGIN_ATTEMPT_WINDOW" envDefault:"15"`
+	Backoff       int `env:"LOGIN_BACKOFF" envDefault:"1"`
+	MaxBackoff    int `env:"LOGIN_MAX_BACKOFF" envDefault:"60"`
+	LockDuration  int `env:"LOGIN_LOCK_DURATION" envDefault:"15"`
+}
+
+// Oidc providers are the names of the OpenID Connect providers, each one is configured
+// by the OIDC_<NAME>_ variables. The state ttl is in minutes
+type Oidc struct {
+	Providers []string `env:"OIDC_PROVIDERS" envSeparator:","`
+	StateTTL  int      `env:"OIDC_STATE_TTL" envDefault:"10"`
+}
+
+// OidcProvider is the client of an OpenID Connect provider, the endpoints are discovered from the issuer
+type OidcProvider struct {
+	Issuer       string   `env:"ISSUER"`
+	ClientID     string   `env:"CLIENT_ID"`
+	ClientSecret string   `env:"CLIENT_SECRET"`
+	RedirectURL  string   `env:"REDIRECT_URL"`
+	Scopes       []string `env:"SCOPES" envSeparator:"," envDefault:"openid,email,profile"`
+}
+
+// Health intervals are in seconds, readiness is flipped DrainDelay before the servers stop
+// and the calls in flight get ShutdownTimeout to finish
+type Health struct {
+	Interval        int `env:"HEALTH_INTERVAL" envDefault:"10"`
+	Timeout         int `env:"HEALTH_TIMEOUT" envDefault:"3"`
+	DrainDelay      int `env:"SHUTDOWN_DRAIN_DELAY" envDefault:"5"`
+	ShutdownTimeout int `env:"SHUTDOWN_TIMEOUT" envDefault:"20"`
+}
+
 func ConfStruct() (*Configs, error) {
 	var configStructs Configs
 
@@ -86,11 +187,12 @@ func ConfStruct() (*Configs, error) {
 // HTTP returns the configuration required for HTTP package
 func (cfg *Configs) HTTP() (*http.Config, error) {
 	return &http.Config{
-		Host:         cfg.Server.Host,
-		Port:         cfg.Server.Port,
-		ReadTimeout:  time.Second * 5,
-		WriteTimeout: time.Second * 20,
-		DialTimeout:  time.Second * 3,
+		Host:           cfg.Server.Host,
+		Port:           cfg.Server.Port,
+		TrustedProxies: cfg.Server.TrustedProxies,
+		ReadTimeout:    time.Second * 5,
+		WriteTimeout:   time.Second * 20,
+		DialTimeout:    time.Second * 3,
 	}, nil
 }
 
@@ -139,15 +241,51 @@ func (cfg *Configs) Cache() (*cachestore.Config, error) {
 	}, nil
 }
 
-// gRPC returns the configuration required for gRPC package
-func (cfg *Configs) Token() *entity.TokenConf {
+// Token returns the configuration of the tokens, the access keys are read from their PEM files
+func (cfg *Configs) Token() (*entity.TokenConf, error) {
+	accessKeys, err := accessKeySet(cfg.TokenConf.AccessKeys, cfg.TokenConf.SigningKey)
+	if err != nil {
+		return nil, err
+	}
 	return &entity.TokenConf{
 		AccesTokenTimeout:   time.Duration(cfg.TokenConf.AccesTokenTimeout) * time.Minute,
 		RefreshTokenTimeout: time.Duration(cfg.TokenConf.RefreshTokenTimeout) * time.Minute,
 		AutoLogoffTimeout:   time.Duration(cfg.TokenConf.AutoLogoffTimeout) * time.Minute,
-		AccessSecret:        []byte(cfg.TokenConf.AccessSecret),
-		RefreshSecret:       []byte(cfg.TokenConf.RefreshSecret),
+		AccessKeys:          accessKeys,
+		RefreshKeys:         jwt.NewHMACKeySet([]byte(cfg.TokenConf.RefreshSecret)),
+		CartSecret:          []byte(cfg.TokenConf.CartSecret),
+	}, nil
+}
+
+func accessKeySet(entries []string, signingKey string) (*jwt.KeySet, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("JWT_KEYS is empty")
+	}
+	var signing *jwt.Key
+	var verifying []*jwt.Key
+	for _, entry := range entries {
+		kid, path, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || kid == "" || path == "" {
+			return nil, fmt.Errorf("JWT_KEYS entry %q is not kid=path", entry)
+		}
+		pemBytes, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := jwt.ParseKey(kid, pemBytes)
+		if err != nil {
+			return nil, err
+		}
+		if signing == nil && (signingKey == "" || signingKey == kid) {
+			signing = key
+			continue
+		}
+		verifying = append(verifying, key)
 	}
+	if signing == nil {
+		return nil, fmt.Errorf("JWT_SIGNING_KEY %q is not one of JWT_KEYS", signingKey)
+	}
+	return jwt.NewKeySet(signing, verifying...)
 }
 
 // HTTP returns the configuration required for HTTP package
@@ -161,6 +299,119 @@ func (cfg *Configs) BrokerConf() (*broker.BrokerConfig, error) {
 	}, nil
 }
 
+// CartReminderConf returns the configuration of the abandoned cart reminders
+func (cfg *Configs) CartReminderConf() *entity.CartReminderConf {
+	stages := make([]time.Duration, len(cfg.CartReminder.Stages))
+	for idx, minutes := range cfg.CartReminder.Stages {
+		stages[idx] = time.Duration(minutes) * time.Minute
+	}
+	return &entity.CartReminderConf{
+		Stages:        stages,
+		Interval:      time.Duration(cfg.CartReminder.Interval) * time.Minute,
+		RestoreURL:    cfg.CartReminder.RestoreURL,
+		CouponStage:   cfg.CartReminder.CouponStage,
+		CouponPercent: cfg.CartReminder.CouponPercent,
+		CouponTTL:     time.Duration(cfg.CartReminder.CouponTTL) * time.Hour,
+	}
+}
+
+// PasswordResetConf returns the configuration of the password reset tokens
+func (cfg *Configs) PasswordResetConf() *entity.PasswordResetConf {
+	return &entity.PasswordResetConf{
+		Secret:       []byte(cfg.Reset.Secret),
+		TokenTimeout: time.Duration(cfg.Reset.TokenTimeout) * time.Minute,
+		ResetURL:     cfg.Reset.ResetURL,
+		RateWindow:   time.Duration(cfg.Reset.RateWindow) * time.Minute,
+		AccountLimit: cfg.Reset.AccountLimit,
+		IPLimit:      cfg.Reset.IPLimit,
+	}
+}
+
+// VerificationConf returns the configuration of the account verification codes
+func (cfg *Configs) VerificationConf() *entity.VerificationConf {
+	return &entity.VerificationConf{
+		CodeLength:     cfg.Verification.CodeLength,
+		CodeTTL:        time.Duration(cfg.Verification.CodeTTL) * time.Minute,
+		MaxAttempts:    cfg.Verification.MaxAttempts,
+		ResendCooldown: time.Duration(cfg.Verification.ResendCooldown) * time.Second,
+		RateWindow:     time.Duration(cfg.Verification.RateWindow) * time.Minute,
+		IPLimit:        cfg.Verification.IPLimit,
+	}
+}
+
+// MfaConf returns the configuration of the two factor authentication
+func (cfg *Configs) MfaConf() *entity.MfaConf {
+	roles := make([]entity.UserRole, 0, len(cfg.Mfa.EnforcedRoles))
+	for _, role := range cfg.Mfa.EnforcedRoles {
+		if role = strings.ToUpper(strings.TrimSpace(role)); role != "" {
+			roles = append(roles, entity.UserRole(role))
+		}
+	}
+	return &entity.MfaConf{
+		Issuer:        cfg.Mfa.Issuer,
+		EnforcedRoles: roles,
+		ChallengeTTL:  time.Duration(cfg.Mfa.ChallengeTTL) * time.Minute,
+		MaxAttempts:   cfg.Mfa.MaxAttempts,
+		RecoveryCodes: cfg.Mfa.RecoveryCodes,
+	}
+}
+
+// LockoutConf returns the configuration of the failed login limits
+func (cfg *Configs) LockoutConf() *entity.LockoutConf {
+	return &entity.LockoutConf{
+		MaxAttempts:   cfg.Lockout.MaxAttempts,
+		IPMaxAttempts: cfg.Lockout.IPMaxAttempts,
+		Window:        time.Duration(cfg.Lockout.Window) * time.Minute,
+		Backoff:       time.Duration(cfg.Lockout.Backoff) * time.Second,
+		MaxBackoff:    time.Duration(cfg.Lockout.MaxBackoff) * time.Second,
+		LockDuration:  time.Duration(cfg.Lockout.LockDuration) * time.Minute,
+	}
+}
+
+// OidcConf returns the OpenID Connect providers by their lowercase names
+func (cfg *Configs) OidcConf() (*entity.OidcConf, error) {
+	providers := map[string]*oidc.Provider{}
+	for _, name := range cfg.Oidc.Providers {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(name) + "_"
+		var provider OidcProvider
+		if err := env.Parse(&provider, env.Options{Prefix: prefix}); err != nil {
+			return nil, err
+		}
+		if provider.Issuer == "" || provider.ClientID == "" || provider.RedirectURL == "" {
+			return nil, fmt.Errorf("%sISSUER, %sCLIENT_ID and %sREDIRECT_URL are required", prefix, prefix, prefix)
+		}
+		name = strings.ToLower(name)
+		providers[name] = &oidc.Provider{
+			Name:         name,
+			Issuer:       provider.Issuer,
+			ClientID:     provider.ClientID,
+			ClientSecret: provider.ClientSecret,
+			RedirectURL:  provider.RedirectURL,
+			Scopes:       provider.Scopes,
+			Client:       &gohttp.Client{Timeout: 10 * time.Second},
+			ClockSkew:    time.Minute,
+		}
+	}
+	return &entity.OidcConf{
+		Providers: providers,
+		StateTTL:  time.Duration(cfg.Oidc.StateTTL) * time.Minute,
+	}, nil
+}
+
+// HealthConf returns the configuration of the health checks and the shutdown
+func (cfg *Configs) HealthConf() *health.Config {
+	return &health.Config{
+		Interval:        time.Duration(cfg.Health.Interval) * time.Second,
+		Timeout:         time.Duration(cfg.Health.Timeout) * time.Second,
+		DrainDelay:      time.Duration(cfg.Health.DrainDelay) * time.Second,
+		ShutdownTimeout: time.Duration(cfg.Health.ShutdownTimeout) * time.Second,
+	}
+}
+
 // NewService returns an instance of Config with all the required dependencies initialized
 func NewService() (*Configs, error) {
 	confStr, err := ConfStruct()
@@ -0,0 +1,195 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/dto"
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	httphelper "go-store/utils/http"
+)
+
+// AddressHandler  represent the httphandler for the user address book
+type AddressHandler struct {
+	addressUC entity.AddressUsecase
+	srvLog    *logrus.Entry
+}
+
+// NewAddressHandler will initialize the address/ resources endpoint
+func NewAddressHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entity.Usecases, srvLog *logrus.Entry) {
+	ah := &AddressHandler{
+		addressUC: uc.AddressUsecase,
+		srvLog:    srvLog,
+	}
+	h := handler.Group("/address")
+	{
+		h.POST("/list", mdw, ah.getAddresses)
+		h.POST("/:addressId", mdw, ah.getAddress)
+		h.POST("", mdw, ah.createAddress)
+		h.PUT("/:addressId", mdw, ah.updateAddress)
+		h.DELETE("/:addressId", mdw, ah.deleteAddress)
+	}
+}
+
+func (ah *AddressHandler) getAddresses(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "AddressHandler.getAddresses"})
+	userCtx, exists := c.Get("user")
+	// This shouldn't happen, as our middleware ought to throw an error.
+	if !exists {
+		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+		httphelper.SendResponse(c, nil, errorStatus.ErrInternalServer)
+		return
+	}
+	user := userCtx.(*entity.Users)
+
+	result, err := ah.addressUC.GetAddresses(c, user)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.addressUC.GetAddresses")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
+func (ah *AddressHandler) getAddress(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "AddressHandler.getAddress"})
+	userCtx, exists := c.Get("user")
+	// This shouldn't happen, as our middleware ought to throw an error.
+	if !exists {
+		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+		httphelper.SendResponse(c, nil, errorStatus.ErrInternalServer)
+		return
+	}
+	user := userCtx.(*entity.Users)
+
+	addressId, err := strconv.Atoi(c.Param("addressId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.addressId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	result, err := ah.addressUC.GetAddress(c, user, addressId)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.addressUC.GetAddress")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
+func (ah *AddressHandler) createAddress(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "AddressHandler.createAddress"})
+	userCtx, exists := c.Get("user")
+	// This shouldn't happen, as our middleware ought to throw an error.
+	if !exists {
+		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+		httphelper.SendResponse(c, nil, errorStatus.ErrInternalServer)
+		return
+	}
+	user := userCtx.(*entity.Users)
+
+	var addressReq dto.AddressRequest
+	if err := httphelper.BindJSON(c, &addressReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	address := mapAddressRequest(&addressReq)
+	err := ah.addressUC.CreateAddress(c, user, address)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.addressUC.CreateAddress")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, address.Id, nil)
+}
+
+func (ah *AddressHandler) updateAddress(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "AddressHandler.updateAddress"})
+	userCtx, exists := c.Get("user")
+	// This shouldn't happen, as our middleware ought to throw an error.
+	if !exists {
+		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+		httphelper.SendResponse(c, nil, errorStatus.ErrInternalServer)
+		return
+	}
+	user := userCtx.(*entity.Users)
+
+	addressId, err := strconv.Atoi(c.Param("addressId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.addressId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	var addressReq dto.AddressRequest
+	if err := httphelper.BindJSON(c, &addressReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	address := mapAddressRequest(&addressReq)
+	address.Id = addressId
+	err = ah.addressUC.UpdateAddress(c, user, address)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.addressUC.UpdateAddress")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, httphelper.Success, nil)
+}
+
+func (ah *AddressHandler) deleteAddress(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "AddressHandler.deleteAddress"})
+	userCtx, exists := c.Get("user")
+	// This shouldn't happen, as our middleware ought to throw an error.
+	if !exists {
+		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+		httphelper.SendResponse(c, nil, errorStatus.ErrInternalServer)
+		return
+	}
+	user := userCtx.(*entity.Users)
+
+	addressId, err := strconv.Atoi(c.Param("addressId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.addressId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	err = ah.addressUC.DeleteAddress(c, user, addressId)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.addressUC.DeleteAddress")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, httphelper.Success, nil)
+}
+
+func mapAddressRequest(r *dto.AddressRequest) *entity.Address {
+	return &entity.Address{
+		Recipient:       r.Recipient,
+		Phone:           r.Phone,
+		Country:         r.Country,
+		RegionId:        r.RegionId,
+		City:            r.City,
+		Street:          r.Street,
+		Postcode:        r.Postcode,
+		Latitude:        r.Latitude,
+		Longitude:       r.Longitude,
+		DefaultShipping: r.DefaultShipping,
+		DefaultBilling:  r.DefaultBilling,
+	}
+}
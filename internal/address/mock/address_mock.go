@@ -0,0 +1,260 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/entity/address.go
+
+// Package addressMock is a generated GoMock package.
+package addressMock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entity "go-store/internal/entity"
+	reflect "reflect"
+)
+
+// MockAddressUsecase is a mock of AddressUsecase interface
+type MockAddressUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressUsecaseMockRecorder
+}
+
+// MockAddressUsecaseMockRecorder is the mock recorder for MockAddressUsecase
+type MockAddressUsecaseMockRecorder struct {
+	mock *MockAddressUsecase
+}
+
+// NewMockAddressUsecase creates a new mock instance
+func NewMockAddressUsecase(ctrl *gomock.Controller) *MockAddressUsecase {
+	mock := &MockAddressUsecase{ctrl: ctrl}
+	mock.recorder = &MockAddressUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAddressUsecase) EXPECT() *MockAddressUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetAddresses mocks base method
+func (m *MockAddressUsecase) GetAddresses(ctx context.Context, user *entity.Users) ([]*entity.AddressJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddresses", ctx, user)
+	ret0, _ := ret[0].([]*entity.AddressJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddresses indicates an expected call of GetAddresses
+func (mr *MockAddressUsecaseMockRecorder) GetAddresses(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddresses", reflect.TypeOf((*MockAddressUsecase)(nil).GetAddresses), ctx, user)
+}
+
+// GetAddress mocks base method
+func (m *MockAddressUsecase) GetAddress(ctx context.Context, user *entity.Users, addressId int) (*entity.AddressJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress", ctx, user, addressId)
+	ret0, _ := ret[0].(*entity.AddressJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddress indicates an expected call of GetAddress
+func (mr *MockAddressUsecaseMockRecorder) GetAddress(ctx, user, addressId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockAddressUsecase)(nil).GetAddress), ctx, user, addressId)
+}
+
+// CreateAddress mocks base method
+func (m *MockAddressUsecase) CreateAddress(ctx context.Context, user *entity.Users, address *entity.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAddress", ctx, user, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAddress indicates an expected call of CreateAddress
+func (mr *MockAddressUsecaseMockRecorder) CreateAddress(ctx, user, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAddress", reflect.TypeOf((*MockAddressUsecase)(nil).CreateAddress), ctx, user, address)
+}
+
+// UpdateAddress mocks base method
+func (m *MockAddressUsecase) UpdateAddress(ctx context.Context, user *entity.Users, address *entity.Address) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAddress", ctx, user, address)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAddress indicates an expected call of UpdateAddress
+func (mr *MockAddressUsecaseMockRecorder) UpdateAddress(ctx, user, address interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAddress", reflect.TypeOf((*MockAddressUsecase)(nil).UpdateAddress), ctx, user, address)
+}
+
+// DeleteAddress mocks base method
+func (m *MockAddressUsecase) DeleteAddress(ctx context.Context, user *entity.Users, addressId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", ctx, user, addressId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress
+func (mr *MockAddressUsecaseMockRecorder) DeleteAddress(ctx, user, addressId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockAddressUsecase)(nil).DeleteAddress), ctx, user, addressId)
+}
+
+// MockAddressRepository is a mock of AddressRepository interface
+type MockAddressRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAddressRepositoryMockRecorder
+}
+
+// MockAddressRepositoryMockRecorder is the mock recorder for MockAddressRepository
+type MockAddressRepositoryMockRecorder struct {
+	mock *MockAddressRepository
+}
+
+// NewMockAddressRepository creates a new mock instance
+func NewMockAddressRepository(ctrl *gomock.Controller) *MockAddressRepository {
+	mock := &MockAddressRepository{ctrl: ctrl}
+	mock.recorder = &MockAddressRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAddressRepository) EXPECT() *MockAddressRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAddresses mocks base method
+func (m *MockAddressRepository) GetAddresses(ctx context.Context, userId int) ([]*entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddresses", ctx, userId)
+	ret0, _ := ret[0].([]*entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddresses indicates an expected call of GetAddresses
+func (mr *MockAddressRepositoryMockRecorder) GetAddresses(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddresses", reflect.TypeOf((*MockAddressRepository)(nil).GetAddresses), ctx, userId)
+}
+
+// GetAddress mocks base method
+func (m *MockAddressRepository) GetAddress(ctx context.Context, userId, addressId int) (*entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddress", ctx, userId, addressId)
+	ret0, _ := ret[0].(*entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddress indicates an expected call of GetAddress
+func (mr *MockAddressRepositoryMockRecorder) GetAddress(ctx, userId, addressId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddress", reflect.TypeOf((*MockAddressRepository)(nil).GetAddress), ctx, userId, addressId)
+}
+
+// GetDefaultShipping mocks base method
+func (m *MockAddressRepository) GetDefaultShipping(ctx context.Context, userId int) (*entity.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDefaultShipping", ctx, userId)
+	ret0, _ := ret[0].(*entity.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDefaultShipping indicates an expected call of GetDefaultShipping
+func (mr *MockAddressRepositoryMockRecorder) GetDefaultShipping(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultShipping", reflect.TypeOf((*MockAddressRepository)(nil).GetDefaultShipping), ctx, userId)
+}
+
+// CreateAddress mocks base method
+func (m *MockAddressRepository) CreateAddress(ctx context.Context, address *entity.Address, txId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAddress", ctx, address, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAddress indicates an expected call of CreateAddress
+func (mr *MockAddressRepositoryMockRecorder) CreateAddress(ctx, address, txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAddress", reflect.TypeOf((*MockAddressRepository)(nil).CreateAddress), ctx, address, txId)
+}
+
+// UpdateAddress mocks base method
+func (m *MockAddressRepository) UpdateAddress(ctx context.Context, address *entity.Address, txId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAddress", ctx, address, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAddress indicates an expected call of UpdateAddress
+func (mr *MockAddressRepositoryMockRecorder) UpdateAddress(ctx, address, txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAddress", reflect.TypeOf((*MockAddressRepository)(nil).UpdateAddress), ctx, address, txId)
+}
+
+// DeleteAddress mocks base method
+func (m *MockAddressRepository) DeleteAddress(ctx context.Context, userId, addressId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAddress", ctx, userId, addressId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAddress indicates an expected call of DeleteAddress
+func (mr *MockAddressRepositoryMockRecorder) DeleteAddress(ctx, userId, addressId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAddress", reflect.TypeOf((*MockAddressRepository)(nil).DeleteAddress), ctx, userId, addressId)
+}
+
+// ResetDefaults mocks base method
+func (m *MockAddressRepository) ResetDefaults(ctx context.Context, userId int, shipping, billing bool, txId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetDefaults", ctx, userId, shipping, billing, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetDefaults indicates an expected call of ResetDefaults
+func (mr *MockAddressRepositoryMockRecorder) ResetDefaults(ctx, userId, shipping, billing, txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetDefaults", reflect.TypeOf((*MockAddressRepository)(nil).ResetDefaults), ctx, userId, shipping, billing, txId)
+}
+
+// NewTxId mocks base method
+func (m *MockAddressRepository) NewTxId(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTxId", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTxId indicates an expected call of NewTxId
+func (mr *MockAddressRepositoryMockRecorder) NewTxId(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTxId", reflect.TypeOf((*MockAddressRepository)(nil).NewTxId), ctx)
+}
+
+// TxEnd mocks base method
+func (m *MockAddressRepository) TxEnd(ctx context.Context, txId int, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxEnd", ctx, txId, err)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxEnd indicates an expected call of TxEnd
+func (mr *MockAddressRepositoryMockRecorder) TxEnd(ctx, txId, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxEnd", reflect.TypeOf((*MockAddressRepository)(nil).TxEnd), ctx, txId, err)
+}
@@ -0,0 +1,302 @@
+package pgsql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/utils/database"
+	errorStatus "go-store/utils/errors"
+)
+
+type PgxAccess struct {
+	*database.PgxAccess
+}
+
+// NewPgxAddressRepository will create an object that represent the entity.AddressRepository interface
+func NewPgxAddressRepository(pgx *database.PgxAccess) entity.AddressRepository {
+	return &PgxAccess{pgx}
+}
+
+func (d *PgxAccess) NewTxId(ctx context.Context) (int, error) {
+	id, err := d.PgTxBegin(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+func (d *PgxAccess) TxEnd(ctx context.Context, txId int, err error) error {
+	return d.PgTxEnd(ctx, txId, err)
+}
+
+func (d *PgxAccess) selectAddress() squirrel.SelectBuilder {
+	return d.Builder.
+		Select("id",
+			"user_id",
+			"recipient",
+			"phone",
+			"country",
+			"COALESCE(region_id, 0)",
+			"city",
+			"street",
+			"postcode",
+			"latitude",
+			"longitude",
+			"default_shipping",
+			"default_billing",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version").
+		From("address").
+		Where("state = 'enabled'")
+}
+
+func scanAddress(row pgx.Row, a *entity.Address) error {
+	return row.Scan(&a.Id, &a.UserId, &a.Recipient, &a.Phone, &a.Country, &a.RegionId, &a.City, &a.Street, &a.Postcode,
+		&a.Latitude, &a.Longitude, &a.DefaultShipping, &a.DefaultBilling, &a.CreateTs, &a.UpdateTs, &a.State, &a.Version)
+}
+
+func (d *PgxAccess) GetAddresses(ctx context.Context, userId int) (result []*entity.Address, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetAddresses"})
+	query, args, err := d.selectAddress().
+		Where("user_id = ?", userId).
+		OrderBy("default_shipping DESC", "id").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - GetAddresses - r.Builder - query")
+		return nil, err
+	}
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		err = fmt.Errorf("pg.GetAddresses: %w", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.Address{}
+		if err := scanAddress(rows, tmp); err != nil {
+			dbLog.WithFields(log.Fields{"addressId": tmp.Id}).Warning(err)
+			return nil, err
+		}
+		result = append(result, tmp)
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) GetAddress(ctx context.Context, userId int, addressId int) (result *entity.Address, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetAddress"})
+	query, args, err := d.selectAddress().
+		Where("id = ? AND user_id = ?", addressId, userId).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - GetAddress - r.Builder - query")
+		return nil, err
+	}
+	result = &entity.Address{}
+	err = scanAddress(d.Pool.QueryRow(ctx, query, args...), result)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) GetDefaultShipping(ctx context.Context, userId int) (result *entity.Address, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetDefaultShipping"})
+	query, args, err := d.selectAddress().
+		Where("user_id = ? AND default_shipping", userId).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - GetDefaultShipping - r.Builder - query")
+		return nil, err
+	}
+	result = &entity.Address{}
+	err = scanAddress(d.Pool.QueryRow(ctx, query, args...), result)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) CreateAddress(ctx context.Context, address *entity.Address, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateAddress"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - CreateAddress - d.GetTxById")
+		return err
+	}
+
+	query, args, err := d.Builder.
+		Insert("address").
+		Columns("user_id",
+			"recipient",
+			"phone",
+			"country",
+			"region_id",
+			"city",
+			"street",
+			"postcode",
+			"latitude",
+			"longitude",
+			"default_shipping",
+			"default_billing",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version").
+		Values(address.UserId,
+			address.Recipient,
+			address.Phone,
+			address.Country,
+			nullableId(address.RegionId),
+			address.City,
+			address.Street,
+			address.Postcode,
+			address.Latitude,
+			address.Longitude,
+			address.DefaultShipping,
+			address.DefaultBilling,
+			address.CreateTs,
+			address.UpdateTs,
+			address.State,
+			address.Version).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - CreateAddress - r.Builder - query")
+		return err
+	}
+	err = tx.QueryRow(ctx, query, args...).Scan(&address.Id)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) UpdateAddress(ctx context.Context, address *entity.Address, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateAddress"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - UpdateAddress - d.GetTxById")
+		return err
+	}
+
+	query, args, err := d.Builder.
+		Update("address").
+		SetMap(map[string]interface{}{
+			"recipient":        address.Recipient,
+			"phone":            address.Phone,
+			"country":          address.Country,
+			"region_id":        nullableId(address.RegionId),
+			"city":             address.City,
+			"street":           address.Street,
+			"postcode":         address.Postcode,
+			"latitude":         address.Latitude,
+			"longitude":        address.Longitude,
+			"default_shipping": address.DefaultShipping,
+			"default_billing":  address.DefaultBilling,
+			"update_ts":        address.UpdateTs}).
+		Set("version", squirrel.Expr("version+1")).
+		Where("id = ? AND user_id = ? AND state = 'enabled'", address.Id, address.UserId).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - UpdateAddress - r.Builder - query")
+		return err
+	}
+	err = tx.QueryRow(ctx, query, args...).Scan(&address.Id)
+	if err == pgx.ErrNoRows {
+		return errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) DeleteAddress(ctx context.Context, userId int, addressId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.DeleteAddress"})
+	query, args, err := d.Builder.
+		Update("address").
+		Set("state", entity.Deleted).
+		Set("default_shipping", false).
+		Set("default_billing", false).
+		Set("update_ts", entity.NowUTC()).
+		Set("version", squirrel.Expr("version+1")).
+		Where("id = ? AND user_id = ? AND state = 'enabled'", addressId, userId).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - DeleteAddress - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&addressId)
+	if err == pgx.ErrNoRows {
+		return errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+// ResetDefaults drops the default shipping and/or billing flag from all user addresses
+func (d *PgxAccess) ResetDefaults(ctx context.Context, userId int, shipping bool, billing bool, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.ResetDefaults"})
+	if !shipping && !billing {
+		return nil
+	}
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - ResetDefaults - d.GetTxById")
+		return err
+	}
+
+	baseQuery := d.Builder.
+		Update("address").
+		Where("user_id = ?", userId)
+	if shipping {
+		baseQuery = baseQuery.Set("default_shipping", false)
+	}
+	if billing {
+		baseQuery = baseQuery.Set("default_billing", false)
+	}
+	query, args, err := baseQuery.ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("AddressRepository - ResetDefaults - r.Builder - query")
+		return err
+	}
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func nullableId(id int) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
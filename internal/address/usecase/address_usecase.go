@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+// AddressUsecase will initiate usecase of entity.AddressRepository interface
+type AddressUsecase struct {
+	addressRepo entity.AddressRepository
+}
+
+// NewAddressUsecase will create new an AddressUsecase object representation of entity.AddressUsecase interface
+func NewAddressUsecase(a entity.AddressRepository) entity.AddressUsecase {
+	return &AddressUsecase{
+		addressRepo: a,
+	}
+}
+
+func (a *AddressUsecase) GetAddresses(ctx context.Context, user *entity.Users) (result []*entity.AddressJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "AddressUsecase.GetAddresses"})
+
+	addresses, err := a.addressRepo.GetAddresses(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.GetAddresses")
+		return nil, errorStatus.ErrInternalServer
+	}
+
+	result = make([]*entity.AddressJson, len(addresses))
+	for idx, address := range addresses {
+		result[idx] = mapAddressToJSON(address)
+	}
+	return result, nil
+}
+
+func (a *AddressUsecase) GetAddress(ctx context.Context, user *entity.Users, addressId int) (result *entity.AddressJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "AddressUsecase.GetAddress"})
+
+	address, err := a.addressRepo.GetAddress(ctx, user.Id, addressId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.GetAddress")
+		return nil, err
+	}
+	return mapAddressToJSON(address), nil
+}
+
+func mapAddressToJSON(s *entity.Address) *entity.AddressJson {
+	return &entity.AddressJson{
+		Id:              s.Id,
+		Recipient:       s.Recipient,
+		Phone:           s.Phone,
+		Country:         s.Country,
+		RegionId:        s.RegionId,
+		City:            s.City,
+		Street:          s.Street,
+		Postcode:        s.Postcode,
+		Latitude:        s.Latitude,
+		Longitude:       s.Longitude,
+		DefaultShipping: s.DefaultShipping,
+		DefaultBilling:  s.DefaultBilling,
+	}
+}
+
+func (a *AddressUsecase) CreateAddress(ctx context.Context, user *entity.Users, address *entity.Address) error {
+	ctLog := log.WithFields(log.Fields{"func": "AddressUsecase.CreateAddress"})
+
+	if !validAddress(address) {
+		return errorStatus.ErrBadReq
+	}
+	address.UserId = user.Id
+	address.SetDefaults()
+
+	// the first address of the book becomes the default one
+	existing, err := a.addressRepo.GetAddresses(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.GetAddresses")
+		return errorStatus.ErrInternalServer
+	}
+	if len(existing) == 0 {
+		address.DefaultShipping = true
+		address.DefaultBilling = true
+	}
+
+	txId, err := a.addressRepo.NewTxId(ctx)
+	if err != nil {
+		ctLog.WithError(err).Error("AddressUsecase - error processing a.addressRepo.NewTxId")
+		return err
+	}
+	defer func() {
+		err = a.addressRepo.TxEnd(ctx, txId, err)
+		if err != nil {
+			ctLog.WithError(err).Error("AddressUsecase - error processing a.addressRepo.TxEnd")
+		}
+	}()
+
+	err = a.addressRepo.ResetDefaults(ctx, user.Id, address.DefaultShipping, address.DefaultBilling, txId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.ResetDefaults")
+		return err
+	}
+
+	err = a.addressRepo.CreateAddress(ctx, address, txId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.CreateAddress")
+		return err
+	}
+	return nil
+}
+
+func (a *AddressUsecase) UpdateAddress(ctx context.Context, user *entity.Users, address *entity.Address) error {
+	ctLog := log.WithFields(log.Fields{"func": "AddressUsecase.UpdateAddress"})
+
+	if !validAddress(address) {
+		return errorStatus.ErrBadReq
+	}
+	address.UserId = user.Id
+	address.UpdateTs = entity.NowUTC()
+
+	txId, err := a.addressRepo.NewTxId(ctx)
+	if err != nil {
+		ctLog.WithError(err).Error("AddressUsecase - error processing a.addressRepo.NewTxId")
+		return err
+	}
+	defer func() {
+		err = a.addressRepo.TxEnd(ctx, txId, err)
+		if err != nil {
+			ctLog.WithError(err).Error("AddressUsecase - error processing a.addressRepo.TxEnd")
+		}
+	}()
+
+	err = a.addressRepo.ResetDefaults(ctx, user.Id, address.DefaultShipping, address.DefaultBilling, txId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.ResetDefaults")
+		return err
+	}
+
+	err = a.addressRepo.UpdateAddress(ctx, address, txId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.UpdateAddress")
+		return err
+	}
+	return nil
+}
+
+func (a *AddressUsecase) DeleteAddress(ctx context.Context, user *entity.Users, addressId int) error {
+	ctLog := log.WithFields(log.Fields{"func": "AddressUsecase.DeleteAddress"})
+
+	err := a.addressRepo.DeleteAddress(ctx, user.Id, addressId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.addressRepo.DeleteAddress")
+		return err
+	}
+	return nil
+}
+
+func validAddress(address *entity.Address) bool {
+	return address.Recipient != "" && address.Phone != "" && address.Country != "" &&
+		address.City != "" && address.Street != "" &&
+		address.Latitude >= -90 && address.Latitude <= 90 &&
+		address.Longitude >= -180 && address.Longitude <= 180
+}
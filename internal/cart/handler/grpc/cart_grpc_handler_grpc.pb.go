@@ -0,0 +1,299 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: internal/cart/handler/grpc/cart_grpc_handler.proto
+
+package grpc_server
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CartHandlerClient is the client API for CartHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CartHandlerClient interface {
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartSummary, error)
+	AddCartLine(ctx context.Context, in *CartLineRequest, opts ...grpc.CallOption) (*CartLineResponse, error)
+	UpdateCartLine(ctx context.Context, in *CartLineRequest, opts ...grpc.CallOption) (*CartLineResponse, error)
+	RemoveCartLine(ctx context.Context, in *RemoveCartLineRequest, opts ...grpc.CallOption) (*RemoveCartLineResponse, error)
+}
+
+type cartHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCartHandlerClient(cc grpc.ClientConnInterface) CartHandlerClient {
+	return &cartHandlerClient{cc}
+}
+
+func (c *cartHandlerClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartSummary, error) {
+	out := new(CartSummary)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CartHandler/GetCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartHandlerClient) AddCartLine(ctx context.Context, in *CartLineRequest, opts ...grpc.CallOption) (*CartLineResponse, error) {
+	out := new(CartLineResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CartHandler/AddCartLine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartHandlerClient) UpdateCartLine(ctx context.Context, in *CartLineRequest, opts ...grpc.CallOption) (*CartLineResponse, error) {
+	out := new(CartLineResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CartHandler/UpdateCartLine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartHandlerClient) RemoveCartLine(ctx context.Context, in *RemoveCartLineRequest, opts ...grpc.CallOption) (*RemoveCartLineResponse, error) {
+	out := new(RemoveCartLineResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CartHandler/RemoveCartLine", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CartHandlerServer is the server API for CartHandler service.
+// All implementations must embed UnimplementedCartHandlerServer
+// for forward compatibility
+type CartHandlerServer interface {
+	GetCart(context.Context, *GetCartRequest) (*CartSummary, error)
+	AddCartLine(context.Context, *CartLineRequest) (*CartLineResponse, error)
+	UpdateCartLine(context.Context, *CartLineRequest) (*CartLineResponse, error)
+	RemoveCartLine(context.Context, *RemoveCartLineRequest) (*RemoveCartLineResponse, error)
+	mustEmbedUnimplementedCartHandlerServer()
+}
+
+// UnimplementedCartHandlerServer must be embedded to have forward compatible implementations.
+type UnimplementedCartHandlerServer struct {
+}
+
+func (UnimplementedCartHandlerServer) GetCart(context.Context, *GetCartRequest) (*CartSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+func (UnimplementedCartHandlerServer) AddCartLine(context.Context, *CartLineRequest) (*CartLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCartLine not implemented")
+}
+func (UnimplementedCartHandlerServer) UpdateCartLine(context.Context, *CartLineRequest) (*CartLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCartLine not implemented")
+}
+func (UnimplementedCartHandlerServer) RemoveCartLine(context.Context, *RemoveCartLineRequest) (*RemoveCartLineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCartLine not implemented")
+}
+func (UnimplementedCartHandlerServer) mustEmbedUnimplementedCartHandlerServer() {}
+
+// UnsafeCartHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CartHandlerServer will
+// result in compilation errors.
+type UnsafeCartHandlerServer interface {
+	mustEmbedUnimplementedCartHandlerServer()
+}
+
+func RegisterCartHandlerServer(s grpc.ServiceRegistrar, srv CartHandlerServer) {
+	s.RegisterService(&CartHandler_ServiceDesc, srv)
+}
+
+func _CartHandler_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartHandlerServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CartHandler/GetCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartHandlerServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartHandler_AddCartLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartHandlerServer).AddCartLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CartHandler/AddCartLine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartHandlerServer).AddCartLine(ctx, req.(*CartLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartHandler_UpdateCartLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CartLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartHandlerServer).UpdateCartLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CartHandler/UpdateCartLine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartHandlerServer).UpdateCartLine(ctx, req.(*CartLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartHandler_RemoveCartLine_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartLineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartHandlerServer).RemoveCartLine(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CartHandler/RemoveCartLine",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartHandlerServer).RemoveCartLine(ctx, req.(*RemoveCartLineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CartHandler_ServiceDesc is the grpc.ServiceDesc for CartHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CartHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc_handler.CartHandler",
+	HandlerType: (*CartHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCart",
+			Handler:    _CartHandler_GetCart_Handler,
+		},
+		{
+			MethodName: "AddCartLine",
+			Handler:    _CartHandler_AddCartLine_Handler,
+		},
+		{
+			MethodName: "UpdateCartLine",
+			Handler:    _CartHandler_UpdateCartLine_Handler,
+		},
+		{
+			MethodName: "RemoveCartLine",
+			Handler:    _CartHandler_RemoveCartLine_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/cart/handler/grpc/cart_grpc_handler.proto",
+}
+
+// CheckoutClient is the client API for Checkout service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CheckoutClient interface {
+	PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error)
+}
+
+type checkoutClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCheckoutClient(cc grpc.ClientConnInterface) CheckoutClient {
+	return &checkoutClient{cc}
+}
+
+func (c *checkoutClient) PlaceOrder(ctx context.Context, in *PlaceOrderRequest, opts ...grpc.CallOption) (*PlaceOrderResponse, error) {
+	out := new(PlaceOrderResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.Checkout/PlaceOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CheckoutServer is the server API for Checkout service.
+// All implementations must embed UnimplementedCheckoutServer
+// for forward compatibility
+type CheckoutServer interface {
+	PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error)
+	mustEmbedUnimplementedCheckoutServer()
+}
+
+// UnimplementedCheckoutServer must be embedded to have forward compatible implementations.
+type UnimplementedCheckoutServer struct {
+}
+
+func (UnimplementedCheckoutServer) PlaceOrder(context.Context, *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceOrder not implemented")
+}
+func (UnimplementedCheckoutServer) mustEmbedUnimplementedCheckoutServer() {}
+
+// UnsafeCheckoutServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CheckoutServer will
+// result in compilation errors.
+type UnsafeCheckoutServer interface {
+	mustEmbedUnimplementedCheckoutServer()
+}
+
+func RegisterCheckoutServer(s grpc.ServiceRegistrar, srv CheckoutServer) {
+	s.RegisterService(&Checkout_ServiceDesc, srv)
+}
+
+func _Checkout_PlaceOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaceOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CheckoutServer).PlaceOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.Checkout/PlaceOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CheckoutServer).PlaceOrder(ctx, req.(*PlaceOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Checkout_ServiceDesc is the grpc.ServiceDesc for Checkout service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Checkout_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc_handler.Checkout",
+	HandlerType: (*CheckoutServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PlaceOrder",
+			Handler:    _Checkout_PlaceOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/cart/handler/grpc/cart_grpc_handler.proto",
+}
@@ -0,0 +1,274 @@
+package grpc_server
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	grpc "google.golang.org/grpc"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+	"go-store/utils/validate"
+)
+
+const (
+	cartTokenKey = "x-cart-token"
+)
+
+// AuthPermissions open the cart and the checkout to the guests, their carts are found by the cart token
+var AuthPermissions = map[string]entity.Permission{
+	"/grpc_handler.CartHandler/GetCart":        entity.PermGuest,
+	"/grpc_handler.CartHandler/AddCartLine":    entity.PermGuest,
+	"/grpc_handler.CartHandler/UpdateCartLine": entity.PermGuest,
+	"/grpc_handler.CartHandler/RemoveCartLine": entity.PermGuest,
+	"/grpc_handler.Checkout/PlaceOrder":        entity.PermGuest,
+}
+
+type grpcServer struct {
+	usecases *entity.Usecases
+	UnimplementedCartHandlerServer
+	UnimplementedCheckoutServer
+}
+
+// RegisterServices registers the cart and checkout services on the shared gRPC server
+func RegisterServices(gsrv *grpc.Server, us *entity.Usecases) {
+	srv := &grpcServer{
+		usecases: us,
+	}
+	RegisterCartHandlerServer(gsrv, srv)
+	RegisterCheckoutServer(gsrv, srv)
+}
+
+// RegisterGateway routes the REST calls of the cart and checkout services through the gateway mux to the gRPC server
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	if err := RegisterCartHandlerHandler(ctx, mux, conn); err != nil {
+		return err
+	}
+	return RegisterCheckoutHandler(ctx, mux, conn)
+}
+
+func (g *grpcServer) GetCart(ctx context.Context, req *GetCartRequest) (*CartSummary, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CartHandler.GetCart"})
+
+	user, err := g.cartUser(ctx, false)
+	if err != nil {
+		glog.WithError(err).Warning("g.cartUser")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	summary, err := g.usecases.CartUsecase.GetCart(ctx, user.Users, int(req.Limit), int(req.Offset))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CartUsecase.GetCart")
+		return nil, grpchelper.StatusError(err)
+	}
+	return mapSummary(summary), nil
+}
+
+func (g *grpcServer) AddCartLine(ctx context.Context, req *CartLineRequest) (*CartLineResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CartHandler.AddCartLine"})
+
+	user, err := g.cartUser(ctx, true)
+	if err != nil {
+		glog.WithError(err).Warning("g.cartUser")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	cart := &entity.Cart{
+		UserId:   user.Id,
+		GuestId:  user.GuestId,
+		SkuId:    int(req.SkuId),
+		Quantity: int(req.Quantity),
+	}
+	cart.SetDefaults()
+	err = g.usecases.CartUsecase.CreateCart(ctx, user.Users, cart)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CartUsecase.CreateCart")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &CartLineResponse{
+		SkuId:     int32(cart.SkuId),
+		Quantity:  int32(cart.Quantity),
+		CartToken: user.CartToken,
+	}, nil
+}
+
+func (g *grpcServer) UpdateCartLine(ctx context.Context, req *CartLineRequest) (*CartLineResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CartHandler.UpdateCartLine"})
+
+	user, err := g.cartUser(ctx, false)
+	if err != nil {
+		glog.WithError(err).Warning("g.cartUser")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	cart := &entity.Cart{
+		UserId:   user.Id,
+		GuestId:  user.GuestId,
+		SkuId:    int(req.SkuId),
+		Quantity: int(req.Quantity),
+	}
+	err = g.usecases.CartUsecase.UpdateCart(ctx, user.Users, cart)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CartUsecase.UpdateCart")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &CartLineResponse{
+		SkuId:    int32(cart.SkuId),
+		Quantity: int32(cart.Quantity),
+	}, nil
+}
+
+func (g *grpcServer) RemoveCartLine(ctx context.Context, req *RemoveCartLineRequest) (*RemoveCartLineResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CartHandler.RemoveCartLine"})
+
+	user, err := g.cartUser(ctx, false)
+	if err != nil {
+		glog.WithError(err).Warning("g.cartUser")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	cart := &entity.Cart{
+		UserId:  user.Id,
+		GuestId: user.GuestId,
+		SkuId:   int(req.SkuId),
+	}
+	err = g.usecases.CartUsecase.DeleteCart(ctx, cart)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CartUsecase.DeleteCart")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &RemoveCartLineResponse{}, nil
+}
+
+func (g *grpcServer) PlaceOrder(ctx context.Context, req *PlaceOrderRequest) (*PlaceOrderResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "Checkout.PlaceOrder"})
+
+	user, err := g.cartUser(ctx, false)
+	if err != nil {
+		glog.WithError(err).Warning("g.cartUser")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	order := &entity.Order{
+		AddressId: int(req.AddressId),
+		Comment:   req.Comment,
+		Notes:     req.Notes,
+	}
+	if user.Id != 0 {
+		err = g.usecases.OrderUsecase.CreateOrder(ctx, user.Users, order)
+		if err != nil {
+			glog.WithError(err).Warning("g.usecases.OrderUsecase.CreateOrder")
+			return nil, grpchelper.StatusError(err)
+		}
+	} else {
+		if req.Address == nil {
+			return nil, grpchelper.StatusError(errorStatus.ErrBadReq)
+		}
+		order.Email = req.Email
+		address := mapCheckoutAddress(req.Address)
+		if err = validate.Struct(address); err != nil {
+			return nil, grpchelper.StatusError(err)
+		}
+		err = g.usecases.OrderUsecase.CreateGuestOrder(ctx, user.Users, order, address)
+		if err != nil {
+			glog.WithError(err).Warning("g.usecases.OrderUsecase.CreateGuestOrder")
+			return nil, grpchelper.StatusError(err)
+		}
+	}
+
+	return &PlaceOrderResponse{
+		OrderId:  order.Id.String(),
+		Subtotal: order.Subtotal,
+		Tax:      order.TaxTotal,
+		Discount: order.DiscountTotal,
+		Total:    order.Total,
+	}, nil
+}
+
+// cartCaller is the authenticated user of the call with the cart token issued to an anonymous caller
+type cartCaller struct {
+	*entity.Users
+	CartToken string
+}
+
+// cartUser returns the user resolved by the auth interceptor,
+// anonymous callers are identified by the signed cart token of the x-cart-token metadata,
+// if issue is set a new anonymous cart is started for calls without token
+func (g *grpcServer) cartUser(ctx context.Context, issue bool) (*cartCaller, error) {
+	caller := &cartCaller{Users: grpchelper.UserFromContext(ctx)}
+	if caller.Id != 0 {
+		return caller, nil
+	}
+
+	token := grpchelper.MetadataValue(ctx, cartTokenKey)
+	if token == "" {
+		if !issue {
+			return nil, errorStatus.ErrAuth
+		}
+		var err error
+		token, err = g.usecases.CartUsecase.NewGuestToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+		caller.CartToken = token
+	}
+	guestId, err := g.usecases.CartUsecase.ParseGuestToken(ctx, token)
+	if err != nil {
+		return nil, errorStatus.ErrAuth
+	}
+	caller.GuestId = guestId
+	return caller, nil
+}
+
+func mapSummary(s *entity.CartSummaryJson) *CartSummary {
+	res := &CartSummary{
+		Items:         make([]*CartLine, len(s.Items)),
+		Subtotal:      s.Subtotal,
+		Discounts:     make([]*CartDiscount, len(s.Discounts)),
+		DiscountTotal: s.DiscountTotal,
+		Total:         s.Total,
+		Warnings:      make([]*CartWarning, len(s.Warnings)),
+	}
+	for idx, item := range s.Items {
+		line := &CartLine{
+			SkuId:      int32(item.SkuId),
+			SkuCode:    item.SkuCode,
+			Name:       item.Name,
+			Image:      item.Image,
+			Options:    make([]*CartSkuOption, len(item.Options)),
+			Quantity:   int32(item.Quantity),
+			UnitPrice:  item.UnitPrice,
+			AddedPrice: item.AddedPrice,
+			LineTotal:  item.LineTotal,
+			Available:  item.Available,
+		}
+		for i, opt := range item.Options {
+			line.Options[i] = &CartSkuOption{Option: opt.Option, Value: opt.Value}
+		}
+		res.Items[idx] = line
+	}
+	for idx, d := range s.Discounts {
+		res.Discounts[idx] = &CartDiscount{Code: d.Code, Description: d.Description, Amount: d.Amount}
+	}
+	for idx, w := range s.Warnings {
+		res.Warnings[idx] = &CartWarning{SkuId: int32(w.SkuId), Code: string(w.Code), Message: w.Message}
+	}
+	return res
+}
+
+func mapCheckoutAddress(a *CheckoutAddress) *entity.Address {
+	return &entity.Address{
+		Recipient: a.Recipient,
+		Phone:     a.Phone,
+		Country:   a.Country,
+		RegionId:  int(a.RegionId),
+		City:      a.City,
+		Street:    a.Street,
+		Postcode:  a.Postcode,
+		Latitude:  a.Latitude,
+		Longitude: a.Longitude,
+	}
+}
@@ -0,0 +1,200 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	mocks "go-store/internal/cart/mock"
+	"go-store/internal/entity"
+	orderMocks "go-store/internal/order/mock"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+func TestCartServer(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	cartMock := mocks.NewMockCartUsecase(mockCtrl)
+	orderMock := orderMocks.NewMockOrderUsecase(mockCtrl)
+	srv := &grpcServer{
+		usecases: &entity.Usecases{
+			CartUsecase:  cartMock,
+			OrderUsecase: orderMock,
+		},
+	}
+	guestId := uuid.UUID{1}
+	guest := &entity.Users{Role: entity.UserRoleGuest}
+	customer := &entity.Users{Id: 5, Role: entity.UserRoleUser}
+	guestCtx := func(token string) context.Context {
+		ctx := grpchelper.ContextWithUser(context.Background(), guest)
+		if token == "" {
+			return ctx
+		}
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(cartTokenKey, token))
+	}
+
+	t.Run("get cart of the user", func(t *testing.T) {
+		ctx := grpchelper.ContextWithUser(context.Background(), customer)
+		summary := &entity.CartSummaryJson{
+			Items: []*entity.CartJson{{
+				SkuId:     3,
+				SkuCode:   "tee-red",
+				Options:   []*entity.SkuOptionJson{{Option: "color", Value: "red"}},
+				Quantity:  2,
+				LineTotal: 25,
+				Available: true,
+			}},
+			Subtotal: 25,
+			Total:    25,
+			Warnings: []*entity.CartWarningJson{{SkuId: 4, Code: entity.CartOutOfStock}},
+		}
+		cartMock.EXPECT().GetCart(ctx, customer, 10, 0).Return(summary, nil).Times(1)
+
+		res, err := srv.GetCart(ctx, &GetCartRequest{Limit: 10})
+		req.NoError(err)
+		req.Len(res.Items, 1)
+		req.Equal("tee-red", res.Items[0].SkuCode)
+		req.Equal("red", res.Items[0].Options[0].Value)
+		req.Equal(float32(25), res.Total)
+		req.Equal(string(entity.CartOutOfStock), res.Warnings[0].Code)
+	})
+
+	t.Run("get cart without the cart token", func(t *testing.T) {
+		_, err := srv.GetCart(guestCtx(""), &GetCartRequest{})
+		req.Equal(codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("get cart with a forged cart token", func(t *testing.T) {
+		cartMock.EXPECT().ParseGuestToken(any, "forged").Return(uuid.Nil, errorStatus.ErrToken).Times(1)
+
+		_, err := srv.GetCart(guestCtx("forged"), &GetCartRequest{})
+		req.Equal(codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("first line of the guest issues the cart token", func(t *testing.T) {
+		cartMock.EXPECT().NewGuestToken(any).Return("cart-token", nil).Times(1)
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+		cartMock.EXPECT().CreateCart(any, guest, any).DoAndReturn(func(_ context.Context, _ *entity.Users, cart *entity.Cart) error {
+			req.Equal(guestId, cart.GuestId)
+			req.Equal(3, cart.SkuId)
+			return nil
+		}).Times(1)
+
+		res, err := srv.AddCartLine(guestCtx(""), &CartLineRequest{SkuId: 3, Quantity: 1})
+		req.NoError(err)
+		req.Equal("cart-token", res.CartToken)
+	})
+
+	t.Run("next line of the guest keeps the cart token", func(t *testing.T) {
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+		cartMock.EXPECT().CreateCart(any, guest, any).Return(nil).Times(1)
+
+		res, err := srv.AddCartLine(guestCtx("cart-token"), &CartLineRequest{SkuId: 4, Quantity: 1})
+		req.NoError(err)
+		req.Empty(res.CartToken)
+	})
+
+	t.Run("update line of a missing sku", func(t *testing.T) {
+		ctx := grpchelper.ContextWithUser(context.Background(), customer)
+		cartMock.EXPECT().UpdateCart(ctx, customer, &entity.Cart{UserId: 5, SkuId: 9, Quantity: 2}).Return(errorStatus.ErrNotFound).Times(1)
+
+		_, err := srv.UpdateCartLine(ctx, &CartLineRequest{SkuId: 9, Quantity: 2})
+		req.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("remove line of the guest", func(t *testing.T) {
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+		cartMock.EXPECT().DeleteCart(any, &entity.Cart{GuestId: guestId, SkuId: 3}).Return(nil).Times(1)
+
+		_, err := srv.RemoveCartLine(guestCtx("cart-token"), &RemoveCartLineRequest{SkuId: 3})
+		req.NoError(err)
+	})
+}
+
+func TestCheckoutServer(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	cartMock := mocks.NewMockCartUsecase(mockCtrl)
+	orderMock := orderMocks.NewMockOrderUsecase(mockCtrl)
+	srv := &grpcServer{
+		usecases: &entity.Usecases{
+			CartUsecase:  cartMock,
+			OrderUsecase: orderMock,
+		},
+	}
+	guestId := uuid.UUID{2}
+	guest := &entity.Users{Role: entity.UserRoleGuest}
+	guestCtx := metadata.NewIncomingContext(grpchelper.ContextWithUser(context.Background(), guest), metadata.Pairs(cartTokenKey, "cart-token"))
+	address := &CheckoutAddress{Recipient: "John Doe", Phone: "+100200300", Country: "US", City: "Boston", Street: "Main st. 1"}
+
+	t.Run("user places the order to the address book entry", func(t *testing.T) {
+		customer := &entity.Users{Id: 5, Role: entity.UserRoleUser}
+		ctx := grpchelper.ContextWithUser(context.Background(), customer)
+		orderId := uuid.UUID{3}
+		orderMock.EXPECT().CreateOrder(ctx, customer, any).DoAndReturn(func(_ context.Context, _ *entity.Users, order *entity.Order) error {
+			req.Equal(7, order.AddressId)
+			order.Id = orderId
+			order.Total = 42
+			return nil
+		}).Times(1)
+
+		res, err := srv.PlaceOrder(ctx, &PlaceOrderRequest{AddressId: 7})
+		req.NoError(err)
+		req.Equal(orderId.String(), res.OrderId)
+		req.Equal(float32(42), res.Total)
+	})
+
+	t.Run("guest places the order to the given address", func(t *testing.T) {
+		orderId := uuid.UUID{4}
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+		orderMock.EXPECT().CreateGuestOrder(any, any, any, any).
+			DoAndReturn(func(_ context.Context, user *entity.Users, order *entity.Order, addr *entity.Address) error {
+				req.Equal(guestId, user.GuestId)
+				req.Equal("john@example.com", order.Email)
+				req.Equal("Boston", addr.City)
+				order.Id = orderId
+				return nil
+			}).Times(1)
+
+		res, err := srv.PlaceOrder(guestCtx, &PlaceOrderRequest{Email: "john@example.com", Address: address})
+		req.NoError(err)
+		req.Equal(orderId.String(), res.OrderId)
+	})
+
+	t.Run("guest order without the address", func(t *testing.T) {
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+
+		_, err := srv.PlaceOrder(guestCtx, &PlaceOrderRequest{Email: "john@example.com"})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("guest order to an invalid address", func(t *testing.T) {
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+
+		_, err := srv.PlaceOrder(guestCtx, &PlaceOrderRequest{Email: "john@example.com", Address: &CheckoutAddress{Recipient: "John Doe"}})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+		violations := grpchelper.DomainError(status.Convert(err)).Violations
+		req.Contains(violations, errorStatus.FieldViolation{Field: "city", Description: "required"})
+	})
+
+	t.Run("checkout of an empty cart", func(t *testing.T) {
+		cartMock.EXPECT().ParseGuestToken(any, "cart-token").Return(guestId, nil).Times(1)
+		orderMock.EXPECT().CreateGuestOrder(any, any, any, any).Return(errorStatus.ErrNotFound).Times(1)
+
+		_, err := srv.PlaceOrder(guestCtx, &PlaceOrderRequest{Email: "john@example.com", Address: address})
+		req.Equal(codes.NotFound, status.Code(err))
+	})
+}
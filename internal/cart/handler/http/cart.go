@@ -1,7 +1,6 @@
 package http
 
 import (
-	"encoding/json"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -23,7 +22,8 @@ type CartHandler struct {
 }
 
 const (
-	sucsess = "sucsess"
+	sucsess         = "sucsess"
+	cartTokenHeader = "x-cart-token"
 )
 
 // NewCartHandler will initialize the cart items endpoint
@@ -35,9 +35,11 @@ func NewCartHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entity.Us
 	}
 	h := handler.Group("/cart")
 	{
+		h.POST("/guest", oh.newGuestToken)
+		h.GET("/restore/:token", oh.restoreCart)
 		h.POST("", mdw, oh.getCartItems)
 		h.POST("/create", mdw, oh.createCartItem)
-		h.PUT("/:cartId", mdw, oh.updateCartItem)
+		h.PUT("/:skuId", mdw, oh.updateCartItem)
 		h.DELETE("/:cartId", mdw, oh.deleteCartItem)
 	}
 }
@@ -53,10 +55,16 @@ func (oh *CartHandler) getCartItems(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 
+	if err := oh.guestCart(c, user, false); err != nil {
+		srvLog.WithError(err).Warning("oh.guestCart")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
 	pageParam, err := httphelper.PaginationParams(c)
 	if err != nil {
 		srvLog.WithError(err).Warning(err)
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
@@ -83,15 +91,22 @@ func (oh *CartHandler) createCartItem(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 
+	if err := oh.guestCart(c, user, true); err != nil {
+		srvLog.WithError(err).Warning("oh.guestCart")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
 	var createReq dto.CartCreateRequest
-	if err := json.NewDecoder(c.Request.Body).Decode(&createReq); err != nil {
-		srvLog.WithError(err).Error("format is wrong")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+	if err := httphelper.BindJSON(c, &createReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
 	cart := &entity.Cart{
 		UserId:   user.Id,
+		GuestId:  user.GuestId,
 		SkuId:    createReq.SkuId,
 		Quantity: createReq.Quantity,
 	}
@@ -104,7 +119,7 @@ func (oh *CartHandler) createCartItem(c *gin.Context) {
 		return
 	}
 
-	httphelper.SendResponse(c, sucsess, nil)
+	httphelper.SendResponse(c, dto.CartLineResponse{SkuId: cart.SkuId, Quantity: cart.Quantity}, nil)
 
 }
 
@@ -119,16 +134,39 @@ func (oh *CartHandler) updateCartItem(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 
-	cart := &entity.Cart{}
+	if err := oh.guestCart(c, user, false); err != nil {
+		srvLog.WithError(err).Warning("oh.guestCart")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	skuId, err := strconv.Atoi(c.Param("skuId"))
+	if err != nil || skuId <= 0 {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("skuId", "must be a positive integer"))
+		return
+	}
+	var updateReq dto.CartUpdateRequest
+	if err = httphelper.BindJSON(c, &updateReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
 
-	err := oh.cartUc.UpdateCart(c, user, cart)
+	cart := &entity.Cart{
+		UserId:   user.Id,
+		GuestId:  user.GuestId,
+		SkuId:    skuId,
+		Quantity: updateReq.Quantity,
+	}
+
+	err = oh.cartUc.UpdateCart(c, user, cart)
 	if err != nil {
 		srvLog.WithError(err).Warning("oh.ordUsecase.UpdateOrder")
 		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
-	httphelper.SendResponse(c, sucsess, nil)
+	httphelper.SendResponse(c, dto.CartLineResponse{SkuId: cart.SkuId, Quantity: cart.Quantity}, nil)
 
 }
 
@@ -143,6 +181,12 @@ func (oh *CartHandler) deleteCartItem(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 
+	if err := oh.guestCart(c, user, false); err != nil {
+		srvLog.WithError(err).Warning("oh.guestCart")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
 	skuId, ok := c.GetQuery("skuId")
 	if !ok {
 		srvLog.Warning("createCategoryOpt.GetQuery.optionName")
@@ -158,8 +202,9 @@ func (oh *CartHandler) deleteCartItem(c *gin.Context) {
 	}
 
 	cart := &entity.Cart{
-		UserId: user.Id,
-		SkuId:  skuIdInt,
+		UserId:  user.Id,
+		GuestId: user.GuestId,
+		SkuId:   skuIdInt,
 	}
 
 	err = oh.cartUc.DeleteCart(c, cart)
@@ -171,3 +216,57 @@ func (oh *CartHandler) deleteCartItem(c *gin.Context) {
 
 	httphelper.SendResponse(c, sucsess, nil)
 }
+
+// newGuestToken starts an anonymous cart, the token is sent back in the x-cart-token header
+func (oh *CartHandler) newGuestToken(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "CartHandler.newGuestToken"})
+
+	token, err := oh.cartUc.NewGuestToken(c)
+	if err != nil {
+		srvLog.WithError(err).Warning("oh.cartUc.NewGuestToken")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, token, nil)
+}
+
+// restoreCart serves the one-click restore link of the abandoned cart reminders
+func (oh *CartHandler) restoreCart(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "CartHandler.restoreCart"})
+
+	result, err := oh.cartUc.RestoreCart(c, c.Param("token"))
+	if err != nil {
+		srvLog.WithError(err).Warning("oh.cartUc.RestoreCart")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
+// guestCart identifies anonymous users by the signed x-cart-token header,
+// if issue is set a new anonymous cart is started for requests without token
+func (oh *CartHandler) guestCart(c *gin.Context, user *entity.Users, issue bool) error {
+	if user.Id != 0 {
+		return nil
+	}
+	token := c.GetHeader(cartTokenHeader)
+	if token == "" {
+		if !issue {
+			return errorStatus.ErrAuth
+		}
+		var err error
+		token, err = oh.cartUc.NewGuestToken(c)
+		if err != nil {
+			return err
+		}
+		c.Header(cartTokenHeader, token)
+	}
+	guestId, err := oh.cartUc.ParseGuestToken(c, token)
+	if err != nil {
+		return err
+	}
+	user.GuestId = guestId
+	return nil
+}
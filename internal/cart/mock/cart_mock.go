@@ -0,0 +1,435 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/entity/cart.go
+
+// Package cartMock is a generated GoMock package.
+package cartMock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "go-store/internal/entity"
+	reflect "reflect"
+	time "time"
+)
+
+// MockCartUsecase is a mock of CartUsecase interface
+type MockCartUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartUsecaseMockRecorder
+}
+
+// MockCartUsecaseMockRecorder is the mock recorder for MockCartUsecase
+type MockCartUsecaseMockRecorder struct {
+	mock *MockCartUsecase
+}
+
+// NewMockCartUsecase creates a new mock instance
+func NewMockCartUsecase(ctrl *gomock.Controller) *MockCartUsecase {
+	mock := &MockCartUsecase{ctrl: ctrl}
+	mock.recorder = &MockCartUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCartUsecase) EXPECT() *MockCartUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetCart mocks base method
+func (m *MockCartUsecase) GetCart(ctx context.Context, user *entity.Users, limit, offset int) (*entity.CartSummaryJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCart", ctx, user, limit, offset)
+	ret0, _ := ret[0].(*entity.CartSummaryJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCart indicates an expected call of GetCart
+func (mr *MockCartUsecaseMockRecorder) GetCart(ctx, user, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCart", reflect.TypeOf((*MockCartUsecase)(nil).GetCart), ctx, user, limit, offset)
+}
+
+// CreateCart mocks base method
+func (m *MockCartUsecase) CreateCart(ctx context.Context, user *entity.Users, cart *entity.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCart", ctx, user, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCart indicates an expected call of CreateCart
+func (mr *MockCartUsecaseMockRecorder) CreateCart(ctx, user, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockCartUsecase)(nil).CreateCart), ctx, user, cart)
+}
+
+// UpdateCart mocks base method
+func (m *MockCartUsecase) UpdateCart(ctx context.Context, user *entity.Users, cart *entity.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCart", ctx, user, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCart indicates an expected call of UpdateCart
+func (mr *MockCartUsecaseMockRecorder) UpdateCart(ctx, user, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCart", reflect.TypeOf((*MockCartUsecase)(nil).UpdateCart), ctx, user, cart)
+}
+
+// DeleteCart mocks base method
+func (m *MockCartUsecase) DeleteCart(ctx context.Context, cart *entity.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCart", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCart indicates an expected call of DeleteCart
+func (mr *MockCartUsecaseMockRecorder) DeleteCart(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCart", reflect.TypeOf((*MockCartUsecase)(nil).DeleteCart), ctx, cart)
+}
+
+// NewGuestToken mocks base method
+func (m *MockCartUsecase) NewGuestToken(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewGuestToken", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewGuestToken indicates an expected call of NewGuestToken
+func (mr *MockCartUsecaseMockRecorder) NewGuestToken(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewGuestToken", reflect.TypeOf((*MockCartUsecase)(nil).NewGuestToken), ctx)
+}
+
+// ParseGuestToken mocks base method
+func (m *MockCartUsecase) ParseGuestToken(ctx context.Context, token string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseGuestToken", ctx, token)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseGuestToken indicates an expected call of ParseGuestToken
+func (mr *MockCartUsecaseMockRecorder) ParseGuestToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseGuestToken", reflect.TypeOf((*MockCartUsecase)(nil).ParseGuestToken), ctx, token)
+}
+
+// MergeGuestCart mocks base method
+func (m *MockCartUsecase) MergeGuestCart(ctx context.Context, token string, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeGuestCart", ctx, token, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeGuestCart indicates an expected call of MergeGuestCart
+func (mr *MockCartUsecaseMockRecorder) MergeGuestCart(ctx, token, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeGuestCart", reflect.TypeOf((*MockCartUsecase)(nil).MergeGuestCart), ctx, token, userId)
+}
+
+// RestoreCart mocks base method
+func (m *MockCartUsecase) RestoreCart(ctx context.Context, token string) (*entity.CartSummaryJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreCart", ctx, token)
+	ret0, _ := ret[0].(*entity.CartSummaryJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreCart indicates an expected call of RestoreCart
+func (mr *MockCartUsecaseMockRecorder) RestoreCart(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreCart", reflect.TypeOf((*MockCartUsecase)(nil).RestoreCart), ctx, token)
+}
+
+// MockCartReminderUsecase is a mock of CartReminderUsecase interface
+type MockCartReminderUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartReminderUsecaseMockRecorder
+}
+
+// MockCartReminderUsecaseMockRecorder is the mock recorder for MockCartReminderUsecase
+type MockCartReminderUsecaseMockRecorder struct {
+	mock *MockCartReminderUsecase
+}
+
+// NewMockCartReminderUsecase creates a new mock instance
+func NewMockCartReminderUsecase(ctrl *gomock.Controller) *MockCartReminderUsecase {
+	mock := &MockCartReminderUsecase{ctrl: ctrl}
+	mock.recorder = &MockCartReminderUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCartReminderUsecase) EXPECT() *MockCartReminderUsecaseMockRecorder {
+	return m.recorder
+}
+
+// SendReminders mocks base method
+func (m *MockCartReminderUsecase) SendReminders(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReminders", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReminders indicates an expected call of SendReminders
+func (mr *MockCartReminderUsecaseMockRecorder) SendReminders(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReminders", reflect.TypeOf((*MockCartReminderUsecase)(nil).SendReminders), ctx)
+}
+
+// Run mocks base method
+func (m *MockCartReminderUsecase) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run
+func (mr *MockCartReminderUsecaseMockRecorder) Run(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockCartReminderUsecase)(nil).Run), ctx)
+}
+
+// MockCartRepository is a mock of CartRepository interface
+type MockCartRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartRepositoryMockRecorder
+}
+
+// MockCartRepositoryMockRecorder is the mock recorder for MockCartRepository
+type MockCartRepositoryMockRecorder struct {
+	mock *MockCartRepository
+}
+
+// NewMockCartRepository creates a new mock instance
+func NewMockCartRepository(ctrl *gomock.Controller) *MockCartRepository {
+	mock := &MockCartRepository{ctrl: ctrl}
+	mock.recorder = &MockCartRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCartRepository) EXPECT() *MockCartRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetCarts mocks base method
+func (m *MockCartRepository) GetCarts(ctx context.Context, limit, offset int, owner *entity.CartOwner) ([]*entity.Cart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCarts", ctx, limit, offset, owner)
+	ret0, _ := ret[0].([]*entity.Cart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCarts indicates an expected call of GetCarts
+func (mr *MockCartRepositoryMockRecorder) GetCarts(ctx, limit, offset, owner interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCarts", reflect.TypeOf((*MockCartRepository)(nil).GetCarts), ctx, limit, offset, owner)
+}
+
+// CreateCart mocks base method
+func (m *MockCartRepository) CreateCart(ctx context.Context, cart *entity.Cart, maxQuantity int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCart", ctx, cart, maxQuantity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCart indicates an expected call of CreateCart
+func (mr *MockCartRepositoryMockRecorder) CreateCart(ctx, cart, maxQuantity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCart", reflect.TypeOf((*MockCartRepository)(nil).CreateCart), ctx, cart, maxQuantity)
+}
+
+// UpdateCart mocks base method
+func (m *MockCartRepository) UpdateCart(ctx context.Context, cart *entity.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCart", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCart indicates an expected call of UpdateCart
+func (mr *MockCartRepositoryMockRecorder) UpdateCart(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCart", reflect.TypeOf((*MockCartRepository)(nil).UpdateCart), ctx, cart)
+}
+
+// DeleteCart mocks base method
+func (m *MockCartRepository) DeleteCart(ctx context.Context, cart *entity.Cart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCart", ctx, cart)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCart indicates an expected call of DeleteCart
+func (mr *MockCartRepositoryMockRecorder) DeleteCart(ctx, cart interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCart", reflect.TypeOf((*MockCartRepository)(nil).DeleteCart), ctx, cart)
+}
+
+// MergeCart mocks base method
+func (m *MockCartRepository) MergeCart(ctx context.Context, guestId uuid.UUID, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MergeCart", ctx, guestId, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MergeCart indicates an expected call of MergeCart
+func (mr *MockCartRepositoryMockRecorder) MergeCart(ctx, guestId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeCart", reflect.TypeOf((*MockCartRepository)(nil).MergeCart), ctx, guestId, userId)
+}
+
+// GetAbandonedCarts mocks base method
+func (m *MockCartRepository) GetAbandonedCarts(ctx context.Context, idleSince time.Time, prevStage int, prevSentBefore time.Time, limit int) ([]*entity.AbandonedCart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbandonedCarts", ctx, idleSince, prevStage, prevSentBefore, limit)
+	ret0, _ := ret[0].([]*entity.AbandonedCart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbandonedCarts indicates an expected call of GetAbandonedCarts
+func (mr *MockCartRepositoryMockRecorder) GetAbandonedCarts(ctx, idleSince, prevStage, prevSentBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbandonedCarts", reflect.TypeOf((*MockCartRepository)(nil).GetAbandonedCarts), ctx, idleSince, prevStage, prevSentBefore, limit)
+}
+
+// CreateReminder mocks base method
+func (m *MockCartRepository) CreateReminder(ctx context.Context, reminder *entity.CartReminder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReminder", ctx, reminder)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReminder indicates an expected call of CreateReminder
+func (mr *MockCartRepositoryMockRecorder) CreateReminder(ctx, reminder interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReminder", reflect.TypeOf((*MockCartRepository)(nil).CreateReminder), ctx, reminder)
+}
+
+// GetReminder mocks base method
+func (m *MockCartRepository) GetReminder(ctx context.Context, token uuid.UUID) (*entity.CartReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReminder", ctx, token)
+	ret0, _ := ret[0].(*entity.CartReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReminder indicates an expected call of GetReminder
+func (mr *MockCartRepositoryMockRecorder) GetReminder(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReminder", reflect.TypeOf((*MockCartRepository)(nil).GetReminder), ctx, token)
+}
+
+// ClickReminder mocks base method
+func (m *MockCartRepository) ClickReminder(ctx context.Context, reminderId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClickReminder", ctx, reminderId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClickReminder indicates an expected call of ClickReminder
+func (mr *MockCartRepositoryMockRecorder) ClickReminder(ctx, reminderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClickReminder", reflect.TypeOf((*MockCartRepository)(nil).ClickReminder), ctx, reminderId)
+}
+
+// MarkRecovered mocks base method
+func (m *MockCartRepository) MarkRecovered(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRecovered", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRecovered indicates an expected call of MarkRecovered
+func (mr *MockCartRepositoryMockRecorder) MarkRecovered(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRecovered", reflect.TypeOf((*MockCartRepository)(nil).MarkRecovered), ctx)
+}
+
+// CreateCoupon mocks base method
+func (m *MockCartRepository) CreateCoupon(ctx context.Context, coupon *entity.Coupon) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCoupon", ctx, coupon)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCoupon indicates an expected call of CreateCoupon
+func (mr *MockCartRepositoryMockRecorder) CreateCoupon(ctx, coupon interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCoupon", reflect.TypeOf((*MockCartRepository)(nil).CreateCoupon), ctx, coupon)
+}
+
+// GetActiveCoupon mocks base method
+func (m *MockCartRepository) GetActiveCoupon(ctx context.Context, userId int) (*entity.Coupon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveCoupon", ctx, userId)
+	ret0, _ := ret[0].(*entity.Coupon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveCoupon indicates an expected call of GetActiveCoupon
+func (mr *MockCartRepositoryMockRecorder) GetActiveCoupon(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveCoupon", reflect.TypeOf((*MockCartRepository)(nil).GetActiveCoupon), ctx, userId)
+}
+
+// MockCartBroker is a mock of CartBroker interface
+type MockCartBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockCartBrokerMockRecorder
+}
+
+// MockCartBrokerMockRecorder is the mock recorder for MockCartBroker
+type MockCartBrokerMockRecorder struct {
+	mock *MockCartBroker
+}
+
+// NewMockCartBroker creates a new mock instance
+func NewMockCartBroker(ctrl *gomock.Controller) *MockCartBroker {
+	mock := &MockCartBroker{ctrl: ctrl}
+	mock.recorder = &MockCartBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCartBroker) EXPECT() *MockCartBrokerMockRecorder {
+	return m.recorder
+}
+
+// SendEmail mocks base method
+func (m *MockCartBroker) SendEmail(ctx context.Context, to, subject string, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmail", ctx, to, subject, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmail indicates an expected call of SendEmail
+func (mr *MockCartBrokerMockRecorder) SendEmail(ctx, to, subject, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockCartBroker)(nil).SendEmail), ctx, to, subject, message)
+}
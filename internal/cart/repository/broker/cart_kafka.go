@@ -0,0 +1,48 @@
+package broker
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+)
+
+type KafkaConn struct {
+	*kafka.Conn
+}
+
+// NewCartBroker will create an object that represent the entity.CartBroker interface,
+// the connection is kept open for the reminders of the following runs
+func NewCartBroker(conn *kafka.Conn) entity.CartBroker {
+	return &KafkaConn{conn}
+}
+
+// SendEmail writes the email body to the email topic, the recipient and subject go to the message headers
+func (b *KafkaConn) SendEmail(ctx context.Context, dest string, subject string, message []byte) error {
+	bLog := log.WithFields(log.Fields{"func": "broker.SendEmail"})
+	err := b.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	if err != nil {
+		bLog.Warning("failed to set write deadline:", err)
+		return err
+	}
+	timeNowStr := strconv.FormatInt(time.Now().Unix(), 10)
+	_, err = b.Conn.WriteMessages(
+		kafka.Message{
+			Key:   []byte("email-" + timeNowStr),
+			Value: message,
+			Headers: []kafka.Header{
+				{Key: "to", Value: []byte(dest)},
+				{Key: "subject", Value: []byte(subject)},
+			},
+		},
+	)
+	if err != nil {
+		bLog.Warning("failed to write messages:", err)
+		return err
+	}
+	return nil
+}
@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"go-store/utils/database"
+	errorStatus "go-store/utils/errors"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v4"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -20,20 +23,22 @@ func NewPgxCartRepository(pgx *database.PgxAccess) entity.CartRepository {
 	return &PgxAccess{pgx}
 }
 
-func (d *PgxAccess) GetCarts(ctx context.Context, limit int, offset int, userId *int) (result []*entity.Cart, err error) {
+func (d *PgxAccess) GetCarts(ctx context.Context, limit int, offset int, owner *entity.CartOwner) (result []*entity.Cart, err error) {
 	dbLog := log.WithFields(log.Fields{"func": "db.GetOrderQuery"})
 	baseQuery := d.Builder.
-		Select("user_id",
+		Select("COALESCE(user_id, 0)",
+			"COALESCE(guest_id, '00000000-0000-0000-0000-000000000000')",
 			"sku_id",
 			"quantity",
+			"price",
 			"create_ts",
 			"update_ts").
 		From("cart").
 		Where("state = 'enabled'").
 		Limit(uint64(limit)).
 		Offset(uint64(offset))
-	if userId != nil {
-		baseQuery = baseQuery.Where("cart.user_id = ?", userId)
+	if owner != nil {
+		baseQuery = baseQuery.Where(ownerEq(*owner))
 	}
 	query, args, err := baseQuery.ToSql()
 	rows, err := d.Pool.Query(ctx, query, args...)
@@ -45,8 +50,8 @@ func (d *PgxAccess) GetCarts(ctx context.Context, limit int, offset int, userId
 	defer rows.Close()
 	for rows.Next() {
 		var tmp entity.Cart
-		if err := rows.Scan(&tmp.UserId, &tmp.SkuId, &tmp.Quantity, &tmp.CreateTs, &tmp.UpdateTs); err != nil {
-			dbLog.WithFields(log.Fields{"user_id": userId}).Warning(err)
+		if err := rows.Scan(&tmp.UserId, &tmp.GuestId, &tmp.SkuId, &tmp.Quantity, &tmp.Price, &tmp.CreateTs, &tmp.UpdateTs); err != nil {
+			dbLog.WithFields(log.Fields{"owner": owner}).Warning(err)
 			return nil, err
 		}
 		result = append(result, &tmp)
@@ -56,28 +61,49 @@ func (d *PgxAccess) GetCarts(ctx context.Context, limit int, offset int, userId
 
 }
 
-func (d *PgxAccess) CreateCart(ctx context.Context, cart *entity.Cart) (err error) {
+// CreateCart adds the cart line or, if the sku is already in the cart, increments it,
+// the resulting quantity is capped at maxQuantity and written back to the cart
+func (d *PgxAccess) CreateCart(ctx context.Context, cart *entity.Cart, maxQuantity int) (err error) {
 	dbLog := log.WithFields(log.Fields{"func": "pg.CreateOrder"})
+	conflict := "(user_id, sku_id)"
+	if cart.UserId == 0 {
+		conflict = "(guest_id, sku_id)"
+	}
 	query, args, err := d.Builder.
 		Insert("cart").
 		Columns("user_id",
+			"guest_id",
 			"sku_id",
 			"quantity",
+			"price",
 			"create_ts",
 			"update_ts",
 			"state",
 			"version").
-		Values(cart.UserId,
+		Values(nullableUserId(cart.UserId),
+			nullableGuestId(cart.GuestId),
 			cart.SkuId,
 			cart.Quantity,
+			cart.Price,
 			cart.CreateTs,
 			cart.UpdateTs,
 			cart.State,
 			cart.Version).
+		Suffix("ON CONFLICT "+conflict+" DO UPDATE "+
+			"SET quantity = LEAST(cart.quantity + EXCLUDED.quantity, ?), "+
+			"price = EXCLUDED.price, "+
+			"update_ts = EXCLUDED.update_ts, "+
+			"state = EXCLUDED.state, "+
+			"version = cart.version + 1 "+
+			"RETURNING quantity", maxQuantity).
 		ToSql()
-	_, err = d.Pool.Exec(ctx, query, args...)
 	if err != nil {
-		dbLog.WithError(err).Errorf("PgxAccess - CreateCart - Exec")
+		dbLog.WithError(err).Errorf("CartRepository - CreateCart - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&cart.Quantity)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - CreateCart - QueryRow")
 		return err
 	}
 	return nil
@@ -89,20 +115,23 @@ func (d *PgxAccess) UpdateCart(ctx context.Context, cart *entity.Cart) (err erro
 		Update("cart").
 		SetMap(map[string]interface{}{
 			"quantity":  cart.Quantity,
-			"update_ts": time.Now(),
-			"state":     cart.State}).
+			"update_ts": time.Now()}).
 		Set("version", squirrel.Expr("version+1")).
-		Where("sku_id = $1 AND user_id = $2", cart.SkuId, cart.UserId).
+		Where("sku_id = ?", cart.SkuId).
+		Where(ownerEq(cart.Owner())).
 		ToSql()
 	if err != nil {
 		dbLog.WithError(err).Errorf("UserLogRepo - UpdateCart - r.Builder - query")
 		return err
 	}
-	_, err = d.Pool.Exec(ctx, query, args...)
+	tag, err := d.Pool.Exec(ctx, query, args...)
 	if err != nil {
 		dbLog.WithError(err).Errorf("PgxAccess - UpdateCart - Exec")
 		return err
 	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
 	return nil
 }
 
@@ -110,7 +139,8 @@ func (d *PgxAccess) DeleteCart(ctx context.Context, cart *entity.Cart) (err erro
 	dbLog := log.WithFields(log.Fields{"func": "pg.DeleteOrder"})
 	query, args, err := d.Builder.
 		Delete("cart").
-		Where("sku_id = $1 AND user_id = $2", cart.SkuId, cart.UserId).
+		Where("sku_id = ?", cart.SkuId).
+		Where(ownerEq(cart.Owner())).
 		ToSql()
 	if err != nil {
 		dbLog.WithError(err).Errorf("UserLogRepo - UpdateCart - r.Builder - query")
@@ -123,3 +153,252 @@ func (d *PgxAccess) DeleteCart(ctx context.Context, cart *entity.Cart) (err erro
 	}
 	return nil
 }
+
+// skuMaxQuantity is entity.Sku.MaxCartQuantity of the sku row
+const skuMaxQuantity = `CASE WHEN sku.max_per_order > 0 AND sku.max_per_order < COALESCE(sku.quantity, 0)
+	THEN sku.max_per_order ELSE COALESCE(sku.quantity, 0) END`
+
+// MergeCart moves the guest cart lines into the user cart, quantities of skus present
+// in both carts are summed up. The quantities are capped like in CreateCart,
+// the guest lines of skus out of stock are dropped
+func (d *PgxAccess) MergeCart(ctx context.Context, guestId uuid.UUID, userId int) (err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.MergeCart"})
+	queryStr := `WITH guest AS (
+		DELETE FROM public."cart" WHERE guest_id = $1
+		RETURNING sku_id, quantity, price, create_ts, state, version
+	), limited AS (
+		SELECT guest.sku_id, LEAST(guest.quantity, ` + skuMaxQuantity + `) AS quantity,
+		guest.price, guest.create_ts, guest.state, guest.version
+		FROM guest
+		INNER JOIN public."sku" ON sku.id = guest.sku_id
+	)
+	INSERT INTO public."cart" (user_id, sku_id, quantity, price, create_ts, update_ts, state, version)
+	SELECT $2, sku_id, quantity, price, create_ts, $3, state, version FROM limited
+	WHERE quantity > 0
+	ON CONFLICT (user_id, sku_id) DO UPDATE
+	SET quantity = LEAST(cart.quantity + EXCLUDED.quantity,
+		(SELECT ` + skuMaxQuantity + ` FROM public."sku" WHERE sku.id = EXCLUDED.sku_id)),
+	update_ts = EXCLUDED.update_ts,
+	state = EXCLUDED.state,
+	version = cart.version + 1;`
+	_, err = d.Pool.Exec(ctx, queryStr, guestId, userId, entity.NowUTC())
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - MergeCart - Exec")
+		return err
+	}
+	return nil
+}
+
+// GetAbandonedCarts returns the carts of registered users idle since idleSince, which got exactly
+// prevStage reminders after their last update and no reminder after prevSentBefore
+func (d *PgxAccess) GetAbandonedCarts(ctx context.Context, idleSince time.Time, prevStage int, prevSentBefore time.Time, limit int) (result []*entity.AbandonedCart, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetAbandonedCarts"})
+	queryStr := `SELECT cart.user_id, users.email, MAX(cart.update_ts)
+	FROM public."cart"
+	INNER JOIN public."users" ON users.id = cart.user_id
+	WHERE cart.state = 'enabled' AND COALESCE(users.email, '') <> ''
+	GROUP BY cart.user_id, users.email
+	HAVING MAX(cart.update_ts) < $1
+	AND (SELECT COUNT(*) FROM public."cart_reminder" r
+		WHERE r.user_id = cart.user_id AND r.sent_ts > MAX(cart.update_ts)) = $2
+	AND NOT EXISTS (SELECT 1 FROM public."cart_reminder" r
+		WHERE r.user_id = cart.user_id AND r.sent_ts >= $3)
+	LIMIT $4;`
+	rows, err := d.Pool.Query(ctx, queryStr, idleSince, prevStage, prevSentBefore, limit)
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, fmt.Errorf("pg.GetAbandonedCarts: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.AbandonedCart{}
+		if err := rows.Scan(&tmp.UserId, &tmp.Email, &tmp.UpdateTs); err != nil {
+			dbLog.Warning(err)
+			return nil, err
+		}
+		result = append(result, tmp)
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) CreateReminder(ctx context.Context, reminder *entity.CartReminder) (err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateReminder"})
+	query, args, err := d.Builder.
+		Insert("cart_reminder").
+		Columns("user_id",
+			"stage",
+			"token",
+			"coupon_code",
+			"sent_ts").
+		Values(reminder.UserId,
+			reminder.Stage,
+			reminder.Token,
+			nullableCode(reminder.CouponCode),
+			reminder.SentTs).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("CartRepository - CreateReminder - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&reminder.Id)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) GetReminder(ctx context.Context, token uuid.UUID) (result *entity.CartReminder, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetReminder"})
+	query, args, err := d.Builder.
+		Select("id",
+			"user_id",
+			"stage",
+			"token",
+			"COALESCE(coupon_code, '')",
+			"sent_ts").
+		From("cart_reminder").
+		Where("token = ?", token).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("CartRepository - GetReminder - r.Builder - query")
+		return nil, err
+	}
+	result = &entity.CartReminder{}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&result.Id, &result.UserId, &result.Stage, &result.Token, &result.CouponCode, &result.SentTs)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClickReminder records the first click of the restore link
+func (d *PgxAccess) ClickReminder(ctx context.Context, reminderId int) (err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.ClickReminder"})
+	query, args, err := d.Builder.
+		Update("cart_reminder").
+		Set("click_ts", entity.NowUTC()).
+		Where("id = ? AND click_ts IS NULL", reminderId).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("CartRepository - ClickReminder - r.Builder - query")
+		return err
+	}
+	_, err = d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - ClickReminder - Exec")
+		return err
+	}
+	return nil
+}
+
+// MarkRecovered marks the last reminder of the carts ordered after it as recovered,
+// it returns the number of carts recovered since the previous call
+func (d *PgxAccess) MarkRecovered(ctx context.Context) (recovered int64, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.MarkRecovered"})
+	queryStr := `UPDATE public."cart_reminder" r
+	SET recovered_ts = (SELECT MIN(orders.create_ts) FROM public."orders"
+		WHERE orders.user_id = r.user_id AND orders.create_ts > r.sent_ts)
+	WHERE r.recovered_ts IS NULL
+	AND EXISTS (SELECT 1 FROM public."orders"
+		WHERE orders.user_id = r.user_id AND orders.create_ts > r.sent_ts)
+	AND NOT EXISTS (SELECT 1 FROM public."cart_reminder" later
+		WHERE later.user_id = r.user_id AND later.sent_ts > r.sent_ts);`
+	tag, err := d.Pool.Exec(ctx, queryStr)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - MarkRecovered - Exec")
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (d *PgxAccess) CreateCoupon(ctx context.Context, coupon *entity.Coupon) (err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateCoupon"})
+	query, args, err := d.Builder.
+		Insert("coupon").
+		Columns("code",
+			"user_id",
+			"percent",
+			"expire_ts",
+			"create_ts").
+		Values(coupon.Code,
+			coupon.UserId,
+			coupon.Percent,
+			coupon.ExpireTs,
+			coupon.CreateTs).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("CartRepository - CreateCoupon - r.Builder - query")
+		return err
+	}
+	_, err = d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - CreateCoupon - Exec")
+		return err
+	}
+	return nil
+}
+
+// GetActiveCoupon returns the best unused and unexpired coupon of the user
+func (d *PgxAccess) GetActiveCoupon(ctx context.Context, userId int) (result *entity.Coupon, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetActiveCoupon"})
+	query, args, err := d.Builder.
+		Select("code",
+			"user_id",
+			"percent",
+			"expire_ts",
+			"create_ts").
+		From("coupon").
+		Where("user_id = ? AND used_ts IS NULL AND expire_ts > ?", userId, entity.NowUTC()).
+		OrderBy("percent DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("CartRepository - GetActiveCoupon - r.Builder - query")
+		return nil, err
+	}
+	result = &entity.Coupon{}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&result.Code, &result.UserId, &result.Percent, &result.ExpireTs, &result.CreateTs)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func nullableCode(code string) interface{} {
+	if code == "" {
+		return nil
+	}
+	return code
+}
+
+// ownerEq filters the cart lines of a user or, for anonymous carts, of a guest
+func ownerEq(owner entity.CartOwner) squirrel.Eq {
+	if owner.UserId != 0 {
+		return squirrel.Eq{"cart.user_id": owner.UserId}
+	}
+	return squirrel.Eq{"cart.guest_id": owner.GuestId}
+}
+
+func nullableUserId(userId int) interface{} {
+	if userId == 0 {
+		return nil
+	}
+	return userId
+}
+
+func nullableGuestId(guestId uuid.UUID) interface{} {
+	if guestId == uuid.Nil {
+		return nil
+	}
+	return guestId
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"expvar"
+	"html/template"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/utils/broker"
+	generator "go-store/utils/generator"
+)
+
+const (
+	reminderBatch   = 100
+	reminderSubject = "Your cart is waiting for you"
+)
+
+// reminder metrics, served with the other expvar variables on /debug/vars to the admins
+var (
+	remindersSent  = expvar.NewInt("cart_reminders_sent")
+	cartsRecovered = expvar.NewInt("carts_recovered")
+)
+
+// CartReminderUsecase sends the staged reminders of abandoned carts
+type CartReminderUsecase struct {
+	cartRepo entity.CartRepository
+	broker   entity.CartBroker
+	conf     *entity.CartReminderConf
+	tmpl     *template.Template
+}
+
+// NewCartReminderUsecase will create new an CartReminderUsecase object representation of entity.CartReminderUsecase interface
+func NewCartReminderUsecase(cart entity.CartRepository, b entity.CartBroker, conf *entity.CartReminderConf) entity.CartReminderUsecase {
+	return &CartReminderUsecase{
+		cartRepo: cart,
+		broker:   b,
+		conf:     conf,
+		tmpl:     template.Must(template.New("cart_reminder").Parse(broker.CartReminderTemplate)),
+	}
+}
+
+// Run sends the reminders every conf.Interval until the context is done
+func (r *CartReminderUsecase) Run(ctx context.Context) {
+	ctLog := log.WithFields(log.Fields{"func": "CartReminderUsecase.Run"})
+
+	ticker := time.NewTicker(r.conf.Interval)
+	defer ticker.Stop()
+	for {
+		if err := r.SendReminders(ctx); err != nil {
+			ctLog.WithError(err).Warning("r.SendReminders")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendReminders counts the carts recovered since the previous run and sends the due reminders of every stage
+func (r *CartReminderUsecase) SendReminders(ctx context.Context) error {
+	ctLog := log.WithFields(log.Fields{"func": "CartReminderUsecase.SendReminders"})
+
+	recovered, err := r.cartRepo.MarkRecovered(ctx)
+	if err != nil {
+		ctLog.WithError(err).Warning("r.cartRepo.MarkRecovered")
+		return err
+	}
+	cartsRecovered.Add(recovered)
+
+	now := entity.NowUTC()
+	for idx, idle := range r.conf.Stages {
+		// a cart idle past several thresholds still gets the reminders one by one,
+		// spaced like the thresholds themselves
+		prevSentBefore := now
+		if idx > 0 {
+			prevSentBefore = now.Add(r.conf.Stages[idx-1] - idle)
+		}
+		carts, err := r.cartRepo.GetAbandonedCarts(ctx, now.Add(-idle), idx, prevSentBefore, reminderBatch)
+		if err != nil {
+			ctLog.WithError(err).Warning("r.cartRepo.GetAbandonedCarts")
+			return err
+		}
+		for _, cart := range carts {
+			if err := r.remind(ctx, cart, idx+1); err != nil {
+				ctLog.WithError(err).WithField("userId", cart.UserId).Warning("r.remind")
+			}
+		}
+	}
+	return nil
+}
+
+func (r *CartReminderUsecase) remind(ctx context.Context, cart *entity.AbandonedCart, stage int) error {
+	now := entity.NowUTC()
+	reminder := &entity.CartReminder{
+		UserId: cart.UserId,
+		Stage:  stage,
+		Token:  uuid.New(),
+		SentTs: now,
+	}
+
+	var coupon *entity.Coupon
+	if r.conf.CouponStage > 0 && stage == r.conf.CouponStage && r.conf.CouponPercent > 0 {
+		coupon = &entity.Coupon{
+			Code:     strings.ToUpper(generator.RandStringRunes(10)),
+			UserId:   cart.UserId,
+			Percent:  r.conf.CouponPercent,
+			ExpireTs: now.Add(r.conf.CouponTTL),
+			CreateTs: now,
+		}
+		if err := r.cartRepo.CreateCoupon(ctx, coupon); err != nil {
+			return err
+		}
+		reminder.CouponCode = coupon.Code
+	}
+
+	data := struct {
+		RestoreLink   string
+		CouponCode    string
+		CouponPercent float32
+		CouponExpire  string
+	}{
+		RestoreLink: r.conf.RestoreURL + "?token=" + reminder.Token.String(),
+	}
+	if coupon != nil {
+		data.CouponCode = coupon.Code
+		data.CouponPercent = coupon.Percent
+		data.CouponExpire = coupon.ExpireTs.Format("January 2, 15:04 MST")
+	}
+	buff := new(bytes.Buffer)
+	if err := r.tmpl.Execute(buff, data); err != nil {
+		return err
+	}
+
+	// the reminder is stored first, so a failing broker doesn't resend it on every run
+	if err := r.cartRepo.CreateReminder(ctx, reminder); err != nil {
+		return err
+	}
+	if err := r.broker.SendEmail(ctx, cart.Email, reminderSubject, buff.Bytes()); err != nil {
+		return err
+	}
+	remindersSent.Add(1)
+	return nil
+}
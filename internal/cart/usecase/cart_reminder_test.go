@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	mocks "go-store/internal/cart/mock"
+	"go-store/internal/entity"
+)
+
+func TestCartReminder(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	storageMock := mocks.NewMockCartRepository(mockCtrl)
+	brokerMock := mocks.NewMockCartBroker(mockCtrl)
+
+	conf := &entity.CartReminderConf{
+		Stages:        []time.Duration{time.Hour, 24 * time.Hour},
+		Interval:      time.Minute,
+		RestoreURL:    "https://shop.test/cart/restore",
+		CouponStage:   2,
+		CouponPercent: 10,
+		CouponTTL:     72 * time.Hour,
+	}
+
+	t.Run("send staged reminders", func(t *testing.T) {
+		storageMock.EXPECT().MarkRecovered(ctx).Return(int64(2), nil).Times(1)
+		storageMock.EXPECT().GetAbandonedCarts(ctx, any, 0, any, any).
+			Return([]*entity.AbandonedCart{{UserId: 1, Email: "john@example.com"}}, nil).Times(1)
+		storageMock.EXPECT().GetAbandonedCarts(ctx, any, 1, any, any).
+			Return([]*entity.AbandonedCart{{UserId: 2, Email: "jane@example.com"}}, nil).Times(1)
+
+		var reminders []*entity.CartReminder
+		storageMock.EXPECT().CreateReminder(ctx, any).DoAndReturn(func(_ context.Context, reminder *entity.CartReminder) error {
+			reminders = append(reminders, reminder)
+			return nil
+		}).Times(2)
+		var coupon *entity.Coupon
+		storageMock.EXPECT().CreateCoupon(ctx, any).DoAndReturn(func(_ context.Context, c *entity.Coupon) error {
+			coupon = c
+			return nil
+		}).Times(1)
+		var couponEmail []byte
+		brokerMock.EXPECT().SendEmail(ctx, "john@example.com", any, any).Return(nil).Times(1)
+		brokerMock.EXPECT().SendEmail(ctx, "jane@example.com", any, any).DoAndReturn(func(_ context.Context, _ string, _ string, message []byte) error {
+			couponEmail = message
+			return nil
+		}).Times(1)
+
+		sentBefore := remindersSent.Value()
+		recoveredBefore := cartsRecovered.Value()
+
+		reminderUsc := NewCartReminderUsecase(storageMock, brokerMock, conf)
+		err := reminderUsc.SendReminders(ctx)
+		req.NoError(err)
+
+		req.Len(reminders, 2)
+		req.Equal(1, reminders[0].Stage)
+		req.Empty(reminders[0].CouponCode)
+		req.Equal(2, reminders[1].Stage)
+		req.Equal(2, coupon.UserId)
+		req.Equal(coupon.Code, reminders[1].CouponCode)
+		req.Contains(string(couponEmail), coupon.Code)
+		req.Contains(string(couponEmail), "https://shop.test/cart/restore?token="+reminders[1].Token.String())
+		req.Equal(int64(2), remindersSent.Value()-sentBefore)
+		req.Equal(int64(2), cartsRecovered.Value()-recoveredBefore)
+	})
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	mocks "go-store/internal/cart/mock"
+	"go-store/internal/entity"
+	optionMocks "go-store/internal/option/mock"
+	productMocks "go-store/internal/product/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+func TestCart(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	storageMock := mocks.NewMockCartRepository(mockCtrl)
+	prodMock := productMocks.NewMockProductRepository(mockCtrl)
+	optionMock := optionMocks.NewMockOptionRepository(mockCtrl)
+
+	t.Run("get cart summary with warnings", func(t *testing.T) {
+		carts := []*entity.Cart{
+			{UserId: 1, SkuId: 1, Quantity: 2, Price: 10},
+			{UserId: 1, SkuId: 2, Quantity: 5, Price: 20},
+			{UserId: 1, SkuId: 3, Quantity: 1, Price: 30},
+			{UserId: 1, SkuId: 4, Quantity: 1, Price: 40},
+		}
+		storageMock.EXPECT().GetCarts(ctx, 10, 0, &entity.CartOwner{UserId: 1}).Return(carts, nil).Times(1)
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 1).
+			Return(&entity.Sku{Id: 1, Sku: "tee-red", Price: 12.5, Quantity: 10, SmallImage: "tee.png", State: entity.Enabled}, &entity.Product{ProductName: "Tee"}, nil).Times(1)
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 2).
+			Return(&entity.Sku{Id: 2, Price: 20, Quantity: 3, State: entity.Enabled}, &entity.Product{ProductName: "Cap"}, nil).Times(1)
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 3).
+			Return(&entity.Sku{Id: 3, Price: 30, Quantity: 0, State: entity.Enabled}, &entity.Product{ProductName: "Mug"}, nil).Times(1)
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 4).Return(nil, nil, errorStatus.ErrNotFound).Times(1)
+		optionMock.EXPECT().GetSkuOptions(ctx, 1).Return([]*entity.SkuOptionJson{{Option: "color", Value: "red"}}, nil).Times(1)
+		optionMock.EXPECT().GetSkuOptions(ctx, any).Return(nil, nil).Times(2)
+		storageMock.EXPECT().GetActiveCoupon(ctx, 1).Return(&entity.Coupon{Code: "SAVE10", UserId: 1, Percent: 10}, nil).Times(1)
+
+		cartUsc := &CartUsecase{
+			cartRepo:   storageMock,
+			prodRepo:   prodMock,
+			optionRepo: optionMock,
+		}
+
+		summary, err := cartUsc.GetCart(ctx, &entity.Users{Id: 1}, 10, 0)
+		req.NoError(err)
+		req.Len(summary.Items, 4)
+		req.Equal("Tee", summary.Items[0].Name)
+		req.Equal("red", summary.Items[0].Options[0].Value)
+		req.Equal(float32(25), summary.Items[0].LineTotal)
+		req.False(summary.Items[2].Available)
+		req.False(summary.Items[3].Available)
+		req.Equal(float32(125), summary.Subtotal)
+		req.Equal("SAVE10", summary.Discounts[0].Code)
+		req.Equal(float32(12.5), summary.DiscountTotal)
+		req.Equal(float32(112.5), summary.Total)
+
+		codes := make([]entity.CartWarningCode, len(summary.Warnings))
+		for idx, warning := range summary.Warnings {
+			codes[idx] = warning.Code
+		}
+		req.Equal([]entity.CartWarningCode{entity.CartPriceChanged, entity.CartInsufficientStock, entity.CartOutOfStock, entity.CartSkuDisabled}, codes)
+	})
+
+	t.Run("add cart line capped at max per order", func(t *testing.T) {
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 5).
+			Return(&entity.Sku{Id: 5, Price: 9, Quantity: 10, MaxPerOrder: 3, State: entity.Enabled}, &entity.Product{}, nil).Times(1)
+		storageMock.EXPECT().CreateCart(ctx, any, 3).Return(nil).Times(1)
+
+		cartUsc := &CartUsecase{
+			cartRepo: storageMock,
+			prodRepo: prodMock,
+		}
+		cart := &entity.Cart{UserId: 1, SkuId: 5, Quantity: 4}
+
+		err := cartUsc.CreateCart(ctx, &entity.Users{Id: 1}, cart)
+		req.NoError(err)
+		req.Equal(3, cart.Quantity)
+		req.Equal(float32(9), cart.Price)
+	})
+
+	t.Run("add cart line rejected", func(t *testing.T) {
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 6).
+			Return(&entity.Sku{Id: 6, Quantity: 0, State: entity.Enabled}, &entity.Product{}, nil).Times(1)
+		prodMock.EXPECT().GetSingleProduct(ctx, "", 7).
+			Return(&entity.Sku{Id: 7, Quantity: 5, State: entity.Deleted}, &entity.Product{}, nil).Times(1)
+
+		cartUsc := &CartUsecase{
+			cartRepo: storageMock,
+			prodRepo: prodMock,
+		}
+		user := &entity.Users{Id: 1}
+
+		err := cartUsc.CreateCart(ctx, user, &entity.Cart{UserId: 1, SkuId: 5, Quantity: 0})
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+		err = cartUsc.CreateCart(ctx, user, &entity.Cart{UserId: 1, SkuId: 6, Quantity: 1})
+		req.ErrorIs(err, errorStatus.ErrOutOfStock)
+		err = cartUsc.CreateCart(ctx, user, &entity.Cart{UserId: 1, SkuId: 7, Quantity: 1})
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
+}
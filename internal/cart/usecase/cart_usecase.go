@@ -2,87 +2,261 @@ package usecase
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strings"
 
+	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
 	"go-store/internal/entity"
 	errorStatus "go-store/utils/errors"
 )
 
+// restoreLimit is the number of lines shown by the restore link
+const restoreLimit = 100
+
 // CartUsecase will initiate usecase of entity.CartRepository interface
 type CartUsecase struct {
-	cartRepo entity.CartRepository
-	prodRepo entity.ProductRepository
+	cartRepo   entity.CartRepository
+	prodRepo   entity.ProductRepository
+	optionRepo entity.OptionRepository
+	cartSecret []byte
 }
 
-// NewCartUsecase will create new an CartUsecase object representation of entity.CartUsecase interface
-func NewCartUsecase(cart entity.CartRepository, prod entity.ProductRepository) entity.CartUsecase {
+// NewCartUsecase will create new an CartUsecase object representation of entity.CartUsecase interface,
+// cartSecret signs the tokens of anonymous carts
+func NewCartUsecase(cart entity.CartRepository, prod entity.ProductRepository, option entity.OptionRepository, cartSecret []byte) entity.CartUsecase {
 	return &CartUsecase{
-		cartRepo: cart,
-		prodRepo: prod,
+		cartRepo:   cart,
+		prodRepo:   prod,
+		optionRepo: option,
+		cartSecret: cartSecret,
 	}
 }
 
-func (o *CartUsecase) GetCart(ctx context.Context, user *entity.Users, limit int, offset int) (result []*entity.CartJson, err error) {
+func (o *CartUsecase) GetCart(ctx context.Context, user *entity.Users, limit int, offset int) (result *entity.CartSummaryJson, err error) {
 	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.GetOrders"})
 
-	var userId *int
+	var owner *entity.CartOwner
 
-	//if user not admin then find only current users cart items
-	if user.Role != entity.UserRoleAdmin {
-		userId = &user.Id
+	//without the cart:read permission find only current users (or guests) cart items
+	if !user.Role.Can(entity.PermCartRead) {
+		cartOwner := user.CartOwner()
+		owner = &cartOwner
 	}
 
-	carts, err := o.cartRepo.GetCarts(ctx, limit, offset, userId)
+	carts, err := o.cartRepo.GetCarts(ctx, limit, offset, owner)
 	if err != nil {
 		srvLog.Warning("Cannot get cart query, Err: ", err)
 		err = errorStatus.ErrInternalServer
 		return
 	}
 
-	cartResp := make([]*entity.CartJson, len(carts))
+	var couponUserId int
+	if owner != nil {
+		couponUserId = owner.UserId
+	}
+	result, err = o.summarize(ctx, carts, couponUserId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.summarize")
+		return nil, errorStatus.ErrInternalServer
+	}
+	return result, nil
+}
 
-	for idx, cart := range carts {
+// RestoreCart opens the cart of the reminder restore link and records the click
+func (o *CartUsecase) RestoreCart(ctx context.Context, token string) (result *entity.CartSummaryJson, err error) {
+	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.RestoreCart"})
 
-		cartResp[idx] = mapCartToJSON(cart)
+	reminderToken, err := uuid.Parse(token)
+	if err != nil {
+		return nil, errorStatus.ErrNotFound
+	}
+	reminder, err := o.cartRepo.GetReminder(ctx, reminderToken)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.cartRepo.GetReminder")
+		return nil, err
+	}
+	err = o.cartRepo.ClickReminder(ctx, reminder.Id)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.cartRepo.ClickReminder")
+		return nil, errorStatus.ErrInternalServer
+	}
 
+	carts, err := o.cartRepo.GetCarts(ctx, restoreLimit, 0, &entity.CartOwner{UserId: reminder.UserId})
+	if err != nil {
+		srvLog.WithError(err).Warning("o.cartRepo.GetCarts")
+		return nil, errorStatus.ErrInternalServer
+	}
+	result, err = o.summarize(ctx, carts, reminder.UserId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.summarize")
+		return nil, errorStatus.ErrInternalServer
 	}
-	result = cartResp
-	return
+	return result, nil
+}
+
+// summarize prices the cart lines and applies the active coupon of the user, if any
+func (o *CartUsecase) summarize(ctx context.Context, carts []*entity.Cart, couponUserId int) (result *entity.CartSummaryJson, err error) {
+	result = &entity.CartSummaryJson{
+		Items:     make([]*entity.CartJson, len(carts)),
+		Discounts: []*entity.CartDiscountJson{},
+		Warnings:  []*entity.CartWarningJson{},
+	}
+
+	for idx, cart := range carts {
+		item := mapCartToJSON(cart)
+		warnings, err := o.priceItem(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+		result.Items[idx] = item
+		result.Warnings = append(result.Warnings, warnings...)
+		if item.Available {
+			result.Subtotal += item.LineTotal
+		}
+	}
+	result.Subtotal = entity.RoundMoney(result.Subtotal)
+
+	if couponUserId != 0 {
+		coupon, err := o.cartRepo.GetActiveCoupon(ctx, couponUserId)
+		if err != nil && err != errorStatus.ErrNotFound {
+			return nil, err
+		}
+		if coupon != nil {
+			result.Discounts = append(result.Discounts, &entity.CartDiscountJson{
+				Code:        coupon.Code,
+				Description: fmt.Sprintf("%g%% off", coupon.Percent),
+				Amount:      coupon.Discount(result.Subtotal),
+			})
+		}
+	}
+	for _, discount := range result.Discounts {
+		result.DiscountTotal += discount.Amount
+	}
+	result.DiscountTotal = entity.RoundMoney(result.DiscountTotal)
+	result.Total = entity.RoundMoney(result.Subtotal - result.DiscountTotal)
+	return result, nil
 }
 
 func mapCartToJSON(c *entity.Cart) *entity.CartJson {
 	return &entity.CartJson{
-		UserId:   c.UserId,
-		SkuId:    c.SkuId,
-		Quantity: c.Quantity,
+		UserId:     c.UserId,
+		SkuId:      c.SkuId,
+		Quantity:   c.Quantity,
+		AddedPrice: c.Price,
+	}
+}
+
+// priceItem fills the cart line with the current sku data and reports what changed since it was added
+func (o *CartUsecase) priceItem(ctx context.Context, item *entity.CartJson) (warnings []*entity.CartWarningJson, err error) {
+	sku, product, err := o.prodRepo.GetSingleProduct(ctx, "", item.SkuId)
+	if err == errorStatus.ErrNotFound {
+		return []*entity.CartWarningJson{{SkuId: item.SkuId, Code: entity.CartSkuDisabled, Message: "product is no longer available"}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	item.SkuCode = sku.Sku
+	item.Name = product.ProductName
+	item.Image = sku.SmallImage
+	item.UnitPrice = sku.Price
+	item.LineTotal = entity.RoundMoney(sku.Price * float32(item.Quantity))
+
+	item.Options, err = o.optionRepo.GetSkuOptions(ctx, sku.Id)
+	if err != nil {
+		return nil, err
 	}
+
+	switch {
+	case sku.State != entity.Enabled:
+		warnings = append(warnings, &entity.CartWarningJson{SkuId: item.SkuId, Code: entity.CartSkuDisabled, Message: "product is no longer available"})
+	case sku.Quantity <= 0:
+		warnings = append(warnings, &entity.CartWarningJson{SkuId: item.SkuId, Code: entity.CartOutOfStock, Message: "product is out of stock"})
+	default:
+		item.Available = true
+		if item.Quantity > sku.Quantity {
+			warnings = append(warnings, &entity.CartWarningJson{SkuId: item.SkuId, Code: entity.CartInsufficientStock,
+				Message: fmt.Sprintf("only %d items left in stock", sku.Quantity)})
+		}
+	}
+	// lines added before prices were recorded have no added price
+	if item.AddedPrice != 0 && item.AddedPrice != sku.Price {
+		warnings = append(warnings, &entity.CartWarningJson{SkuId: item.SkuId, Code: entity.CartPriceChanged,
+			Message: fmt.Sprintf("price changed from %.2f to %.2f", item.AddedPrice, sku.Price)})
+	}
+	return warnings, nil
 }
 
+// CreateCart adds the sku to the cart or increments its line, the quantity is capped
+// at the stock and the per order maximum of the sku
 func (o *CartUsecase) CreateCart(ctx context.Context, user *entity.Users, cart *entity.Cart) error {
-	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.GetOrderById"})
+	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.CreateCart"})
+
+	maxQuantity, err := o.limitQuantity(ctx, cart)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.limitQuantity")
+		return err
+	}
 
-	err := o.cartRepo.CreateCart(ctx, cart)
+	err = o.cartRepo.CreateCart(ctx, cart, maxQuantity)
 	if err != nil {
-		srvLog.WithError(err).Warning("o.cartRepo.CreateOrderItem")
+		srvLog.WithError(err).Warning("o.cartRepo.CreateCart")
 		return err
 	}
 
 	return nil
 }
 
-func (o *CartUsecase) UpdateCart(ctx context.Context, user *entity.Users, order *entity.Cart) error {
-	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.UpdateOrder"})
+// UpdateCart sets the quantity of the cart line, capped like in CreateCart
+func (o *CartUsecase) UpdateCart(ctx context.Context, user *entity.Users, cart *entity.Cart) error {
+	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.UpdateCart"})
+
+	if _, err := o.limitQuantity(ctx, cart); err != nil {
+		srvLog.WithError(err).Warning("o.limitQuantity")
+		return err
+	}
 
-	err := o.cartRepo.UpdateCart(ctx, order)
+	err := o.cartRepo.UpdateCart(ctx, cart)
 	if err != nil {
-		srvLog.WithError(err).Warning("o.cartRepo.UpdateOrder")
+		srvLog.WithError(err).Warning("o.cartRepo.UpdateCart")
 		return err
 	}
 
 	return nil
 }
 
+// limitQuantity rejects skus that can't be bought, caps the quantity of the cart line
+// and records the current price to warn about changes before checkout
+func (o *CartUsecase) limitQuantity(ctx context.Context, cart *entity.Cart) (maxQuantity int, err error) {
+	if cart.Quantity <= 0 {
+		return 0, errorStatus.ErrBadReq
+	}
+
+	// disabled products aren't found at all
+	sku, _, err := o.prodRepo.GetSingleProduct(ctx, "", cart.SkuId)
+	if err != nil {
+		return 0, err
+	}
+	if sku.State != entity.Enabled {
+		return 0, errorStatus.ErrNotFound
+	}
+
+	maxQuantity = sku.MaxCartQuantity()
+	if maxQuantity <= 0 {
+		return 0, errorStatus.ErrOutOfStock
+	}
+	if cart.Quantity > maxQuantity {
+		cart.Quantity = maxQuantity
+	}
+	cart.Price = sku.Price
+	return maxQuantity, nil
+}
+
 func (o *CartUsecase) DeleteCart(ctx context.Context, cart *entity.Cart) error {
 	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.DeleteOrder"})
 
@@ -94,3 +268,51 @@ func (o *CartUsecase) DeleteCart(ctx context.Context, cart *entity.Cart) error {
 
 	return nil
 }
+
+// NewGuestToken starts an anonymous cart and returns its signed token
+func (o *CartUsecase) NewGuestToken(ctx context.Context) (token string, err error) {
+	guestId := uuid.New()
+	return guestId.String() + "." + o.signGuest(guestId), nil
+}
+
+// ParseGuestToken checks the signature of the cart token and returns the guest id
+func (o *CartUsecase) ParseGuestToken(ctx context.Context, token string) (guestId uuid.UUID, err error) {
+	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.ParseGuestToken"})
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return uuid.Nil, errorStatus.ErrToken
+	}
+	guestId, err = uuid.Parse(parts[0])
+	if err != nil {
+		srvLog.WithError(err).Warning("uuid.Parse")
+		return uuid.Nil, errorStatus.ErrToken
+	}
+	if !hmac.Equal([]byte(parts[1]), []byte(o.signGuest(guestId))) {
+		srvLog.Warning("cart token signature mismatch")
+		return uuid.Nil, errorStatus.ErrToken
+	}
+	return guestId, nil
+}
+
+// MergeGuestCart moves the anonymous cart of the token into the user cart
+func (o *CartUsecase) MergeGuestCart(ctx context.Context, token string, userId int) error {
+	srvLog := log.WithFields(log.Fields{"func": "CartUsecase.MergeGuestCart"})
+
+	guestId, err := o.ParseGuestToken(ctx, token)
+	if err != nil {
+		return err
+	}
+	err = o.cartRepo.MergeCart(ctx, guestId, userId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.cartRepo.MergeCart")
+		return err
+	}
+	return nil
+}
+
+func (o *CartUsecase) signGuest(guestId uuid.UUID) string {
+	mac := hmac.New(sha256.New, o.cartSecret)
+	mac.Write(guestId[:])
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
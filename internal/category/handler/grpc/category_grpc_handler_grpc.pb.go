@@ -0,0 +1,468 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.12.4
+// source: internal/category/handler/grpc/category_grpc_handler.proto
+
+package grpc_server
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// CategoryHandlerClient is the client API for CategoryHandler service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoryHandlerClient interface {
+	ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	GetCategory(ctx context.Context, in *CategoryIdRequest, opts ...grpc.CallOption) (*CategoryDetail, error)
+	//admin
+	CreateCategory(ctx context.Context, in *CategoryInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCategory(ctx context.Context, in *CategoryIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateCategoryOption(ctx context.Context, in *CategoryOptionRequest, opts ...grpc.CallOption) (*CategoryOptionIdRequest, error)
+	UpdateCategoryOption(ctx context.Context, in *CategoryOptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteCategoryOption(ctx context.Context, in *CategoryOptionIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateOptionValue(ctx context.Context, in *OptionValueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateOptionValue(ctx context.Context, in *OptionValueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteOptionValue(ctx context.Context, in *OptionValueIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type categoryHandlerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoryHandlerClient(cc grpc.ClientConnInterface) CategoryHandlerClient {
+	return &categoryHandlerClient{cc}
+}
+
+func (c *categoryHandlerClient) ListCategories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) GetCategory(ctx context.Context, in *CategoryIdRequest, opts ...grpc.CallOption) (*CategoryDetail, error) {
+	out := new(CategoryDetail)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/GetCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) CreateCategory(ctx context.Context, in *CategoryInput, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/CreateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/UpdateCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) DeleteCategory(ctx context.Context, in *CategoryIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/DeleteCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) CreateCategoryOption(ctx context.Context, in *CategoryOptionRequest, opts ...grpc.CallOption) (*CategoryOptionIdRequest, error) {
+	out := new(CategoryOptionIdRequest)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/CreateCategoryOption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) UpdateCategoryOption(ctx context.Context, in *CategoryOptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/UpdateCategoryOption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) DeleteCategoryOption(ctx context.Context, in *CategoryOptionIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/DeleteCategoryOption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) CreateOptionValue(ctx context.Context, in *OptionValueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/CreateOptionValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) UpdateOptionValue(ctx context.Context, in *OptionValueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/UpdateOptionValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryHandlerClient) DeleteOptionValue(ctx context.Context, in *OptionValueIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.CategoryHandler/DeleteOptionValue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoryHandlerServer is the server API for CategoryHandler service.
+// All implementations must embed UnimplementedCategoryHandlerServer
+// for forward compatibility
+type CategoryHandlerServer interface {
+	ListCategories(context.Context, *emptypb.Empty) (*ListCategoriesResponse, error)
+	GetCategory(context.Context, *CategoryIdRequest) (*CategoryDetail, error)
+	//admin
+	CreateCategory(context.Context, *CategoryInput) (*emptypb.Empty, error)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*emptypb.Empty, error)
+	DeleteCategory(context.Context, *CategoryIdRequest) (*emptypb.Empty, error)
+	CreateCategoryOption(context.Context, *CategoryOptionRequest) (*CategoryOptionIdRequest, error)
+	UpdateCategoryOption(context.Context, *CategoryOptionRequest) (*emptypb.Empty, error)
+	DeleteCategoryOption(context.Context, *CategoryOptionIdRequest) (*emptypb.Empty, error)
+	CreateOptionValue(context.Context, *OptionValueRequest) (*emptypb.Empty, error)
+	UpdateOptionValue(context.Context, *OptionValueRequest) (*emptypb.Empty, error)
+	DeleteOptionValue(context.Context, *OptionValueIdRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCategoryHandlerServer()
+}
+
+// UnimplementedCategoryHandlerServer must be embedded to have forward compatible implementations.
+type UnimplementedCategoryHandlerServer struct {
+}
+
+func (UnimplementedCategoryHandlerServer) ListCategories(context.Context, *emptypb.Empty) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedCategoryHandlerServer) GetCategory(context.Context, *CategoryIdRequest) (*CategoryDetail, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedCategoryHandlerServer) CreateCategory(context.Context, *CategoryInput) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedCategoryHandlerServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedCategoryHandlerServer) DeleteCategory(context.Context, *CategoryIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedCategoryHandlerServer) CreateCategoryOption(context.Context, *CategoryOptionRequest) (*CategoryOptionIdRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryOption not implemented")
+}
+func (UnimplementedCategoryHandlerServer) UpdateCategoryOption(context.Context, *CategoryOptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryOption not implemented")
+}
+func (UnimplementedCategoryHandlerServer) DeleteCategoryOption(context.Context, *CategoryOptionIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryOption not implemented")
+}
+func (UnimplementedCategoryHandlerServer) CreateOptionValue(context.Context, *OptionValueRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOptionValue not implemented")
+}
+func (UnimplementedCategoryHandlerServer) UpdateOptionValue(context.Context, *OptionValueRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOptionValue not implemented")
+}
+func (UnimplementedCategoryHandlerServer) DeleteOptionValue(context.Context, *OptionValueIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOptionValue not implemented")
+}
+func (UnimplementedCategoryHandlerServer) mustEmbedUnimplementedCategoryHandlerServer() {}
+
+// UnsafeCategoryHandlerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CategoryHandlerServer will
+// result in compilation errors.
+type UnsafeCategoryHandlerServer interface {
+	mustEmbedUnimplementedCategoryHandlerServer()
+}
+
+func RegisterCategoryHandlerServer(s grpc.ServiceRegistrar, srv CategoryHandlerServer) {
+	s.RegisterService(&CategoryHandler_ServiceDesc, srv)
+}
+
+func _CategoryHandler_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).ListCategories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/GetCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).GetCategory(ctx, req.(*CategoryIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/CreateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).CreateCategory(ctx, req.(*CategoryInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/UpdateCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/DeleteCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).DeleteCategory(ctx, req.(*CategoryIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_CreateCategoryOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).CreateCategoryOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/CreateCategoryOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).CreateCategoryOption(ctx, req.(*CategoryOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_UpdateCategoryOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).UpdateCategoryOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/UpdateCategoryOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).UpdateCategoryOption(ctx, req.(*CategoryOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_DeleteCategoryOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CategoryOptionIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).DeleteCategoryOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/DeleteCategoryOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).DeleteCategoryOption(ctx, req.(*CategoryOptionIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_CreateOptionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).CreateOptionValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/CreateOptionValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).CreateOptionValue(ctx, req.(*OptionValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_UpdateOptionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionValueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).UpdateOptionValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/UpdateOptionValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).UpdateOptionValue(ctx, req.(*OptionValueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryHandler_DeleteOptionValue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OptionValueIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryHandlerServer).DeleteOptionValue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.CategoryHandler/DeleteOptionValue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryHandlerServer).DeleteOptionValue(ctx, req.(*OptionValueIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoryHandler_ServiceDesc is the grpc.ServiceDesc for CategoryHandler service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CategoryHandler_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc_handler.CategoryHandler",
+	HandlerType: (*CategoryHandlerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCategories",
+			Handler:    _CategoryHandler_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _CategoryHandler_GetCategory_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _CategoryHandler_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _CategoryHandler_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _CategoryHandler_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "CreateCategoryOption",
+			Handler:    _CategoryHandler_CreateCategoryOption_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryOption",
+			Handler:    _CategoryHandler_UpdateCategoryOption_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryOption",
+			Handler:    _CategoryHandler_DeleteCategoryOption_Handler,
+		},
+		{
+			MethodName: "CreateOptionValue",
+			Handler:    _CategoryHandler_CreateOptionValue_Handler,
+		},
+		{
+			MethodName: "UpdateOptionValue",
+			Handler:    _CategoryHandler_UpdateOptionValue_Handler,
+		},
+		{
+			MethodName: "DeleteOptionValue",
+			Handler:    _CategoryHandler_DeleteOptionValue_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/category/handler/grpc/category_grpc_handler.proto",
+}
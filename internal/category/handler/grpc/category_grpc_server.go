@@ -0,0 +1,248 @@
+package grpc_server
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+type grpcServer struct {
+	usecases *entity.Usecases
+	UnimplementedCategoryHandlerServer
+}
+
+// AuthPermissions are the permissions required by the category methods, the mutations of the catalog
+var AuthPermissions = map[string]entity.Permission{
+	"/grpc_handler.CategoryHandler/ListCategories":       entity.PermGuest,
+	"/grpc_handler.CategoryHandler/GetCategory":          entity.PermGuest,
+	"/grpc_handler.CategoryHandler/CreateCategory":       entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/UpdateCategory":       entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/DeleteCategory":       entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/CreateCategoryOption": entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/UpdateCategoryOption": entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/DeleteCategoryOption": entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/CreateOptionValue":    entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/UpdateOptionValue":    entity.PermCategoryWrite,
+	"/grpc_handler.CategoryHandler/DeleteOptionValue":    entity.PermCategoryWrite,
+}
+
+// RegisterServices registers the category service on the shared gRPC server
+func RegisterServices(gsrv *grpc.Server, us *entity.Usecases) {
+	RegisterCategoryHandlerServer(gsrv, &grpcServer{
+		usecases: us,
+	})
+}
+
+// RegisterGateway routes the REST calls of the category service through the gateway mux to the gRPC server
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterCategoryHandlerHandler(ctx, mux, conn)
+}
+
+func (g *grpcServer) ListCategories(ctx context.Context, _ *emptypb.Empty) (*ListCategoriesResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.ListCategories"})
+
+	categories, err := g.usecases.CategoryUsecase.Get(ctx)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.Get")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	res := &ListCategoriesResponse{
+		Categories: make([]*Category, len(categories)),
+	}
+	for idx, cat := range categories {
+		res.Categories[idx] = &Category{
+			Id:     int32(cat.Id),
+			Name:   cat.Name,
+			Parent: int32(cat.Parent),
+			Image:  cat.Image,
+			Icon:   cat.Icon,
+		}
+	}
+	return res, nil
+}
+
+func (g *grpcServer) GetCategory(ctx context.Context, req *CategoryIdRequest) (*CategoryDetail, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.GetCategory"})
+
+	userRole := grpchelper.UserFromContext(ctx).Role
+	cat, err := g.usecases.CategoryUsecase.GetById(ctx, int(req.CategoryId), &userRole)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.GetById")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	res := &CategoryDetail{
+		Id:      int32(cat.Id),
+		Name:    cat.Name,
+		Parent:  int32(cat.Parent),
+		Image:   cat.Image,
+		Icon:    cat.Icon,
+		Options: make([]*CategoryOption, len(cat.CategoryOptions)),
+	}
+	for idx, opt := range cat.CategoryOptions {
+		option := &CategoryOption{
+			Id:     int32(opt.Id),
+			Name:   opt.Name,
+			Values: make([]*CategoryOptionValue, len(opt.OptionValueJson)),
+		}
+		for i, value := range opt.OptionValueJson {
+			option.Values[i] = &CategoryOptionValue{Id: int32(value.Id), Name: value.Name}
+		}
+		res.Options[idx] = option
+	}
+	return res, nil
+}
+
+func (g *grpcServer) CreateCategory(ctx context.Context, req *CategoryInput) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.CreateCategory"})
+
+	category, err := mapCategoryInput(req)
+	if err != nil {
+		return nil, grpchelper.StatusError(err)
+	}
+	err = g.usecases.CategoryUsecase.Create(ctx, category)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.Create")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) UpdateCategory(ctx context.Context, req *UpdateCategoryRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.UpdateCategory"})
+
+	if req.Category == nil {
+		return nil, grpchelper.StatusError(errorStatus.ErrBadReq)
+	}
+	category, err := mapCategoryInput(req.Category)
+	if err != nil {
+		return nil, grpchelper.StatusError(err)
+	}
+	category.Id = int(req.CategoryId)
+	err = g.usecases.CategoryUsecase.Update(ctx, category)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.Update")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) DeleteCategory(ctx context.Context, req *CategoryIdRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.DeleteCategory"})
+
+	err := g.usecases.CategoryUsecase.Delete(ctx, int(req.CategoryId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.Delete")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) CreateCategoryOption(ctx context.Context, req *CategoryOptionRequest) (*CategoryOptionIdRequest, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.CreateCategoryOption"})
+
+	if req.Name == "" {
+		return nil, grpchelper.StatusError(errorStatus.Violation("Name", "required"))
+	}
+	optionId, err := g.usecases.CategoryUsecase.CreateOpt(ctx, int(req.CategoryId), req.Name)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.CreateOpt")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &CategoryOptionIdRequest{OptionId: int32(*optionId)}, nil
+}
+
+func (g *grpcServer) UpdateCategoryOption(ctx context.Context, req *CategoryOptionRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.UpdateCategoryOption"})
+
+	if req.Name == "" {
+		return nil, grpchelper.StatusError(errorStatus.Violation("Name", "required"))
+	}
+	err := g.usecases.CategoryUsecase.UpdateCatOpt(ctx, int(req.CategoryId), int(req.OptionId), req.Name)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.UpdateCatOpt")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) DeleteCategoryOption(ctx context.Context, req *CategoryOptionIdRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.DeleteCategoryOption"})
+
+	err := g.usecases.CategoryUsecase.DeleteCatOpt(ctx, int(req.OptionId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.DeleteCatOpt")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) CreateOptionValue(ctx context.Context, req *OptionValueRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.CreateOptionValue"})
+
+	if req.Name == "" {
+		return nil, grpchelper.StatusError(errorStatus.Violation("Name", "required"))
+	}
+	err := g.usecases.CategoryUsecase.CreateOptValue(ctx, int(req.OptionId), req.Name)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.CreateOptValue")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) UpdateOptionValue(ctx context.Context, req *OptionValueRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.UpdateOptionValue"})
+
+	if req.Name == "" {
+		return nil, grpchelper.StatusError(errorStatus.Violation("Name", "required"))
+	}
+	err := g.usecases.CategoryUsecase.UpdateCatOptValue(ctx, int(req.OptionId), int(req.OptionValueId), req.Name)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.UpdateCatOptValue")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) DeleteOptionValue(ctx context.Context, req *OptionValueIdRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "CategoryHandler.DeleteOptionValue"})
+
+	err := g.usecases.CategoryUsecase.DeleteCatOptValue(ctx, int(req.OptionValueId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.CategoryUsecase.DeleteCatOptValue")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// mapCategoryInput reads the category like the http CategoryForm
+func mapCategoryInput(c *CategoryInput) (*entity.Category, error) {
+	if c.Name == "" {
+		return nil, errorStatus.Violation("Name", "required")
+	}
+	state := entity.Enabled
+	if c.State != "" {
+		var err error
+		state, err = entity.ParseState(c.State)
+		if err != nil {
+			return nil, errorStatus.Violation("State", "unknown state")
+		}
+	}
+	return &entity.Category{
+		Name:   c.Name,
+		Parent: int(c.Parent),
+		Image:  c.Image,
+		Icon:   c.Icon,
+		State:  state,
+	}, nil
+}
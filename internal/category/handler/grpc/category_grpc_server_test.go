@@ -0,0 +1,97 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mocks "go-store/internal/category/mock"
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+func TestCategoryServer(t *testing.T) {
+	req := require.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	catMock := mocks.NewMockCategoryUsecase(mockCtrl)
+	srv := &grpcServer{
+		usecases: &entity.Usecases{
+			CategoryUsecase: catMock,
+		},
+	}
+	guest := &entity.Users{Role: entity.UserRoleGuest}
+	ctx := grpchelper.ContextWithUser(context.Background(), guest)
+
+	t.Run("get category with its options", func(t *testing.T) {
+		cat := &entity.SingleCategoryJson{
+			Id:   2,
+			Name: "Tees",
+			CategoryOptions: []*entity.OptionJson{{
+				Id:              1,
+				Name:            "color",
+				OptionValueJson: []*entity.OptionValueJson{{Id: 5, Name: "red"}},
+			}},
+		}
+		catMock.EXPECT().GetById(ctx, 2, &guest.Role).Return(cat, nil).Times(1)
+
+		res, err := srv.GetCategory(ctx, &CategoryIdRequest{CategoryId: 2})
+		req.NoError(err)
+		req.Equal("Tees", res.Name)
+		req.Equal("color", res.Options[0].Name)
+		req.Equal("red", res.Options[0].Values[0].Name)
+	})
+
+	t.Run("get a missing category", func(t *testing.T) {
+		catMock.EXPECT().GetById(ctx, 9, &guest.Role).Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		_, err := srv.GetCategory(ctx, &CategoryIdRequest{CategoryId: 9})
+		req.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("create category", func(t *testing.T) {
+		catMock.EXPECT().Create(ctx, &entity.Category{Name: "Caps", Parent: 2, State: entity.Disabled}).Return(nil).Times(1)
+
+		_, err := srv.CreateCategory(ctx, &CategoryInput{Name: "Caps", Parent: 2, State: string(entity.Disabled)})
+		req.NoError(err)
+	})
+
+	t.Run("create category with an unknown state", func(t *testing.T) {
+		_, err := srv.CreateCategory(ctx, &CategoryInput{Name: "Caps", State: "gone"})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+		violations := grpchelper.DomainError(status.Convert(err)).Violations
+		req.Equal([]errorStatus.FieldViolation{{Field: "State", Description: "unknown state"}}, violations)
+	})
+
+	t.Run("update category", func(t *testing.T) {
+		catMock.EXPECT().Update(ctx, &entity.Category{Id: 3, Name: "Caps", State: entity.Enabled}).Return(nil).Times(1)
+
+		_, err := srv.UpdateCategory(ctx, &UpdateCategoryRequest{CategoryId: 3, Category: &CategoryInput{Name: "Caps"}})
+		req.NoError(err)
+	})
+
+	t.Run("update category without the category", func(t *testing.T) {
+		_, err := srv.UpdateCategory(ctx, &UpdateCategoryRequest{CategoryId: 3})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("create option", func(t *testing.T) {
+		optionId := 4
+		catMock.EXPECT().CreateOpt(ctx, 2, "size").Return(&optionId, nil).Times(1)
+
+		res, err := srv.CreateCategoryOption(ctx, &CategoryOptionRequest{CategoryId: 2, Name: "size"})
+		req.NoError(err)
+		req.Equal(int32(4), res.OptionId)
+	})
+
+	t.Run("create option without the name", func(t *testing.T) {
+		_, err := srv.CreateCategoryOption(ctx, &CategoryOptionRequest{CategoryId: 2})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -35,7 +35,7 @@ func NewCategoryHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entit
 		h.POST("", ch.getCategory)
 		h.POST("/:categoryId", mdw, ch.getCategoryById)
 	}
-	a := handler.Group("/admin")
+	a := handler.Group("/admin", mdw, httphelper.RequirePermission(entity.PermCategoryWrite))
 	{
 		a.POST("/category", ch.createCategory)
 		a.PUT("/category/:categoryId", ch.updateCategory)
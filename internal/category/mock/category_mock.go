@@ -0,0 +1,325 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/entity/category.go
+
+// Package categoryMock is a generated GoMock package.
+package categoryMock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entity "go-store/internal/entity"
+	reflect "reflect"
+)
+
+// MockCategoryUsecase is a mock of CategoryUsecase interface
+type MockCategoryUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryUsecaseMockRecorder
+}
+
+// MockCategoryUsecaseMockRecorder is the mock recorder for MockCategoryUsecase
+type MockCategoryUsecaseMockRecorder struct {
+	mock *MockCategoryUsecase
+}
+
+// NewMockCategoryUsecase creates a new mock instance
+func NewMockCategoryUsecase(ctrl *gomock.Controller) *MockCategoryUsecase {
+	mock := &MockCategoryUsecase{ctrl: ctrl}
+	mock.recorder = &MockCategoryUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCategoryUsecase) EXPECT() *MockCategoryUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockCategoryUsecase) Get(ctx context.Context) ([]*entity.CategoryJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]*entity.CategoryJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockCategoryUsecaseMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCategoryUsecase)(nil).Get), ctx)
+}
+
+// GetById mocks base method
+func (m *MockCategoryUsecase) GetById(ctx context.Context, categoryId int, userRole *entity.UserRole) (*entity.SingleCategoryJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, categoryId, userRole)
+	ret0, _ := ret[0].(*entity.SingleCategoryJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById
+func (mr *MockCategoryUsecaseMockRecorder) GetById(ctx, categoryId, userRole interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockCategoryUsecase)(nil).GetById), ctx, categoryId, userRole)
+}
+
+// Create mocks base method
+func (m *MockCategoryUsecase) Create(ctx context.Context, category *entity.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockCategoryUsecaseMockRecorder) Create(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryUsecase)(nil).Create), ctx, category)
+}
+
+// Update mocks base method
+func (m *MockCategoryUsecase) Update(ctx context.Context, category *entity.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockCategoryUsecaseMockRecorder) Update(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryUsecase)(nil).Update), ctx, category)
+}
+
+// Delete mocks base method
+func (m *MockCategoryUsecase) Delete(ctx context.Context, categoryId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, categoryId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockCategoryUsecaseMockRecorder) Delete(ctx, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryUsecase)(nil).Delete), ctx, categoryId)
+}
+
+// CreateOpt mocks base method
+func (m *MockCategoryUsecase) CreateOpt(ctx context.Context, categoryId int, optionName string) (*int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOpt", ctx, categoryId, optionName)
+	ret0, _ := ret[0].(*int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOpt indicates an expected call of CreateOpt
+func (mr *MockCategoryUsecaseMockRecorder) CreateOpt(ctx, categoryId, optionName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOpt", reflect.TypeOf((*MockCategoryUsecase)(nil).CreateOpt), ctx, categoryId, optionName)
+}
+
+// CreateOptValue mocks base method
+func (m *MockCategoryUsecase) CreateOptValue(ctx context.Context, optionId int, optionValueName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOptValue", ctx, optionId, optionValueName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOptValue indicates an expected call of CreateOptValue
+func (mr *MockCategoryUsecaseMockRecorder) CreateOptValue(ctx, optionId, optionValueName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOptValue", reflect.TypeOf((*MockCategoryUsecase)(nil).CreateOptValue), ctx, optionId, optionValueName)
+}
+
+// UpdateCatOpt mocks base method
+func (m *MockCategoryUsecase) UpdateCatOpt(c context.Context, categoryIdInt, optionIdInt int, optionName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCatOpt", c, categoryIdInt, optionIdInt, optionName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCatOpt indicates an expected call of UpdateCatOpt
+func (mr *MockCategoryUsecaseMockRecorder) UpdateCatOpt(c, categoryIdInt, optionIdInt, optionName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCatOpt", reflect.TypeOf((*MockCategoryUsecase)(nil).UpdateCatOpt), c, categoryIdInt, optionIdInt, optionName)
+}
+
+// UpdateCatOptValue mocks base method
+func (m *MockCategoryUsecase) UpdateCatOptValue(c context.Context, optionId, optionValueIdInt int, optionValueName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCatOptValue", c, optionId, optionValueIdInt, optionValueName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCatOptValue indicates an expected call of UpdateCatOptValue
+func (mr *MockCategoryUsecaseMockRecorder) UpdateCatOptValue(c, optionId, optionValueIdInt, optionValueName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCatOptValue", reflect.TypeOf((*MockCategoryUsecase)(nil).UpdateCatOptValue), c, optionId, optionValueIdInt, optionValueName)
+}
+
+// DeleteCatOpt mocks base method
+func (m *MockCategoryUsecase) DeleteCatOpt(c context.Context, optionId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCatOpt", c, optionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCatOpt indicates an expected call of DeleteCatOpt
+func (mr *MockCategoryUsecaseMockRecorder) DeleteCatOpt(c, optionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCatOpt", reflect.TypeOf((*MockCategoryUsecase)(nil).DeleteCatOpt), c, optionId)
+}
+
+// DeleteCatOptValue mocks base method
+func (m *MockCategoryUsecase) DeleteCatOptValue(c context.Context, optionValueId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCatOptValue", c, optionValueId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCatOptValue indicates an expected call of DeleteCatOptValue
+func (mr *MockCategoryUsecaseMockRecorder) DeleteCatOptValue(c, optionValueId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCatOptValue", reflect.TypeOf((*MockCategoryUsecase)(nil).DeleteCatOptValue), c, optionValueId)
+}
+
+// MockCategoryRepository is a mock of CategoryRepository interface
+type MockCategoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryRepositoryMockRecorder
+}
+
+// MockCategoryRepositoryMockRecorder is the mock recorder for MockCategoryRepository
+type MockCategoryRepositoryMockRecorder struct {
+	mock *MockCategoryRepository
+}
+
+// NewMockCategoryRepository creates a new mock instance
+func NewMockCategoryRepository(ctrl *gomock.Controller) *MockCategoryRepository {
+	mock := &MockCategoryRepository{ctrl: ctrl}
+	mock.recorder = &MockCategoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCategoryRepository) EXPECT() *MockCategoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockCategoryRepository) Get(ctx context.Context) ([]*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockCategoryRepositoryMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCategoryRepository)(nil).Get), ctx)
+}
+
+// GetById mocks base method
+func (m *MockCategoryRepository) GetById(ctx context.Context, categoryId int) (*entity.Category, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, categoryId)
+	ret0, _ := ret[0].(*entity.Category)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById
+func (mr *MockCategoryRepositoryMockRecorder) GetById(ctx, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockCategoryRepository)(nil).GetById), ctx, categoryId)
+}
+
+// Create mocks base method
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockCategoryRepositoryMockRecorder) Create(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCategoryRepository)(nil).Create), ctx, category)
+}
+
+// Update mocks base method
+func (m *MockCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockCategoryRepositoryMockRecorder) Update(ctx, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryRepository)(nil).Update), ctx, category)
+}
+
+// Delete mocks base method
+func (m *MockCategoryRepository) Delete(ctx context.Context, categoryId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, categoryId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockCategoryRepositoryMockRecorder) Delete(ctx, categoryId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCategoryRepository)(nil).Delete), ctx, categoryId)
+}
+
+// MockCategoryRedisRepository is a mock of CategoryRedisRepository interface
+type MockCategoryRedisRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCategoryRedisRepositoryMockRecorder
+}
+
+// MockCategoryRedisRepositoryMockRecorder is the mock recorder for MockCategoryRedisRepository
+type MockCategoryRedisRepositoryMockRecorder struct {
+	mock *MockCategoryRedisRepository
+}
+
+// NewMockCategoryRedisRepository creates a new mock instance
+func NewMockCategoryRedisRepository(ctrl *gomock.Controller) *MockCategoryRedisRepository {
+	mock := &MockCategoryRedisRepository{ctrl: ctrl}
+	mock.recorder = &MockCategoryRedisRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockCategoryRedisRepository) EXPECT() *MockCategoryRedisRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method
+func (m *MockCategoryRedisRepository) Get(ctx context.Context) ([]*entity.CategoryJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx)
+	ret0, _ := ret[0].([]*entity.CategoryJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockCategoryRedisRepositoryMockRecorder) Get(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCategoryRedisRepository)(nil).Get), ctx)
+}
@@ -0,0 +1,16 @@
+package dto
+
+// AddressRequest -.
+type AddressRequest struct {
+	Recipient       string  `json:"recipient" validate:"required,max=100"`
+	Phone           string  `json:"phone" validate:"required,phone"`
+	Country         string  `json:"country" validate:"required,max=64"`
+	RegionId        int     `json:"regionId" validate:"gte=0"`
+	City            string  `json:"city" validate:"required,max=100"`
+	Street          string  `json:"street" validate:"required,max=255"`
+	Postcode        string  `json:"postcode" validate:"max=20"`
+	Latitude        float64 `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude       float64 `json:"longitude" validate:"gte=-180,lte=180"`
+	DefaultShipping bool    `json:"defaultShipping"`
+	DefaultBilling  bool    `json:"defaultBilling"`
+}
@@ -2,6 +2,17 @@ package dto
 
 // CartListRequest -.
 type CartCreateRequest struct {
+	SkuId    int `json:"skuId" validate:"required,gt=0"`
+	Quantity int `json:"quantity" validate:"gte=0"`
+}
+
+// CartUpdateRequest is the new quantity of the cart line of the path sku
+type CartUpdateRequest struct {
+	Quantity int `json:"quantity" validate:"gte=0"`
+}
+
+// CartLineResponse -.
+type CartLineResponse struct {
 	SkuId    int `json:"skuId"`
 	Quantity int `json:"quantity"`
 }
@@ -6,16 +6,58 @@ import (
 
 // OrderListRequest -.
 type OrderListRequest struct {
-	Filter *OrderListFilter `json:"filter"`
-	Limit  int              `json:"limit" example:"10"`
-	Offset int              `json:"offset" example:"0"`
+	Limit  int    `form:"limit" example:"10" validate:"gte=0"`
+	Offset int    `form:"offset" example:"0" validate:"gte=0"`
+	Id     *int   `form:"id" example:"1"`
+	UserId *int   `form:"userId" example:"1"`
+	Phone  string `form:"phone" validate:"omitempty,max=20"`
+	Status string `form:"status" validate:"omitempty,max=50"`
+}
+
+// Filter returns the filter of the query params
+func (o *OrderListRequest) Filter() *OrderListFilter {
+	return &OrderListFilter{
+		Id:     o.Id,
+		UserId: o.UserId,
+		Phone:  o.Phone,
+		Status: o.Status,
+	}
+}
+
+// GuestOrderRequest -.
+type GuestOrderRequest struct {
+	Email string `json:"email" validate:"required,email"`
+}
+
+// OrderForm -.
+type OrderForm struct {
+	AddressId int    `form:"addressId" validate:"gte=0"`
+	Comment   string `form:"comment" validate:"max=1000"`
+	Notes     string `form:"notes" validate:"max=1000"`
+	Status    string `form:"status" validate:"max=50"`
+}
+
+// GuestOrderForm -.
+type GuestOrderForm struct {
+	Email     string  `form:"email" validate:"required,email"`
+	Comment   string  `form:"comment" validate:"max=1000"`
+	Notes     string  `form:"notes" validate:"max=1000"`
+	Recipient string  `form:"recipient" validate:"required,max=100"`
+	Phone     string  `form:"phone" validate:"required,phone"`
+	Country   string  `form:"country" validate:"required,max=64"`
+	RegionId  int     `form:"regionId" validate:"gte=0"`
+	City      string  `form:"city" validate:"required,max=100"`
+	Street    string  `form:"street" validate:"required,max=255"`
+	Postcode  string  `form:"postcode" validate:"max=20"`
+	Latitude  float64 `form:"latitude" validate:"gte=-90,lte=90"`
+	Longitude float64 `form:"longitude" validate:"gte=-180,lte=180"`
 }
 
 type OrderListFilter struct {
 	Id     *int   `json:"id,omitempty" example:"1"`
 	UserId *int   `json:"userId,omitempty" example:"1"`
-	Phone  string `json:"phone,omitempty"`
-	Status string `json:"status,omitempty"`
+	Phone  string `json:"phone,omitempty" validate:"omitempty,max=20"`
+	Status string `json:"status,omitempty" validate:"omitempty,max=50"`
 }
 
 func (o *OrderListFilter) ToSqlFilterMap() map[string]string {
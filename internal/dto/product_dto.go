@@ -6,9 +6,28 @@ import (
 
 // ProductListRequest -.
 type ProductListRequest struct {
-	Filter *ProductListFilter `json:"filter"`
-	Limit  int                `json:"limit" example:"10"`
-	Offset int                `json:"offset" example:"0"`
+	Limit       int      `form:"limit" example:"10" validate:"gte=0"`
+	Offset      int      `form:"offset" example:"0" validate:"gte=0"`
+	ProductName string   `form:"productName"`
+	Description string   `form:"description"`
+	CategoryId  *int     `form:"categoryId"`
+	BrandId     *int     `form:"brandId"`
+	RegionId    *int     `form:"regionId"`
+	PriceStart  *float32 `form:"priceStart" validate:"omitempty,gte=0"`
+	PriceEnd    *float32 `form:"priceEnd" validate:"omitempty,gte=0"`
+}
+
+// Filter returns the filter of the query params
+func (p *ProductListRequest) Filter() *ProductListFilter {
+	return &ProductListFilter{
+		ProductName: p.ProductName,
+		Description: p.Description,
+		CategoryId:  p.CategoryId,
+		BrandId:     p.BrandId,
+		RegionId:    p.RegionId,
+		PriceStart:  p.PriceStart,
+		PriceEnd:    p.PriceEnd,
+	}
 }
 
 type ProductListFilter struct {
@@ -17,14 +36,40 @@ type ProductListFilter struct {
 	CategoryId  *int     `json:"categoryId,omitempty"`
 	BrandId     *int     `json:"brandId,omitempty"`
 	RegionId    *int     `json:"regionId,omitempty"`
-	PriceStart  *float32 `json:"priceStart,omitempty"`
-	PriceEnd    *float32 `json:"priceEnd,omitempty"`
+	PriceStart  *float32 `json:"priceStart,omitempty" validate:"omitempty,gte=0"`
+	PriceEnd    *float32 `json:"priceEnd,omitempty" validate:"omitempty,gte=0"`
 }
 
 type ProductOptionRequest struct {
-	SkuId         string `json:"skuId"`
-	OptionId      int    `json:"optionId"`
-	OptionValueId int    `json:"optionValueId"`
+	SkuId         string `json:"skuId" validate:"required"`
+	OptionId      int    `json:"optionId" form:"optionId" validate:"required,gt=0"`
+	OptionValueId int    `json:"optionValueId" form:"optionValueId" validate:"required,gt=0"`
+}
+
+// ProductForm -.
+type ProductForm struct {
+	Name        string `form:"name" validate:"required,max=255"`
+	Description string `form:"description" validate:"required,max=4000"`
+	CategoryId  int    `form:"categoryId" validate:"required,gt=0"`
+	BrandId     int    `form:"brandId" validate:"required,gt=0"`
+	RegionId    int    `form:"regionId" validate:"required,gt=0"`
+	TaxClassId  int    `form:"taxClassId" validate:"gte=0"`
+}
+
+// CategoryForm -.
+type CategoryForm struct {
+	Name     string `form:"name" validate:"required,max=100"`
+	ParentId *int   `form:"parentId" validate:"required,gte=0"`
+	State    string `form:"state" validate:"omitempty,state"`
+}
+
+// SkuForm -.
+type SkuForm struct {
+	SkuCode     string  `form:"skuCode" validate:"max=64"`
+	Price       float32 `form:"price" validate:"required,gt=0"`
+	Quantity    int     `form:"quantity" validate:"gte=0"`
+	MaxPerOrder int     `form:"maxPerOrder" validate:"gte=0"`
+	State       string  `form:"state" validate:"required,state"`
 }
 
 func (p *ProductListFilter) ToSqlFilterMap() map[string]string {
@@ -0,0 +1,21 @@
+package dto
+
+// TaxClassRequest -.
+type TaxClassRequest struct {
+	Name        string `json:"name" example:"reduced" validate:"required,max=100"`
+	Description string `json:"description" validate:"max=1000"`
+}
+
+// TaxRateRequest -.
+type TaxRateRequest struct {
+	TaxClassId int     `json:"taxClassId" example:"1" validate:"required,gt=0"`
+	RegionId   int     `json:"regionId" example:"1" validate:"gte=0"`
+	Name       string  `json:"name" example:"VAT" validate:"required,max=100"`
+	Rate       float32 `json:"rate" example:"12" validate:"gte=0,lte=100"`
+	Inclusive  bool    `json:"inclusive"`
+}
+
+// TaxRateFilter -. the rates of the region come first, the other regions follow
+type TaxRateFilter struct {
+	RegionId int `form:"regionId" example:"1" validate:"gte=0"`
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Address is an entry of the user address book
+type Address struct {
+	Id              int       `json:"id"`
+	UserId          int       `json:"userId"`
+	Recipient       string    `json:"recipient" validate:"required,max=100"`
+	Phone           string    `json:"phone" validate:"required,phone"`
+	Country         string    `json:"country" validate:"required,max=64"`
+	RegionId        int       `json:"regionId" validate:"gte=0"`
+	City            string    `json:"city" validate:"required,max=100"`
+	Street          string    `json:"street" validate:"required,max=255"`
+	Postcode        string    `json:"postcode" validate:"max=20"`
+	Latitude        float64   `json:"latitude" validate:"gte=-90,lte=90"`
+	Longitude       float64   `json:"longitude" validate:"gte=-180,lte=180"`
+	DefaultShipping bool      `json:"defaultShipping"`
+	DefaultBilling  bool      `json:"defaultBilling"`
+	CreateTs        time.Time `json:"createTs"`
+	UpdateTs        time.Time `json:"updateTs"`
+	State           State     `json:"state" validate:"omitempty,state"`
+	Version         int       `json:"version"`
+}
+
+type AddressJson struct {
+	Id              int     `json:"id"`
+	Recipient       string  `json:"recipient"`
+	Phone           string  `json:"phone"`
+	Country         string  `json:"country"`
+	RegionId        int     `json:"regionId"`
+	City            string  `json:"city"`
+	Street          string  `json:"street"`
+	Postcode        string  `json:"postcode"`
+	Latitude        float64 `json:"latitude"`
+	Longitude       float64 `json:"longitude"`
+	DefaultShipping bool    `json:"defaultShipping"`
+	DefaultBilling  bool    `json:"defaultBilling"`
+}
+
+func (a *Address) SetDefaults() {
+	now := NowUTC()
+	a.State = Enabled
+	a.CreateTs = now
+	a.UpdateTs = now
+	a.Version = 0
+}
+
+// String formats the address as a single line, e.g. for couriers and invoices
+func (a *Address) String() string {
+	parts := make([]string, 0, 4)
+	for _, part := range []string{a.Street, a.City, a.Postcode, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+type AddressUsecase interface {
+	GetAddresses(ctx context.Context, user *Users) (result []*AddressJson, err error)
+	GetAddress(ctx context.Context, user *Users, addressId int) (result *AddressJson, err error)
+	CreateAddress(ctx context.Context, user *Users, address *Address) (err error)
+	UpdateAddress(ctx context.Context, user *Users, address *Address) (err error)
+	DeleteAddress(ctx context.Context, user *Users, addressId int) (err error)
+}
+
+type AddressRepository interface {
+	GetAddresses(ctx context.Context, userId int) (result []*Address, err error)
+	GetAddress(ctx context.Context, userId int, addressId int) (result *Address, err error)
+	GetDefaultShipping(ctx context.Context, userId int) (result *Address, err error)
+	CreateAddress(ctx context.Context, address *Address, txId int) (err error)
+	UpdateAddress(ctx context.Context, address *Address, txId int) (err error)
+	DeleteAddress(ctx context.Context, userId int, addressId int) (err error)
+	ResetDefaults(ctx context.Context, userId int, shipping bool, billing bool, txId int) (err error)
+	NewTxId(ctx context.Context) (txId int, err error)
+	TxEnd(ctx context.Context, txId int, err error) error
+}
@@ -3,36 +3,159 @@ package entity
 import (
 	"context"
 	"time"
+
+	"github.com/google/uuid"
 )
 
+// Cart is a cart line of a registered user or, for anonymous carts, of a guest
 type Cart struct {
 	UserId   int       `json:"userId"`
+	GuestId  uuid.UUID `json:"guestId"`
 	SkuId    int       `json:"skuId"`
 	Quantity int       `json:"quantity"`
+	Price    float32   `json:"price"`
 	CreateTs time.Time `json:"createTs"`
 	UpdateTs time.Time `json:"updateTs"`
 	State    State     `json:"state"`
 	Version  int       `json:"version"`
 }
 
+// CartJson is a cart line priced with the current sku data
 type CartJson struct {
-	UserId   int `json:"userId"`
-	SkuId    int `json:"skuId"`
-	Quantity int `json:"quantity"`
+	UserId     int              `json:"userId"`
+	SkuId      int              `json:"skuId"`
+	SkuCode    string           `json:"skuCode"`
+	Name       string           `json:"name"`
+	Image      string           `json:"image"`
+	Options    []*SkuOptionJson `json:"options"`
+	Quantity   int              `json:"quantity"`
+	UnitPrice  float32          `json:"unitPrice"`
+	AddedPrice float32          `json:"addedPrice"`
+	LineTotal  float32          `json:"lineTotal"`
+	Available  bool             `json:"available"`
+}
+
+// CartSummaryJson is the priced cart, lines that aren't available are left out of the totals
+type CartSummaryJson struct {
+	Items         []*CartJson         `json:"items"`
+	Subtotal      float32             `json:"subtotal"`
+	Discounts     []*CartDiscountJson `json:"discounts"`
+	DiscountTotal float32             `json:"discountTotal"`
+	Total         float32             `json:"total"`
+	Warnings      []*CartWarningJson  `json:"warnings"`
+}
+
+type CartDiscountJson struct {
+	Code        string  `json:"code"`
+	Description string  `json:"description"`
+	Amount      float32 `json:"amount"`
+}
+
+type CartWarningCode string
+
+const (
+	CartSkuDisabled       CartWarningCode = "sku_disabled"
+	CartOutOfStock        CartWarningCode = "out_of_stock"
+	CartInsufficientStock CartWarningCode = "insufficient_stock"
+	CartPriceChanged      CartWarningCode = "price_changed"
+)
+
+type CartWarningJson struct {
+	SkuId   int             `json:"skuId"`
+	Code    CartWarningCode `json:"code"`
+	Message string          `json:"message"`
+}
+
+// CartOwner identifies a cart, either by the registered user or by the guest of a signed cart token
+type CartOwner struct {
+	UserId  int
+	GuestId uuid.UUID
+}
+
+func (u *Users) CartOwner() CartOwner {
+	return CartOwner{UserId: u.Id, GuestId: u.GuestId}
+}
+
+func (c *Cart) Owner() CartOwner {
+	return CartOwner{UserId: c.UserId, GuestId: c.GuestId}
+}
+
+// AbandonedCart is the cart of a registered user left idle since its last update
+type AbandonedCart struct {
+	UserId   int
+	Email    string
+	UpdateTs time.Time
+}
+
+// CartReminder is a reminder email sent for an abandoned cart, Stage counts the reminders
+// since the last cart update and Token is the key of its restore link
+type CartReminder struct {
+	Id         int
+	UserId     int
+	Stage      int
+	Token      uuid.UUID
+	CouponCode string
+	SentTs     time.Time
+}
+
+// Coupon is a single use discount of a user, applied to the cart and redeemed by the next order
+type Coupon struct {
+	Code     string
+	UserId   int
+	Percent  float32
+	ExpireTs time.Time
+	CreateTs time.Time
+}
+
+// Discount is the coupon amount of the subtotal
+func (c *Coupon) Discount(subtotal float32) float32 {
+	return RoundMoney(subtotal * c.Percent / 100)
+}
+
+// CartReminderConf configures the abandoned cart reminders, Stages are the idle times
+// after the last cart update at which the consecutive reminders are sent
+type CartReminderConf struct {
+	Stages        []time.Duration
+	Interval      time.Duration
+	RestoreURL    string
+	CouponStage   int
+	CouponPercent float32
+	CouponTTL     time.Duration
 }
 
 type CartUsecase interface {
-	GetCart(ctx context.Context, user *Users, limit int, offset int) (result []*CartJson, err error)
+	GetCart(ctx context.Context, user *Users, limit int, offset int) (result *CartSummaryJson, err error)
 	CreateCart(ctx context.Context, user *Users, cart *Cart) (err error)
 	UpdateCart(ctx context.Context, user *Users, cart *Cart) (err error)
 	DeleteCart(ctx context.Context, cart *Cart) (err error)
+	NewGuestToken(ctx context.Context) (token string, err error)
+	ParseGuestToken(ctx context.Context, token string) (guestId uuid.UUID, err error)
+	MergeGuestCart(ctx context.Context, token string, userId int) (err error)
+	RestoreCart(ctx context.Context, token string) (result *CartSummaryJson, err error)
+}
+
+type CartReminderUsecase interface {
+	SendReminders(ctx context.Context) (err error)
+	Run(ctx context.Context)
 }
 
 type CartRepository interface {
-	GetCarts(ctx context.Context, limit int, offset int, userId *int) (result []*Cart, err error)
-	CreateCart(ctx context.Context, cart *Cart) (err error)
+	GetCarts(ctx context.Context, limit int, offset int, owner *CartOwner) (result []*Cart, err error)
+	CreateCart(ctx context.Context, cart *Cart, maxQuantity int) (err error)
 	UpdateCart(ctx context.Context, cart *Cart) (err error)
 	DeleteCart(ctx context.Context, cart *Cart) (err error)
+	MergeCart(ctx context.Context, guestId uuid.UUID, userId int) (err error)
+	GetAbandonedCarts(ctx context.Context, idleSince time.Time, prevStage int, prevSentBefore time.Time, limit int) (result []*AbandonedCart, err error)
+	CreateReminder(ctx context.Context, reminder *CartReminder) (err error)
+	GetReminder(ctx context.Context, token uuid.UUID) (result *CartReminder, err error)
+	ClickReminder(ctx context.Context, reminderId int) (err error)
+	MarkRecovered(ctx context.Context) (recovered int64, err error)
+	CreateCoupon(ctx context.Context, coupon *Coupon) (err error)
+	GetActiveCoupon(ctx context.Context, userId int) (result *Coupon, err error)
+}
+
+type CartBroker interface {
+	SendEmail(ctx context.Context, to string, subject string, message []byte) (err error)
 }
 
 func (c *Cart) SetDefaults() {
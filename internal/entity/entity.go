@@ -1,8 +1,9 @@
 package entity
 
 import (
-	"errors"
 	"time"
+
+	errorStatus "go-store/utils/errors"
 )
 
 type Usecases struct {
@@ -11,6 +12,8 @@ type Usecases struct {
 	ProductUsecase  ProductUsecase
 	CategoryUsecase CategoryUsecase
 	CartUsecase     CartUsecase
+	TaxUsecase      TaxUsecase
+	AddressUsecase  AddressUsecase
 }
 
 type PaginationParam struct {
@@ -25,6 +28,9 @@ const (
 	Enabled  State = "enabled"
 	Disabled State = "disabled"
 	Deleted  State = "deleted"
+	// Blocked is a user account disabled by the staff, unlike a disabled account that is
+	// waiting for its verification it can't activate itself
+	Blocked State = "blocked"
 )
 
 func NowUTC() time.Time {
@@ -44,16 +50,7 @@ func ParseState(s string) (r State, err error) {
 	}
 }
 
+// the domain errors are the ones of utils/errors, rendered the same way by both transports
 var (
-	ErrUnauthorized         = errors.New("unauthorized")
-	ErrPasswordHashMismatch = errors.New("password hash mismatch")
-	ErrTypeNotMatched       = errors.New("type not matched")
-	ErrNotFound             = errors.New("not found")
-	ErrForbidden            = errors.New("forbidden")
-	ErrAlreadyExists        = errors.New("already exists")
-	ErrBadRequest           = errors.New("bad request")
-	ErrInvalidInput         = errors.New("invalid input")
-	ErrConflict             = errors.New("conflict")
-	ErrTooManyRequests      = errors.New("too many requests")
-	ErrInternalServerError  = errors.New("internal server error")
+	ErrTypeNotMatched = errorStatus.New(errorStatus.CodeInvalidArgument, "type not matched")
 )
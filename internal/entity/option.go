@@ -36,6 +36,12 @@ type OptionValueJson struct {
 	Name string `json:"name"`
 }
 
+// SkuOptionJson is an option value selected by the sku, e.g. color: red
+type SkuOptionJson struct {
+	Option string `json:"option"`
+	Value  string `json:"value"`
+}
+
 type OptionRepository interface {
 	GetSkuValue(ctx context.Context, skuID int, userRole *UserRole) (result []*SkuValue, err error)
 	GetOption(ctx context.Context, optionID int, userRole *UserRole) (result *Option, err error)
@@ -43,6 +49,7 @@ type OptionRepository interface {
 	GetOptionValue(ctx context.Context, optionValueID int, userRole *UserRole) (result *OptionValue, err error)
 	GetOptionValueByOptId(ctx context.Context, optionID int, userRole *UserRole) (result []*OptionValueJson, err error)
 	GetOptionBySkuValue(ctx context.Context, skuValueID int, userRole *UserRole) (result *OptionJson, err error)
+	GetSkuOptions(ctx context.Context, skuID int) (result []*SkuOptionJson, err error)
 	CreateOption(ctx context.Context, option Option) (optionID *int, err error)
 	CreateOptionValue(ctx context.Context, optionValue OptionValue, txId int) (optionValueID *int, err error)
 	CreateSkuValue(ctx context.Context, sku string, skuValue *SkuValue, txId int) error
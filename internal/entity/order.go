@@ -9,31 +9,50 @@ import (
 )
 
 type Order struct {
-	Id        uuid.UUID `json:"id"`
-	UserId    int       `json:"userId"`
-	Address   string    `json:"address"`
-	Phone     string    `json:"phone"`
-	Comment   string    `json:"comment"`
-	Notes     string    `json:"notes"`
-	Status    string    `json:"status"`
-	CreateTs  time.Time `json:"createTs"`
-	UpdateTs  time.Time `json:"updateTs"`
-	State     State     `json:"state"`
-	Version   int       `json:"version"`
-	OrderItem OrderItem `db:"order_item"`
+	Id            uuid.UUID `json:"id"`
+	UserId        int       `json:"userId"`
+	RegionId      int       `json:"regionId"`
+	Email         string    `json:"email"`
+	AddressId     int       `json:"addressId"`
+	Recipient     string    `json:"recipient"`
+	Country       string    `json:"country"`
+	City          string    `json:"city"`
+	Street        string    `json:"street"`
+	Postcode      string    `json:"postcode"`
+	Latitude      float64   `json:"latitude"`
+	Longitude     float64   `json:"longitude"`
+	Address       string    `json:"address"`
+	Phone         string    `json:"phone"`
+	Comment       string    `json:"comment"`
+	Notes         string    `json:"notes"`
+	Status        string    `json:"status"`
+	Subtotal      float32   `json:"subtotal"`
+	TaxTotal      float32   `json:"taxTotal"`
+	ShippingTotal float32   `json:"shippingTotal"`
+	DiscountTotal float32   `json:"discountTotal"`
+	Total         float32   `json:"total"`
+	CreateTs      time.Time `json:"createTs"`
+	UpdateTs      time.Time `json:"updateTs"`
+	State         State     `json:"state"`
+	Version       int       `json:"version"`
+	OrderItem     OrderItem `db:"order_item"`
 }
 
 type OrderItem struct {
-	Id       int       `json:"id"`
-	OrderId  uuid.UUID `json:"orderId"`
-	SkuId    int       `json:"skuId"`
-	Quantity int       `json:"quantity"`
-	Price    float32   `json:"price"`
-	CreateTs time.Time `json:"createTs"`
-	UpdateTs time.Time `json:"updateTs"`
-	State    State     `json:"state"`
-	Version  int       `json:"version"`
-	Sku      `db:"sku"`
+	Id         int       `json:"id"`
+	OrderId    uuid.UUID `json:"orderId"`
+	SkuId      int       `json:"skuId"`
+	Quantity   int       `json:"quantity"`
+	Price      float32   `json:"price"`
+	TaxClassId int       `json:"taxClassId"`
+	TaxRate    float32   `json:"taxRate"`
+	TaxAmount  float32   `json:"taxAmount"`
+	Subtotal   float32   `json:"subtotal"`
+	CreateTs   time.Time `json:"createTs"`
+	UpdateTs   time.Time `json:"updateTs"`
+	State      State     `json:"state"`
+	Version    int       `json:"version"`
+	Sku        `db:"sku"`
 }
 
 type OrderItemSkuJson struct {
@@ -42,19 +61,27 @@ type OrderItemSkuJson struct {
 	Quantity  int     `json:"quantity"`
 	Price     float32 `json:"price"`
 	Subtotal  float32 `json:"subTotal"`
+	TaxRate   float32 `json:"taxRate"`
+	Tax       float32 `json:"tax"`
 	SmallName string  `json:"skuImage"`
 }
 
 type OrderJson struct {
 	Id        uuid.UUID          `json:"id"`
 	UserId    int                `json:"user_id"`
+	Email     string             `json:"email"`
 	Address   string             `json:"address"`
 	Phone     string             `json:"phone"`
 	Comment   string             `json:"comment"`
 	Status    string             `json:"status"`
 	CreateTs  time.Time          `json:"createTs"`
+	Delivery  *AddressJson       `json:"deliveryAddress"`
 	OrderItem []OrderItemSkuJson `json:"orderItems"`
-	TotalSum  float32            `json:"totalSum"`
+	Subtotal  float32            `json:"subtotal"`
+	Tax       float32            `json:"tax"`
+	Shipping  float32            `json:"shipping"`
+	Discount  float32            `json:"discount"`
+	Total     float32            `json:"total"`
 }
 
 func (o *Order) SetDefaults() {
@@ -64,6 +91,36 @@ func (o *Order) SetDefaults() {
 	o.Version = 0
 }
 
+// SetAddress snapshots the address book entry onto the order,
+// so later edits of the address book don't change placed orders
+func (o *Order) SetAddress(a *Address) {
+	o.AddressId = a.Id
+	o.Recipient = a.Recipient
+	o.Phone = a.Phone
+	o.Country = a.Country
+	o.City = a.City
+	o.Street = a.Street
+	o.Postcode = a.Postcode
+	o.Latitude = a.Latitude
+	o.Longitude = a.Longitude
+	o.Address = a.String()
+	if a.RegionId != 0 {
+		o.RegionId = a.RegionId
+	}
+}
+
+// CalcTotals sums up the lines into the order breakdown
+func (o *Order) CalcTotals(items []*OrderItem) {
+	var subtotal, tax float32
+	for _, item := range items {
+		subtotal += item.Subtotal
+		tax += item.TaxAmount
+	}
+	o.Subtotal = RoundMoney(subtotal)
+	o.TaxTotal = RoundMoney(tax)
+	o.Total = RoundMoney(o.Subtotal + o.TaxTotal + o.ShippingTotal - o.DiscountTotal)
+}
+
 func (o *OrderItem) SetDefaults() {
 	o.State = Enabled
 	o.CreateTs = NowUTC()
@@ -71,13 +128,75 @@ func (o *OrderItem) SetDefaults() {
 	o.Version = 0
 }
 
+// order statuses with an event type of their own
+const (
+	OrderStatusCancelled = "cancelled"
+	OrderStatusRefunded  = "refunded"
+)
+
+// OrderEventType is the kind of change pushed to the order event subscribers
+type OrderEventType string
+
+const (
+	OrderCreated       OrderEventType = "created"
+	OrderStatusChanged OrderEventType = "status_changed"
+	OrderCancelled     OrderEventType = "cancelled"
+	OrderRefunded      OrderEventType = "refunded"
+)
+
+// OrderEvent is a change of an order, the id orders the events and lets subscribers resume
+type OrderEvent struct {
+	Id       int64          `json:"id"`
+	OrderId  uuid.UUID      `json:"orderId"`
+	UserId   int            `json:"userId"`
+	Type     OrderEventType `json:"type"`
+	Status   string         `json:"status"`
+	CreateTs time.Time      `json:"createTs"`
+}
+
+// NewOrderEvent returns the event of the order in its current status
+func NewOrderEvent(order *Order, eventType OrderEventType) *OrderEvent {
+	return &OrderEvent{
+		OrderId:  order.Id,
+		UserId:   order.UserId,
+		Type:     eventType,
+		Status:   order.Status,
+		CreateTs: NowUTC(),
+	}
+}
+
+// StatusEventType returns the event type of a change to the status
+func StatusEventType(status string) OrderEventType {
+	switch status {
+	case OrderStatusCancelled:
+		return OrderCancelled
+	case OrderStatusRefunded:
+		return OrderRefunded
+	}
+	return OrderStatusChanged
+}
+
+// OrderEventFilter selects the events of one order and/or one user, zero values match all
+type OrderEventFilter struct {
+	OrderId uuid.UUID
+	UserId  int
+}
+
+func (f *OrderEventFilter) Match(e *OrderEvent) bool {
+	return (f.OrderId == uuid.Nil || f.OrderId == e.OrderId) &&
+		(f.UserId == 0 || f.UserId == e.UserId)
+}
+
 type OrderUsecase interface {
 	GetOrders(ctx context.Context, user *Users, filter *dto.OrderListFilter, limit int, offset int) (result []*OrderJson, err error)
 	GetOrderById(ctx context.Context, user *Users, orderId string) (result *OrderJson, err error)
 	CreateOrder(ctx context.Context, user *Users, order *Order) (err error)
+	CreateGuestOrder(ctx context.Context, user *Users, order *Order, address *Address) (err error)
+	GetGuestOrder(ctx context.Context, orderId string, email string) (result *OrderJson, err error)
 	UpdateOrder(ctx context.Context, user *Users, order *Order) (err error)
 	UpdateOrderStatus(ctx context.Context, user *Users, order *Order) (err error)
 	DeleteOrder(ctx context.Context, order *Order) (err error)
+	SubscribeOrderEvents(ctx context.Context, user *Users, orderId string, lastEventId int64) (events <-chan *OrderEvent, err error)
 }
 
 type OrderRepository interface {
@@ -85,10 +204,17 @@ type OrderRepository interface {
 	GetOrder(ctx context.Context, orderId string) (result *Order, err error)
 	GetItem(ctx context.Context, orderId uuid.UUID) (result []*OrderItem, err error)
 	CreateOrder(ctx context.Context, order *Order, txId int) (result *uuid.UUID, err error)
-	CreateOrderItem(ctx context.Context, orderId uuid.UUID, userId int, txId int) (err error)
-	UpdateOrder(ctx context.Context, order *Order) (err error)
-	UpdateOrderStatus(ctx context.Context, order *Order) (err error)
+	GetCartItems(ctx context.Context, owner CartOwner, txId int) (result []*OrderItem, err error)
+	CreateOrderItem(ctx context.Context, orderId uuid.UUID, items []*OrderItem, txId int) (err error)
+	ClearCart(ctx context.Context, owner CartOwner, txId int) (err error)
+	GetActiveCoupon(ctx context.Context, userId int, txId int) (result *Coupon, err error)
+	RedeemCoupon(ctx context.Context, code string, orderId uuid.UUID, txId int) (err error)
+	UpdateOrder(ctx context.Context, order *Order, txId int) (err error)
+	UpdateOrderTax(ctx context.Context, order *Order, items []*OrderItem, txId int) (err error)
+	UpdateOrderStatus(ctx context.Context, order *Order, txId int) (err error)
 	DeleteOrder(ctx context.Context, order *Order) (err error)
+	CreateOrderEvent(ctx context.Context, event *OrderEvent, txId int) (err error)
+	GetOrderEvents(ctx context.Context, filter *OrderEventFilter, afterId int64, limit int) (result []*OrderEvent, err error)
 	NewTxId(ctx context.Context) (txId int, err error)
 	TxEnd(ctx context.Context, txId int, err error) error
 }
@@ -0,0 +1,75 @@
+package entity
+
+// Permission is an action on a resource, the roles grant them and the handlers require them
+type Permission string
+
+const (
+	PermProductWrite  Permission = "product:write"  // products, skus and their options, the disabled ones are visible
+	PermCategoryWrite Permission = "category:write" // categories, options and option values
+	PermOrderRead     Permission = "order:read"     // the orders and order events of every user
+	PermOrderWrite    Permission = "order:write"    // the address and notes of any order
+	PermOrderStatus   Permission = "order:status"
+	PermCartRead      Permission = "cart:read" // the carts of every user
+	PermTaxWrite      Permission = "tax:write"
+	PermUserRead      Permission = "user:read"
+	PermUserSessions  Permission = "user:sessions" // the force logout of a user
+	PermUserUnlock    Permission = "user:unlock"   // the lockout after the failed logins
+	PermUserRoles     Permission = "user:roles"    // the role assignments
+	PermUserState     Permission = "user:state"    // the disabling and re-enabling of the accounts
+	PermMetricsRead   Permission = "metrics:read"  // the expvar counters of the process
+
+	// PermSignedIn is held by every role but the guest, it guards the calls of a user's own resources
+	PermSignedIn Permission = "signed-in"
+	// PermGuest is held by the guest as well, it marks the calls open to anyone
+	PermGuest Permission = "guest"
+)
+
+// RolePermissions is the policy, the roles missing from it grant nothing
+var RolePermissions = map[UserRole][]Permission{
+	UserRoleAdmin: {
+		PermProductWrite, PermCategoryWrite, PermOrderRead, PermOrderWrite, PermOrderStatus,
+		PermCartRead, PermTaxWrite, PermUserRead, PermUserSessions, PermUserUnlock, PermUserRoles, PermUserState,
+		PermMetricsRead,
+	},
+	UserRoleCatalogManager: {PermProductWrite, PermCategoryWrite},
+	UserRoleOrderOperator:  {PermOrderRead, PermOrderStatus},
+	UserRoleSupportAgent:   {PermUserRead, PermUserSessions, PermUserUnlock, PermOrderRead, PermCartRead},
+	UserRoleUser:           {},
+}
+
+// Roles are the roles that can be assigned, in the order they are listed
+var Roles = []UserRole{UserRoleAdmin, UserRoleCatalogManager, UserRoleOrderOperator, UserRoleSupportAgent, UserRoleUser}
+
+// RoleJson is a role with the permissions it grants
+type RoleJson struct {
+	Role        UserRole     `json:"role"`
+	Permissions []Permission `json:"permissions"`
+}
+
+// Can tells if the role grants the permission
+func (r UserRole) Can(perm Permission) bool {
+	if perm == PermGuest {
+		return true
+	}
+	permissions, ok := RolePermissions[r]
+	if !ok {
+		return false
+	}
+	if perm == PermSignedIn {
+		return true
+	}
+	for _, p := range permissions {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+func ParseRole(s string) (r UserRole, err error) {
+	rt := UserRole(s)
+	if _, ok := RolePermissions[rt]; ok || rt == UserRoleGuest {
+		return rt, nil
+	}
+	return "", ErrTypeNotMatched
+}
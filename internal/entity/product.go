@@ -13,6 +13,7 @@ type Product struct {
 	CategoryId  int       `json:"categoryId"`
 	BrandId     int       `json:"brandId"`
 	RegionId    int       `json:"regionId"`
+	TaxClassId  int       `json:"taxClassId"`
 	CreateTs    time.Time `json:"createTs"`
 	UpdateTs    time.Time `json:"updateTs"`
 	State       State     `json:"state"`
@@ -10,6 +10,7 @@ type Sku struct {
 	Sku         string    `json:"skuName"`
 	Price       float32   `json:"skuCode"`
 	Quantity    int       `json:"quaintity"`
+	MaxPerOrder int       `json:"maxPerOrder"`
 	LargeImage  string    `json:"largeImage"`
 	SmallImage  string    `json:"smallImage"`
 	ThumbImage  string    `json:"thumbImage"`
@@ -50,3 +51,12 @@ type ResultSkuJSon struct {
 	Total   int        `json:"total"`
 	SkuJson []*SkuJson `json:"products"`
 }
+
+// MaxCartQuantity is the largest quantity of the sku a cart line may hold,
+// the stock further limited by the per order maximum if it's set
+func (s *Sku) MaxCartQuantity() int {
+	if s.MaxPerOrder > 0 && s.MaxPerOrder < s.Quantity {
+		return s.MaxPerOrder
+	}
+	return s.Quantity
+}
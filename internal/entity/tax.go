@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+type TaxClass struct {
+	Id          int       `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	CreateTs    time.Time `json:"createTs"`
+	UpdateTs    time.Time `json:"updateTs"`
+	State       State     `json:"state"`
+	Version     int       `json:"version"`
+}
+
+// TaxRate is a percent rate of a tax class inside a region.
+// Inclusive rates mean the sku price already contains the tax.
+type TaxRate struct {
+	Id         int       `json:"id"`
+	TaxClassId int       `json:"taxClassId"`
+	RegionId   int       `json:"regionId"`
+	Name       string    `json:"name"`
+	Rate       float32   `json:"rate"`
+	Inclusive  bool      `json:"inclusive"`
+	CreateTs   time.Time `json:"createTs"`
+	UpdateTs   time.Time `json:"updateTs"`
+	State      State     `json:"state"`
+	Version    int       `json:"version"`
+}
+
+type TaxClassJson struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type TaxRateJson struct {
+	Id         int     `json:"id"`
+	TaxClassId int     `json:"taxClassId"`
+	RegionId   int     `json:"regionId"`
+	Name       string  `json:"name"`
+	Rate       float32 `json:"rate"`
+	Inclusive  bool    `json:"inclusive"`
+}
+
+func (t *TaxClass) SetDefaults() {
+	now := NowUTC()
+	t.State = Enabled
+	t.CreateTs = now
+	t.UpdateTs = now
+	t.Version = 0
+}
+
+func (t *TaxRate) SetDefaults() {
+	now := NowUTC()
+	t.State = Enabled
+	t.CreateTs = now
+	t.UpdateTs = now
+	t.Version = 0
+}
+
+// Apply splits the amount of quantity units by price into net and tax parts.
+// A nil rate means the line is not taxable.
+func (t *TaxRate) Apply(price float32, quantity int) (net float32, tax float32) {
+	gross := RoundMoney(price * float32(quantity))
+	if t == nil || t.Rate == 0 {
+		return gross, 0
+	}
+	if t.Inclusive {
+		tax = RoundMoney(gross - gross/(1+t.Rate/100))
+		return RoundMoney(gross - tax), tax
+	}
+	return gross, RoundMoney(gross * t.Rate / 100)
+}
+
+// RoundMoney rounds amount to cents
+func RoundMoney(amount float32) float32 {
+	return float32(math.Round(float64(amount)*100) / 100)
+}
+
+type TaxUsecase interface {
+	GetClasses(ctx context.Context) (result []*TaxClassJson, err error)
+	CreateClass(ctx context.Context, class *TaxClass) (err error)
+	UpdateClass(ctx context.Context, class *TaxClass) (err error)
+	DeleteClass(ctx context.Context, classId int) (err error)
+	GetRates(ctx context.Context, regionId int) (result []*TaxRateJson, err error)
+	CreateRate(ctx context.Context, rate *TaxRate) (err error)
+	UpdateRate(ctx context.Context, rate *TaxRate) (err error)
+	DeleteRate(ctx context.Context, rateId int) (err error)
+}
+
+type TaxRepository interface {
+	GetClasses(ctx context.Context) (result []*TaxClass, err error)
+	CreateClass(ctx context.Context, class *TaxClass) (err error)
+	UpdateClass(ctx context.Context, class *TaxClass) (err error)
+	DeleteClass(ctx context.Context, classId int) (err error)
+	GetRates(ctx context.Context, regionId int) (result []*TaxRate, err error)
+	GetRatesByRegion(ctx context.Context, regionId int) (result []*TaxRate, err error)
+	CreateRate(ctx context.Context, rate *TaxRate) (err error)
+	UpdateRate(ctx context.Context, rate *TaxRate) (err error)
+	DeleteRate(ctx context.Context, rateId int) (err error)
+}
@@ -4,28 +4,34 @@ import (
 	"context"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
+
+	"go-store/utils/jwt"
+	"go-store/utils/oidc"
 )
 
 type Users struct {
-	Id               int
-	PublicId         uuid.UUID `db:"public_id"`
-	Username         string    `db:"author"`
-	FullName         string    `db:"full_name"`
-	Password         string    `db:"password"`
-	Email            string    `db:"email"`
-	PhoneNumber      string    `db:"phone_number"`
-	Address          string    `db:"address"`
-	Photo            string    `db:"photo"`
-	Role             UserRole  `db:"user_role"`
-	RegionId         int       `db:"region_id"`
-	Parent           int       `db:"parent"`
-	VerificationCode string    `db:"verification_code"`
-	CreateTs         time.Time `json:"createTs"`
-	UpdateTs         time.Time `json:"updateTs"`
-	State            State     `db:"state"`
-	Version          int       `db:"version"`
+	Id          int
+	PublicId    uuid.UUID `db:"public_id"`
+	Username    string    `db:"author"`
+	FullName    string    `db:"full_name"`
+	Password    string    `db:"password"`
+	Email       string    `db:"email" validate:"omitempty,email"`
+	PhoneNumber string    `db:"phone_number" validate:"omitempty,phone"`
+	Address     string    `db:"address"`
+	Photo       string    `db:"photo"`
+	Role        UserRole  `db:"user_role" validate:"omitempty,role"`
+	RegionId    int       `db:"region_id"`
+	Parent      int       `db:"parent"`
+	GuestId     uuid.UUID `db:"-"`           // anonymous requests are identified by the signed cart token
+	SessionId   string    `db:"-"`           // the session of the access token
+	TotpSecret  string    `db:"totp_secret"` // set once the two factor authentication is enrolled
+	CreateTs    time.Time `json:"createTs"`
+	UpdateTs    time.Time `json:"updateTs"`
+	State       State     `db:"state" validate:"omitempty,state"`
+	Version     int       `db:"version"`
+	// set once the owner of the email proved it, by an emailed code or link or by a login provider
+	EmailVerified bool `db:"email_verified"`
 }
 
 type (
@@ -33,9 +39,12 @@ type (
 )
 
 const (
-	UserRoleAdmin UserRole = "ADMIN"
-	UserRoleUser  UserRole = "USER"
-	UserRoleGuest UserRole = "GUEST"
+	UserRoleAdmin          UserRole = "ADMIN"
+	UserRoleCatalogManager UserRole = "CATALOG_MANAGER"
+	UserRoleOrderOperator  UserRole = "ORDER_OPERATOR"
+	UserRoleSupportAgent   UserRole = "SUPPORT_AGENT"
+	UserRoleUser           UserRole = "USER"
+	UserRoleGuest          UserRole = "GUEST"
 )
 
 type Claims struct {
@@ -47,9 +56,10 @@ type Claims struct {
 type JwtClaims struct {
 	Id       int      `json:"id"`
 	UID      string   `json:"uid"`
+	Session  string   `json:"sid"`
 	Username string   `json:"username"`
 	Role     UserRole `json:"role"`
-	jwt.StandardClaims
+	jwt.RegisteredClaims
 	Tokens Tokens `json:"tokens"`
 }
 
@@ -63,47 +73,360 @@ type Signatures struct {
 	RefreshSign string `json:"refresh"`
 }
 
+// Session is a signed in device of a user, the signatures are the uids of its current token pair.
+// It is cached until the user is idle for the auto logoff timeout
+type Session struct {
+	Id         string     `json:"id"`
+	UserId     int        `json:"userId"`
+	Device     string     `json:"device"`
+	IP         string     `json:"ip"`
+	UserAgent  string     `json:"userAgent"`
+	Signatures Signatures `json:"signatures"`
+	CreateTs   time.Time  `json:"createTs"`
+	LastSeenTs time.Time  `json:"lastSeenTs"`
+}
+
+// SessionClient describes the device signing in
+type SessionClient struct {
+	Device    string `json:"device"`
+	IP        string `json:"ip"`
+	UserAgent string `json:"userAgent"`
+}
+
+// MfaConf holds the issuer shown by the authenticator apps and the roles that can't log in
+// without the second factor, a challenge is dropped after MaxAttempts failed codes
+type MfaConf struct {
+	Issuer        string
+	EnforcedRoles []UserRole
+	ChallengeTTL  time.Duration
+	MaxAttempts   int
+	RecoveryCodes int
+}
+
+// MfaChallenge is the second step of a login, it keeps the device of the first one.
+// The enroll challenge of a user without the totp sets it up before the tokens are issued
+type MfaChallenge struct {
+	Id       string        `json:"id"`
+	UserId   int           `json:"userId"`
+	Username string        `json:"username"`
+	Client   SessionClient `json:"client"`
+	Enroll   bool          `json:"enroll"`
+	Secret   string        `json:"secret"` // the totp secret being enrolled
+	ExpireTs time.Time     `json:"expireTs"`
+}
+
+// MfaChallengeJson is returned by the login instead of the tokens
+type MfaChallengeJson struct {
+	Id       string    `json:"id"`
+	Enroll   bool      `json:"enroll"`
+	ExpireTs time.Time `json:"expireTs"`
+}
+
+// TotpEnrollment is the secret to add to an authenticator app, the uri is shown as a QR code
+type TotpEnrollment struct {
+	Secret string `json:"secret"`
+	URI    string `json:"uri"`
+}
+
+// SessionJson is the session shown to its user, without the signatures
+type SessionJson struct {
+	Id         string    `json:"id"`
+	Device     string    `json:"device"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"userAgent"`
+	CreateTs   time.Time `json:"createTs"`
+	LastSeenTs time.Time `json:"lastSeenTs"`
+	Current    bool      `json:"current"`
+}
+
 type TokenConf struct {
 	AccesTokenTimeout   time.Duration
 	RefreshTokenTimeout time.Duration
 	AutoLogoffTimeout   time.Duration
-	AccessSecret        []byte
-	RefreshSecret       []byte
+	AccessKeys          *jwt.KeySet // the asymmetric keys, the other services verify the access tokens by the jwks
+	RefreshKeys         *jwt.KeySet
+	CartSecret          []byte
+}
+
+const (
+	SendMethodEmail = "email"
+	SendMethodSms   = "sms"
+)
+
+// Verification is the pending activation code of an account, it is cached until ExpireTs.
+// The failed attempts are counted by the id, so a resent code starts a new count
+type Verification struct {
+	Id       string    `json:"id"`
+	Code     string    `json:"code"`
+	Method   string    `json:"method"`
+	SentTs   time.Time `json:"sentTs"`
+	ExpireTs time.Time `json:"expireTs"`
+}
+
+// VerificationConf holds the length and lifetime of the activation codes, a code is dropped
+// after MaxAttempts failed attempts and a new one is sent at most once per ResendCooldown.
+// The resend requests of an IP are limited to IPLimit within RateWindow
+type VerificationConf struct {
+	CodeLength     int
+	CodeTTL        time.Duration
+	MaxAttempts    int
+	ResendCooldown time.Duration
+	RateWindow     time.Duration
+	IPLimit        int
+}
+
+// PasswordResetConf holds the signing secret and lifetime of the reset tokens,
+// the reset requests are limited per account and per IP within RateWindow
+type PasswordResetConf struct {
+	Secret       []byte
+	TokenTimeout time.Duration
+	ResetURL     string
+	RateWindow   time.Duration
+	AccountLimit int
+	IPLimit      int
+}
+
+// LockoutConf limits the failed logins, every failure of a username doubles the Backoff up to
+// MaxBackoff before its next attempt until MaxAttempts within Window lock it for LockDuration.
+// The failures of an IP are counted across the usernames and block it for the Window
+type LockoutConf struct {
+	MaxAttempts   int
+	IPMaxAttempts int
+	Window        time.Duration
+	Backoff       time.Duration
+	MaxBackoff    time.Duration
+	LockDuration  time.Duration
+}
+
+// OidcConf holds the OpenID Connect providers by their names in the login urls,
+// an authorization is completed by the callback within StateTTL
+type OidcConf struct {
+	Providers map[string]*oidc.Provider
+	StateTTL  time.Duration
+}
+
+// OidcState is the pending authorization of a browser, it is cached under its state
+// parameter and taken once by the callback
+type OidcState struct {
+	Provider string    `json:"provider"`
+	Nonce    string    `json:"nonce"`
+	Verifier string    `json:"verifier"` // the PKCE code verifier of the challenge sent to the provider
+	ExpireTs time.Time `json:"expireTs"`
+}
+
+// UserIdentity links the account of a provider to the user, the subject is stable across its logins
+type UserIdentity struct {
+	Provider string
+	Subject  string
+	UserId   int
+	Email    string
+	CreateTs time.Time
+}
+
+// UserProfile is the account shown to its user, the pending email waits for its code
+type UserProfile struct {
+	PublicId     uuid.UUID `json:"publicId"`
+	Username     string    `json:"username"`
+	FullName     string    `json:"fullName"`
+	Email        string    `json:"email"`
+	PendingEmail string    `json:"pendingEmail,omitempty"`
+	PhoneNumber  string    `json:"phoneNumber"`
+	Address      string    `json:"address"`
+	Photo        string    `json:"photo"`
+	RegionId     int       `json:"regionId"`
+	Role         UserRole  `json:"role"`
+	TotpEnabled  bool      `json:"totpEnabled"`
+	CreateTs     time.Time `json:"createTs"`
+}
+
+// ProfileUpdate are the fields of the profile to change, the nil ones are kept
+type ProfileUpdate struct {
+	FullName    *string
+	PhoneNumber *string
+	Address     *string
+	RegionId    *int
+}
+
+// EmailChange is the new email of a user waiting for the code sent to it, it is cached until ExpireTs
+type EmailChange struct {
+	Id       string    `json:"id"`
+	Email    string    `json:"email"`
+	Code     string    `json:"code"`
+	SentTs   time.Time `json:"sentTs"`
+	ExpireTs time.Time `json:"expireTs"`
+}
+
+// UserFilter selects the users of the admin search, the zero values match all. The email and
+// the phone number match their parts, the registration is within [CreatedFrom, CreatedTo)
+type UserFilter struct {
+	Email       string
+	PhoneNumber string
+	State       State
+	Role        UserRole
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Limit       int
+	Offset      int
+}
+
+// UserSummary is a user found by the admin search
+type UserSummary struct {
+	Id          int       `json:"id"`
+	PublicId    uuid.UUID `json:"publicId"`
+	Username    string    `json:"username"`
+	FullName    string    `json:"fullName"`
+	Email       string    `json:"email"`
+	PhoneNumber string    `json:"phoneNumber"`
+	Role        UserRole  `json:"role"`
+	State       State     `json:"state"`
+	TotpEnabled bool      `json:"totpEnabled"`
+	CreateTs    time.Time `json:"createTs"`
+}
+
+type ResultUserJson struct {
+	Total int            `json:"total"`
+	Users []*UserSummary `json:"users"`
+}
+
+// UserDetail is a user shown to the staff with its orders, the lifetime spend leaves out
+// the cancelled and refunded orders
+type UserDetail struct {
+	UserSummary
+	Address       string    `json:"address"`
+	RegionId      int       `json:"regionId"`
+	UpdateTs      time.Time `json:"updateTs"`
+	Providers     []string  `json:"providers"` // the linked OpenID Connect providers
+	OrderCount    int       `json:"orderCount"`
+	LifetimeSpend float64   `json:"lifetimeSpend"`
+}
+
+// the actions of the staff on the users
+const (
+	AuditRoleAssigned = "role_assigned"
+	AuditStateChanged = "state_changed"
+	AuditUnlocked     = "unlocked"
+)
+
+// UserAudit is an action of the staff on a user, the detail is the new value of the action
+type UserAudit struct {
+	Id       int64     `json:"id"`
+	UserId   int       `json:"userId"`
+	ActorId  int       `json:"actorId"`
+	Action   string    `json:"action"`
+	Detail   string    `json:"detail"`
+	Reason   string    `json:"reason,omitempty"`
+	CreateTs time.Time `json:"createTs"`
 }
 
 type UserJson struct {
+	Id       int       `json:"-"`
 	PublicId uuid.UUID `json:"public_id"`
 	Username string    `json:"username"`
 	Role     UserRole  `json:"role"`
 	Tokens   Tokens    `json:"tokens"`
+	// the second step of the login, the tokens are empty until it is passed
+	Challenge     *MfaChallengeJson `json:"challenge,omitempty"`
+	RecoveryCodes []string          `json:"recoveryCodes,omitempty"`
 }
 
 type UserUsecase interface {
-	Login(ctx context.Context, username string, password string, tokenConf *TokenConf) (userJs *UserJson, err error)
-	Logout(ctx context.Context, userId int) (err error)
+	Login(ctx context.Context, username string, password string, client *SessionClient, tokenConf *TokenConf) (userJs *UserJson, err error)
+	Logout(ctx context.Context, userId int, sessionId string) (err error)
 	Refresh(ctx context.Context, claims *JwtClaims, tokenConf *TokenConf) (userJs *UserJson, err error)
-	ParseToken(ctx context.Context, tokenStr string, secret []byte) (claims *JwtClaims, err error)
+	ParseToken(ctx context.Context, tokenStr string, keys *jwt.KeySet) (claims *JwtClaims, err error)
 	ValidateToken(ctx context.Context, claims *JwtClaims, tokenMdlw string, isRefresh bool) (user *Users, err error)
-	TokenExpire(ctx context.Context, userId int, timeExp time.Duration) (err error)
+	TokenExpire(ctx context.Context, claims *JwtClaims, timeExp time.Duration) (err error)
+	ListSessions(ctx context.Context, user *Users) (sessions []*SessionJson, err error)
+	RevokeSession(ctx context.Context, userId int, sessionId string) (err error)
+	RevokeSessions(ctx context.Context, userId int) (err error)
 	CreateUser(ctx context.Context, user *Users, sendMethod *string) (err error)
 	ActivateUser(ctx context.Context, username string, verificationCode string) (err error)
+	ResendVerification(ctx context.Context, username string, sendMethod string, ip string) (err error)
+	RequestPasswordReset(ctx context.Context, email string, ip string) (err error)
+	ConfirmPasswordReset(ctx context.Context, token string, password string) (err error)
+	VerifyMfa(ctx context.Context, challengeId string, code string, tokenConf *TokenConf) (userJs *UserJson, err error)
+	EnrollMfaChallenge(ctx context.Context, challengeId string) (enrollment *TotpEnrollment, err error)
+	EnrollTotp(ctx context.Context, user *Users) (enrollment *TotpEnrollment, err error)
+	ConfirmTotp(ctx context.Context, user *Users, code string) (recoveryCodes []string, err error)
+	DisableTotp(ctx context.Context, user *Users, code string) (err error)
+	AssignRole(ctx context.Context, admin *Users, userId int, role UserRole) (err error)
+	UnlockUser(ctx context.Context, staff *Users, userId int) (err error)
+	OidcAuthURL(ctx context.Context, provider string) (authURL string, err error)
+	OidcLogin(ctx context.Context, provider string, state string, code string, client *SessionClient, tokenConf *TokenConf) (userJs *UserJson, err error)
+	GetProfile(ctx context.Context, user *Users) (profile *UserProfile, err error)
+	UpdateProfile(ctx context.Context, user *Users, update *ProfileUpdate) (profile *UserProfile, err error)
+	UpdatePhoto(ctx context.Context, user *Users, photo string) (profile *UserProfile, err error)
+	ChangePassword(ctx context.Context, user *Users, password string, newPassword string) (err error)
+	RequestEmailChange(ctx context.Context, user *Users, email string, password string) (err error)
+	ConfirmEmailChange(ctx context.Context, user *Users, code string) (err error)
+	DeleteAccount(ctx context.Context, user *Users, password string) (err error)
+	SearchUsers(ctx context.Context, staff *Users, filter *UserFilter) (result *ResultUserJson, err error)
+	UserDetail(ctx context.Context, staff *Users, userId int) (detail *UserDetail, err error)
+	SetUserState(ctx context.Context, admin *Users, userId int, state State, reason string) (err error)
+	UserAuditLog(ctx context.Context, staff *Users, userId int, limit int, offset int) (audits []*UserAudit, err error)
 }
 
 type AuthPgxRepository interface {
 	UserByUsername(ctx context.Context, username string) (creds *Users, err error)
+	UserByEmail(ctx context.Context, email string) (creds *Users, err error)
+	UserById(ctx context.Context, userId int) (creds *Users, err error)
 	Create(ctx context.Context, user *Users) (err error)
 	Update(ctx context.Context, user *Users) (err error)
+	UpdatePassword(ctx context.Context, userId int, password string) (err error)
+	UpdateState(ctx context.Context, userId int, state State) (err error)
+	VerifyEmail(ctx context.Context, userId int) (err error)
+	EnableTotp(ctx context.Context, userId int, secret string, recoveryHashes []string) (err error)
+	DisableTotp(ctx context.Context, userId int) (err error)
+	UseRecoveryCode(ctx context.Context, userId int, recoveryHash string) (used bool, err error)
+	UpdateRole(ctx context.Context, userId int, role UserRole) (err error)
+	UserByIdentity(ctx context.Context, provider string, subject string) (creds *Users, err error)
+	CreateIdentity(ctx context.Context, identity *UserIdentity) (err error)
+	CreateWithIdentity(ctx context.Context, user *Users, identity *UserIdentity) (err error)
+	UserProfile(ctx context.Context, userId int) (profile *UserProfile, err error)
+	UpdateProfile(ctx context.Context, userId int, update *ProfileUpdate) (err error)
+	UpdatePhoto(ctx context.Context, userId int, photo string) (err error)
+	UpdateEmail(ctx context.Context, userId int, email string) (err error)
+	AnonymizeUser(ctx context.Context, userId int) (err error)
+	SearchUsers(ctx context.Context, filter *UserFilter) (result *ResultUserJson, err error)
+	UserDetail(ctx context.Context, userId int) (detail *UserDetail, err error)
+	CreateAudit(ctx context.Context, audit *UserAudit) (err error)
+	AuditLog(ctx context.Context, userId int, limit int, offset int) (audits []*UserAudit, err error)
 }
 
 type AuthRedisRepository interface {
 	GetUser(ctx context.Context, username string) (creds *Claims, err error)
 	SetUserCtx(ctx context.Context, username string, seconds int, creds *Claims) error
-	GetUserToken(ctx context.Context, userId int) (tokens *Signatures, err error)
-	SetUserToken(ctx context.Context, user *Signatures, userId int, timeExp time.Duration) error
-	ExpireUserToken(ctx context.Context, userId int, timeExp time.Duration) (err error)
-	DeleteUserToken(ctx context.Context, userId int) error
+	GetSession(ctx context.Context, sessionId string) (session *Session, err error)
+	SetSession(ctx context.Context, session *Session, timeExp time.Duration) error
+	TouchSession(ctx context.Context, userId int, sessionId string, lastSeenTs time.Time, timeExp time.Duration) (touched bool, err error)
+	ListSessions(ctx context.Context, userId int) (sessions []*Session, err error)
+	DeleteSession(ctx context.Context, userId int, sessionId string) error
+	DeleteUserSessions(ctx context.Context, userId int) error
+	UseRefreshToken(ctx context.Context, tokenId string, sessionId string, timeExp time.Duration) (firstUse bool, err error)
+	SetResetToken(ctx context.Context, userId int, tokenId string, timeExp time.Duration) error
+	TakeResetToken(ctx context.Context, userId int) (tokenId string, err error)
+	IncrRate(ctx context.Context, key string, window time.Duration) (count int64, err error)
+	ResetRate(ctx context.Context, key string) error
+	SetLock(ctx context.Context, key string, timeExp time.Duration) error
+	LockTTL(ctx context.Context, key string) (ttl time.Duration, err error)
+	DeleteLock(ctx context.Context, key string) (locked bool, err error)
+	SetVerification(ctx context.Context, userId int, verification *Verification) error
+	GetVerification(ctx context.Context, userId int) (verification *Verification, err error)
+	DeleteVerification(ctx context.Context, userId int) error
+	SetMfaChallenge(ctx context.Context, challenge *MfaChallenge) error
+	GetMfaChallenge(ctx context.Context, challengeId string) (challenge *MfaChallenge, err error)
+	DeleteMfaChallenge(ctx context.Context, challengeId string) error
+	SetTotpPending(ctx context.Context, userId int, secret string, timeExp time.Duration) error
+	GetTotpPending(ctx context.Context, userId int) (secret string, err error)
+	DeleteTotpPending(ctx context.Context, userId int) error
+	SetOidcState(ctx context.Context, state string, oidcState *OidcState) error
+	TakeOidcState(ctx context.Context, state string) (oidcState *OidcState, err error)
+	SetEmailChange(ctx context.Context, userId int, change *EmailChange) error
+	GetEmailChange(ctx context.Context, userId int) (change *EmailChange, err error)
+	DeleteEmailChange(ctx context.Context, userId int) error
 }
 
 type AuthBroker interface {
-	SendEmail(ctx context.Context, to string, message []byte) (err error)
+	SendEmail(ctx context.Context, to string, subject string, message []byte) (err error)
+	SendSms(ctx context.Context, to string, message []byte) (err error)
 }
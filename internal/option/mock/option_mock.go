@@ -6,170 +6,212 @@ package optionMock
 
 import (
 	context "context"
+	gomock "github.com/golang/mock/gomock"
 	entity "go-store/internal/entity"
 	reflect "reflect"
-
-	gomock "github.com/golang/mock/gomock"
 )
 
-// MockOptionRepository is a mock of OptionRepository interface.
+// MockOptionRepository is a mock of OptionRepository interface
 type MockOptionRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockOptionRepositoryMockRecorder
 }
 
-// MockOptionRepositoryMockRecorder is the mock recorder for MockOptionRepository.
+// MockOptionRepositoryMockRecorder is the mock recorder for MockOptionRepository
 type MockOptionRepositoryMockRecorder struct {
 	mock *MockOptionRepository
 }
 
-// NewMockOptionRepository creates a new mock instance.
+// NewMockOptionRepository creates a new mock instance
 func NewMockOptionRepository(ctrl *gomock.Controller) *MockOptionRepository {
 	mock := &MockOptionRepository{ctrl: ctrl}
 	mock.recorder = &MockOptionRepositoryMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
+// EXPECT returns an object that allows the caller to indicate expected use
 func (m *MockOptionRepository) EXPECT() *MockOptionRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateOption mocks base method.
-func (m *MockOptionRepository) CreateOption(ctx context.Context, option entity.Option) (*int, error) {
+// GetSkuValue mocks base method
+func (m *MockOptionRepository) GetSkuValue(ctx context.Context, skuID int, userRole *entity.UserRole) ([]*entity.SkuValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOption", ctx, option)
-	ret0, _ := ret[0].(*int)
+	ret := m.ctrl.Call(m, "GetSkuValue", ctx, skuID, userRole)
+	ret0, _ := ret[0].([]*entity.SkuValue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOption indicates an expected call of CreateOption.
-func (mr *MockOptionRepositoryMockRecorder) CreateOption(ctx, option interface{}) *gomock.Call {
+// GetSkuValue indicates an expected call of GetSkuValue
+func (mr *MockOptionRepositoryMockRecorder) GetSkuValue(ctx, skuID, userRole interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOption", reflect.TypeOf((*MockOptionRepository)(nil).CreateOption), ctx, option)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkuValue", reflect.TypeOf((*MockOptionRepository)(nil).GetSkuValue), ctx, skuID, userRole)
 }
 
-// CreateOptionValue mocks base method.
-func (m *MockOptionRepository) CreateOptionValue(ctx context.Context, optionValue entity.OptionValue) (*int, error) {
+// GetOption mocks base method
+func (m *MockOptionRepository) GetOption(ctx context.Context, optionID int, userRole *entity.UserRole) (*entity.Option, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOptionValue", ctx, optionValue)
-	ret0, _ := ret[0].(*int)
+	ret := m.ctrl.Call(m, "GetOption", ctx, optionID, userRole)
+	ret0, _ := ret[0].(*entity.Option)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateOptionValue indicates an expected call of CreateOptionValue.
-func (mr *MockOptionRepositoryMockRecorder) CreateOptionValue(ctx, optionValue interface{}) *gomock.Call {
+// GetOption indicates an expected call of GetOption
+func (mr *MockOptionRepositoryMockRecorder) GetOption(ctx, optionID, userRole interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).CreateOptionValue), ctx, optionValue)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOption", reflect.TypeOf((*MockOptionRepository)(nil).GetOption), ctx, optionID, userRole)
 }
 
-// CreateSkuValue mocks base method.
-func (m *MockOptionRepository) CreateSkuValue(ctx context.Context, skuID, optionId, optionValueId int) error {
+// GetOptionByCat mocks base method
+func (m *MockOptionRepository) GetOptionByCat(ctx context.Context, categoryId int, userRole *entity.UserRole) ([]*entity.Option, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSkuValue", ctx, skuID, optionId, optionValueId)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetOptionByCat", ctx, categoryId, userRole)
+	ret0, _ := ret[0].([]*entity.Option)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateSkuValue indicates an expected call of CreateSkuValue.
-func (mr *MockOptionRepositoryMockRecorder) CreateSkuValue(ctx, skuID, optionId, optionValueId interface{}) *gomock.Call {
+// GetOptionByCat indicates an expected call of GetOptionByCat
+func (mr *MockOptionRepositoryMockRecorder) GetOptionByCat(ctx, categoryId, userRole interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkuValue", reflect.TypeOf((*MockOptionRepository)(nil).CreateSkuValue), ctx, skuID, optionId, optionValueId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionByCat", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionByCat), ctx, categoryId, userRole)
 }
 
-// GetOption mocks base method.
-func (m *MockOptionRepository) GetOption(ctx context.Context, optionID int) (*entity.Option, error) {
+// GetOptionValue mocks base method
+func (m *MockOptionRepository) GetOptionValue(ctx context.Context, optionValueID int, userRole *entity.UserRole) (*entity.OptionValue, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOption", ctx, optionID)
-	ret0, _ := ret[0].(*entity.Option)
+	ret := m.ctrl.Call(m, "GetOptionValue", ctx, optionValueID, userRole)
+	ret0, _ := ret[0].(*entity.OptionValue)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOption indicates an expected call of GetOption.
-func (mr *MockOptionRepositoryMockRecorder) GetOption(ctx, optionID interface{}) *gomock.Call {
+// GetOptionValue indicates an expected call of GetOptionValue
+func (mr *MockOptionRepositoryMockRecorder) GetOptionValue(ctx, optionValueID, userRole interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOption", reflect.TypeOf((*MockOptionRepository)(nil).GetOption), ctx, optionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionValue), ctx, optionValueID, userRole)
 }
 
-// GetOptionByCat mocks base method.
-func (m *MockOptionRepository) GetOptionByCat(ctx context.Context, categoryId int) ([]*entity.Option, error) {
+// GetOptionValueByOptId mocks base method
+func (m *MockOptionRepository) GetOptionValueByOptId(ctx context.Context, optionID int, userRole *entity.UserRole) ([]*entity.OptionValueJson, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptionByCat", ctx, categoryId)
-	ret0, _ := ret[0].([]*entity.Option)
+	ret := m.ctrl.Call(m, "GetOptionValueByOptId", ctx, optionID, userRole)
+	ret0, _ := ret[0].([]*entity.OptionValueJson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOptionByCat indicates an expected call of GetOptionByCat.
-func (mr *MockOptionRepositoryMockRecorder) GetOptionByCat(ctx, categoryId interface{}) *gomock.Call {
+// GetOptionValueByOptId indicates an expected call of GetOptionValueByOptId
+func (mr *MockOptionRepositoryMockRecorder) GetOptionValueByOptId(ctx, optionID, userRole interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionByCat", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionByCat), ctx, categoryId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionValueByOptId", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionValueByOptId), ctx, optionID, userRole)
 }
 
-// GetOptionBySkuValue mocks base method.
-func (m *MockOptionRepository) GetOptionBySkuValue(ctx context.Context, skuValueID int) (*entity.OptionJson, error) {
+// GetOptionBySkuValue mocks base method
+func (m *MockOptionRepository) GetOptionBySkuValue(ctx context.Context, skuValueID int, userRole *entity.UserRole) (*entity.OptionJson, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptionBySkuValue", ctx, skuValueID)
+	ret := m.ctrl.Call(m, "GetOptionBySkuValue", ctx, skuValueID, userRole)
 	ret0, _ := ret[0].(*entity.OptionJson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOptionBySkuValue indicates an expected call of GetOptionBySkuValue.
-func (mr *MockOptionRepositoryMockRecorder) GetOptionBySkuValue(ctx, skuValueID interface{}) *gomock.Call {
+// GetOptionBySkuValue indicates an expected call of GetOptionBySkuValue
+func (mr *MockOptionRepositoryMockRecorder) GetOptionBySkuValue(ctx, skuValueID, userRole interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionBySkuValue", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionBySkuValue), ctx, skuValueID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionBySkuValue", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionBySkuValue), ctx, skuValueID, userRole)
 }
 
-// GetOptionValue mocks base method.
-func (m *MockOptionRepository) GetOptionValue(ctx context.Context, optionValueID int) (*entity.OptionValue, error) {
+// GetSkuOptions mocks base method
+func (m *MockOptionRepository) GetSkuOptions(ctx context.Context, skuID int) ([]*entity.SkuOptionJson, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptionValue", ctx, optionValueID)
-	ret0, _ := ret[0].(*entity.OptionValue)
+	ret := m.ctrl.Call(m, "GetSkuOptions", ctx, skuID)
+	ret0, _ := ret[0].([]*entity.SkuOptionJson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOptionValue indicates an expected call of GetOptionValue.
-func (mr *MockOptionRepositoryMockRecorder) GetOptionValue(ctx, optionValueID interface{}) *gomock.Call {
+// GetSkuOptions indicates an expected call of GetSkuOptions
+func (mr *MockOptionRepositoryMockRecorder) GetSkuOptions(ctx, skuID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionValue), ctx, optionValueID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkuOptions", reflect.TypeOf((*MockOptionRepository)(nil).GetSkuOptions), ctx, skuID)
 }
 
-// GetOptionValueByOptId mocks base method.
-func (m *MockOptionRepository) GetOptionValueByOptId(ctx context.Context, optionID int) ([]*entity.OptionValueJson, error) {
+// CreateOption mocks base method
+func (m *MockOptionRepository) CreateOption(ctx context.Context, option entity.Option) (*int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOptionValueByOptId", ctx, optionID)
-	ret0, _ := ret[0].([]*entity.OptionValueJson)
+	ret := m.ctrl.Call(m, "CreateOption", ctx, option)
+	ret0, _ := ret[0].(*int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOptionValueByOptId indicates an expected call of GetOptionValueByOptId.
-func (mr *MockOptionRepositoryMockRecorder) GetOptionValueByOptId(ctx, optionID interface{}) *gomock.Call {
+// CreateOption indicates an expected call of CreateOption
+func (mr *MockOptionRepositoryMockRecorder) CreateOption(ctx, option interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOptionValueByOptId", reflect.TypeOf((*MockOptionRepository)(nil).GetOptionValueByOptId), ctx, optionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOption", reflect.TypeOf((*MockOptionRepository)(nil).CreateOption), ctx, option)
 }
 
-// GetSkuValue mocks base method.
-func (m *MockOptionRepository) GetSkuValue(ctx context.Context, skuID int) ([]*entity.SkuValue, error) {
+// CreateOptionValue mocks base method
+func (m *MockOptionRepository) CreateOptionValue(ctx context.Context, optionValue entity.OptionValue, txId int) (*int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSkuValue", ctx, skuID)
-	ret0, _ := ret[0].([]*entity.SkuValue)
+	ret := m.ctrl.Call(m, "CreateOptionValue", ctx, optionValue, txId)
+	ret0, _ := ret[0].(*int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSkuValue indicates an expected call of GetSkuValue.
-func (mr *MockOptionRepositoryMockRecorder) GetSkuValue(ctx, skuID interface{}) *gomock.Call {
+// CreateOptionValue indicates an expected call of CreateOptionValue
+func (mr *MockOptionRepositoryMockRecorder) CreateOptionValue(ctx, optionValue, txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).CreateOptionValue), ctx, optionValue, txId)
+}
+
+// CreateSkuValue mocks base method
+func (m *MockOptionRepository) CreateSkuValue(ctx context.Context, sku string, skuValue *entity.SkuValue, txId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSkuValue", ctx, sku, skuValue, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSkuValue indicates an expected call of CreateSkuValue
+func (mr *MockOptionRepositoryMockRecorder) CreateSkuValue(ctx, sku, skuValue, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSkuValue", reflect.TypeOf((*MockOptionRepository)(nil).GetSkuValue), ctx, skuID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSkuValue", reflect.TypeOf((*MockOptionRepository)(nil).CreateSkuValue), ctx, sku, skuValue, txId)
 }
 
-// RemoveOption mocks base method.
+// UpdateOption mocks base method
+func (m *MockOptionRepository) UpdateOption(ctx context.Context, option entity.Option) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOption", ctx, option)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOption indicates an expected call of UpdateOption
+func (mr *MockOptionRepositoryMockRecorder) UpdateOption(ctx, option interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOption", reflect.TypeOf((*MockOptionRepository)(nil).UpdateOption), ctx, option)
+}
+
+// UpdateOptionValue mocks base method
+func (m *MockOptionRepository) UpdateOptionValue(ctx context.Context, optionValue entity.OptionValue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOptionValue", ctx, optionValue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOptionValue indicates an expected call of UpdateOptionValue
+func (mr *MockOptionRepositoryMockRecorder) UpdateOptionValue(ctx, optionValue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).UpdateOptionValue), ctx, optionValue)
+}
+
+// RemoveOption mocks base method
 func (m *MockOptionRepository) RemoveOption(ctx context.Context, optionID int) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveOption", ctx, optionID)
@@ -177,13 +219,13 @@ func (m *MockOptionRepository) RemoveOption(ctx context.Context, optionID int) e
 	return ret0
 }
 
-// RemoveOption indicates an expected call of RemoveOption.
+// RemoveOption indicates an expected call of RemoveOption
 func (mr *MockOptionRepositoryMockRecorder) RemoveOption(ctx, optionID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOption", reflect.TypeOf((*MockOptionRepository)(nil).RemoveOption), ctx, optionID)
 }
 
-// RemoveOptionValue mocks base method.
+// RemoveOptionValue mocks base method
 func (m *MockOptionRepository) RemoveOptionValue(ctx context.Context, optionValueID int) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveOptionValue", ctx, optionValueID)
@@ -191,13 +233,13 @@ func (m *MockOptionRepository) RemoveOptionValue(ctx context.Context, optionValu
 	return ret0
 }
 
-// RemoveOptionValue indicates an expected call of RemoveOptionValue.
+// RemoveOptionValue indicates an expected call of RemoveOptionValue
 func (mr *MockOptionRepositoryMockRecorder) RemoveOptionValue(ctx, optionValueID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).RemoveOptionValue), ctx, optionValueID)
 }
 
-// RemoveSkuValue mocks base method.
+// RemoveSkuValue mocks base method
 func (m *MockOptionRepository) RemoveSkuValue(ctx context.Context, skuValueID int) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RemoveSkuValue", ctx, skuValueID)
@@ -205,36 +247,37 @@ func (m *MockOptionRepository) RemoveSkuValue(ctx context.Context, skuValueID in
 	return ret0
 }
 
-// RemoveSkuValue indicates an expected call of RemoveSkuValue.
+// RemoveSkuValue indicates an expected call of RemoveSkuValue
 func (mr *MockOptionRepositoryMockRecorder) RemoveSkuValue(ctx, skuValueID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSkuValue", reflect.TypeOf((*MockOptionRepository)(nil).RemoveSkuValue), ctx, skuValueID)
 }
 
-// UpdateOption mocks base method.
-func (m *MockOptionRepository) UpdateOption(ctx context.Context, option entity.Option) error {
+// NewTxId mocks base method
+func (m *MockOptionRepository) NewTxId(ctx context.Context) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOption", ctx, option)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "NewTxId", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateOption indicates an expected call of UpdateOption.
-func (mr *MockOptionRepositoryMockRecorder) UpdateOption(ctx, option interface{}) *gomock.Call {
+// NewTxId indicates an expected call of NewTxId
+func (mr *MockOptionRepositoryMockRecorder) NewTxId(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOption", reflect.TypeOf((*MockOptionRepository)(nil).UpdateOption), ctx, option)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTxId", reflect.TypeOf((*MockOptionRepository)(nil).NewTxId), ctx)
 }
 
-// UpdateOptionValue mocks base method.
-func (m *MockOptionRepository) UpdateOptionValue(ctx context.Context, optionValue entity.OptionValue) error {
+// TxEnd mocks base method
+func (m *MockOptionRepository) TxEnd(ctx context.Context, txId int, err error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOptionValue", ctx, optionValue)
+	ret := m.ctrl.Call(m, "TxEnd", ctx, txId, err)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateOptionValue indicates an expected call of UpdateOptionValue.
-func (mr *MockOptionRepositoryMockRecorder) UpdateOptionValue(ctx, optionValue interface{}) *gomock.Call {
+// TxEnd indicates an expected call of TxEnd
+func (mr *MockOptionRepositoryMockRecorder) TxEnd(ctx, txId, err interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOptionValue", reflect.TypeOf((*MockOptionRepository)(nil).UpdateOptionValue), ctx, optionValue)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxEnd", reflect.TypeOf((*MockOptionRepository)(nil).TxEnd), ctx, txId, err)
 }
@@ -147,7 +147,7 @@ func (d *PgxAccess) GetOptionBySkuValue(ctx context.Context, skuValueId int, use
 		InnerJoin("option ON sku_value.option_id = option.id").
 		InnerJoin("option_value ON sku_value.option_value_id = option_value.id").
 		Where("sku_value.id = $1", skuValueId)
-	if *userRole != entity.UserRoleAdmin {
+	if !userRole.Can(entity.PermProductWrite) {
 		baseQuery = baseQuery.Where("sku_value.state = 'enabled' AND option.state = 'enabled'")
 	}
 
@@ -176,6 +176,39 @@ func (d *PgxAccess) GetOptionBySkuValue(ctx context.Context, skuValueId int, use
 	return option, nil
 }
 
+// GetSkuOptions returns the enabled option values of the sku with their option names
+func (d *PgxAccess) GetSkuOptions(ctx context.Context, skuId int) (res []*entity.SkuOptionJson, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetSkuOptions"})
+	query, args, err := d.Builder.
+		Select("option.name",
+			"option_value.name").
+		From("sku_value").
+		InnerJoin("option ON sku_value.option_id = option.id").
+		InnerJoin("option_value ON sku_value.option_value_id = option_value.id").
+		Where("sku_value.sku_id = ? AND sku_value.state = 'enabled' AND option.state = 'enabled'", skuId).
+		OrderBy("option.id").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OptionRepository - GetSkuOptions - r.Builder - query")
+		return nil, err
+	}
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - GetSkuOptions - Query")
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.SkuOptionJson{}
+		if err := rows.Scan(&tmp.Option, &tmp.Value); err != nil {
+			dbLog.WithError(err).Errorf("PgxAccess - GetSkuOptions - Scan")
+			return nil, err
+		}
+		res = append(res, tmp)
+	}
+	return res, nil
+}
+
 func (d *PgxAccess) CreateOption(ctx context.Context, option entity.Option) (optionID *int, err error) {
 	dbLog := log.WithFields(log.Fields{"func": "pg.CreateOption"})
 	row := d.Pool.QueryRow(ctx, CreateOption, option.CategoryId, option.Name, option.CreateTs, option.UpdateTs, option.State, option.Version)
@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type OrderHandlerClient interface {
 	OrderList(ctx context.Context, in *OrderRequest, opts ...grpc.CallOption) (*OrderResponse, error)
+	OrderEvents(ctx context.Context, in *OrderEventsRequest, opts ...grpc.CallOption) (OrderHandler_OrderEventsClient, error)
 }
 
 type orderHandlerClient struct {
@@ -42,11 +43,44 @@ func (c *orderHandlerClient) OrderList(ctx context.Context, in *OrderRequest, op
 	return out, nil
 }
 
+func (c *orderHandlerClient) OrderEvents(ctx context.Context, in *OrderEventsRequest, opts ...grpc.CallOption) (OrderHandler_OrderEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &OrderHandler_ServiceDesc.Streams[0], "/grpc_handler.OrderHandler/OrderEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &orderHandlerOrderEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type OrderHandler_OrderEventsClient interface {
+	Recv() (*OrderEvent, error)
+	grpc.ClientStream
+}
+
+type orderHandlerOrderEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *orderHandlerOrderEventsClient) Recv() (*OrderEvent, error) {
+	m := new(OrderEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // OrderHandlerServer is the server API for OrderHandler service.
 // All implementations must embed UnimplementedOrderHandlerServer
 // for forward compatibility
 type OrderHandlerServer interface {
 	OrderList(context.Context, *OrderRequest) (*OrderResponse, error)
+	OrderEvents(*OrderEventsRequest, OrderHandler_OrderEventsServer) error
 	mustEmbedUnimplementedOrderHandlerServer()
 }
 
@@ -57,6 +91,9 @@ type UnimplementedOrderHandlerServer struct {
 func (UnimplementedOrderHandlerServer) OrderList(context.Context, *OrderRequest) (*OrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OrderList not implemented")
 }
+func (UnimplementedOrderHandlerServer) OrderEvents(*OrderEventsRequest, OrderHandler_OrderEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method OrderEvents not implemented")
+}
 func (UnimplementedOrderHandlerServer) mustEmbedUnimplementedOrderHandlerServer() {}
 
 // UnsafeOrderHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +125,27 @@ func _OrderHandler_OrderList_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderHandler_OrderEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(OrderEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderHandlerServer).OrderEvents(m, &orderHandlerOrderEventsServer{stream})
+}
+
+type OrderHandler_OrderEventsServer interface {
+	Send(*OrderEvent) error
+	grpc.ServerStream
+}
+
+type orderHandlerOrderEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *orderHandlerOrderEventsServer) Send(m *OrderEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // OrderHandler_ServiceDesc is the grpc.ServiceDesc for OrderHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -100,6 +158,12 @@ var OrderHandler_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _OrderHandler_OrderList_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "OrderEvents",
+			Handler:       _OrderHandler_OrderEvents_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "internal/order/handler/grpc/order_grpc_handler.proto",
 }
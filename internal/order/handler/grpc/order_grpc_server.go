@@ -10,10 +10,13 @@ import (
 	"github.com/bnkamalesh/webgo/v6"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
-	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
 )
 
 type grpcServer struct {
@@ -21,6 +24,26 @@ type grpcServer struct {
 	UnimplementedOrderHandlerServer
 }
 
+var errEventsLagging = errorStatus.New(errorStatus.CodeUnavailable, "order events lagging, resume from the last event id").WithRetry(0)
+
+// AuthPermissions are the permissions required by the order methods
+var AuthPermissions = map[string]entity.Permission{
+	"/grpc_handler.OrderHandler/OrderList":   entity.PermSignedIn,
+	"/grpc_handler.OrderHandler/OrderEvents": entity.PermSignedIn,
+}
+
+// RegisterServices registers the order service on the shared gRPC server
+func RegisterServices(gsrv *grpc.Server, us *entity.Usecases) {
+	RegisterOrderHandlerServer(gsrv, &grpcServer{
+		usecases: us,
+	})
+}
+
+// RegisterGateway routes the REST calls of the order service through the gateway mux to the gRPC server
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterOrderHandlerHandler(ctx, mux, conn)
+}
+
 func NewGRPCServer(cfg *entity.Config, us *entity.Usecases) (*grpc.Server, net.Listener, error) {
 	host := fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)
 	lis, err := net.Listen("tcp", host)
@@ -44,14 +67,13 @@ func (g *grpcServer) OrderList(ctx context.Context, orderGrpc *OrderRequest) (*O
 		"grpc": "OrderHandler",
 	})
 
-	claim := &entity.Users{}
-
-	filter := &dto.OrderListFilter{}
+	user := grpchelper.UserFromContext(ctx)
+	filter := mapOrderFilter(orderGrpc.Filter)
 
-	ordersUsecase, err := g.usecases.OrderUsecase.GetOrders(ctx, claim, filter, int(orderGrpc.Limit), int(orderGrpc.Offset))
+	ordersUsecase, err := g.usecases.OrderUsecase.GetOrders(ctx, user, filter, int(orderGrpc.Limit), int(orderGrpc.Offset))
 	if err != nil {
 		glog.WithError(err).Error("OrderHandler - error while processing g.usecases.OrderUsecase.GetOrders")
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpchelper.StatusError(err)
 	}
 	var res OrderResponse
 	orderResp := make([]*OrdersJson, len(ordersUsecase))
@@ -68,6 +90,58 @@ func (g *grpcServer) OrderList(ctx context.Context, orderGrpc *OrderRequest) (*O
 	return &res, nil
 }
 
+func (g *grpcServer) OrderEvents(req *OrderEventsRequest, stream OrderHandler_OrderEventsServer) error {
+	ctx := stream.Context()
+	glog := log.WithContext(ctx).WithFields(log.Fields{
+		"grpc": "OrderEvents",
+	})
+
+	user := grpchelper.UserFromContext(ctx)
+	events, err := g.usecases.OrderUsecase.SubscribeOrderEvents(ctx, user, req.OrderId, req.LastEventId)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.OrderUsecase.SubscribeOrderEvents")
+		return grpchelper.StatusError(err)
+	}
+
+	for event := range events {
+		err = stream.Send(&OrderEvent{
+			Id:       event.Id,
+			OrderId:  event.OrderId.String(),
+			UserId:   int32(event.UserId),
+			Type:     string(event.Type),
+			Status:   event.Status,
+			CreateTs: timestamppb.New(event.CreateTs),
+		})
+		if err != nil {
+			glog.WithError(err).Warning("stream.Send")
+			return err
+		}
+	}
+	if ctx.Err() != nil {
+		return nil
+	}
+	// the subscriber fell behind, the client resumes from the last event it got
+	return grpchelper.StatusError(errEventsLagging)
+}
+
+func mapOrderFilter(f *OrderRequestFilter) *dto.OrderListFilter {
+	filter := &dto.OrderListFilter{}
+	if f == nil {
+		return filter
+	}
+	if f.Id != 0 {
+		id := int(f.Id)
+		filter.Id = &id
+	}
+	if f.UserId != 0 {
+		userId := int(f.UserId)
+		filter.UserId = &userId
+	}
+	filter.Phone = f.Phone
+	filter.Status = f.Status
+	return filter
+}
+
 func mapOrderToJSON(s *entity.OrderJson) *OrdersJson {
 	return &OrdersJson{
 		Id:      s.Id.String(),
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"encoding/json"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -24,6 +23,7 @@ type ResponseError struct {
 type OrderHandler struct {
 	ordUsecase entity.OrderUsecase
 	user       entity.UserUsecase
+	cart       entity.CartUsecase
 	srvLog     *logrus.Entry
 }
 
@@ -36,19 +36,21 @@ func NewOrderHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entity.U
 	oh := &OrderHandler{
 		ordUsecase: uc.OrderUsecase,
 		user:       uc.UserUsecase,
+		cart:       uc.CartUsecase,
 		srvLog:     srvLog,
 	}
 	h := handler.Group("/order")
 	{
-		h.POST("/list", mdw, oh.getOrders)
-		h.POST("/:orderId", mdw, oh.getOrder)
+		h.GET("", mdw, oh.getOrders)
+		h.POST("/guest/:orderId", oh.getGuestOrder)
+		h.GET("/:orderId", mdw, oh.getOrder)
 		h.POST("", mdw, oh.createOrder)
 		h.PUT("/:orderId", mdw, oh.updateOrder)
 		h.DELETE("/:orderId", mdw, oh.deleteOrder)
 	}
 	a := handler.Group("/admin")
 	{
-		a.PUT("/order/:orderId", mdw, oh.updateOrderStatus)
+		a.PUT("/order/:orderId", mdw, httphelper.RequirePermission(entity.PermOrderStatus), oh.updateOrderStatus)
 	}
 }
 
@@ -69,15 +71,15 @@ func (oh *OrderHandler) getOrders(c *gin.Context) {
 	}
 
 	var listReq dto.OrderListRequest
-	if err := json.NewDecoder(c.Request.Body).Decode(&listReq); err != nil {
-		srvLog.WithError(err).Error("format is wrong")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+	if err := httphelper.BindQuery(c, &listReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindQuery")
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
 	srvLog.WithFields(log.Fields{"limit": listReq.Limit, "offset": listReq.Offset, "func": "server.GetOrdersHandler"})
 
-	result, err := oh.ordUsecase.GetOrders(c, user, listReq.Filter, listReq.Limit, listReq.Offset)
+	result, err := oh.ordUsecase.GetOrders(c, user, listReq.Filter(), listReq.Limit, listReq.Offset)
 	if err != nil {
 		srvLog.WithError(err).Warning("oh.ordUsecase.GetOrders")
 		httphelper.SendResponse(c, nil, err)
@@ -120,6 +122,11 @@ func (oh *OrderHandler) createOrder(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 
+	if user.Id == 0 {
+		oh.createGuestOrder(c, user)
+		return
+	}
+
 	order, err := httphelper.OrderForm(c)
 	if err != nil {
 		srvLog.WithError(err).Warning("createOrder.httphelper.OrderForm")
@@ -138,6 +145,56 @@ func (oh *OrderHandler) createOrder(c *gin.Context) {
 
 }
 
+// createGuestOrder checks out the anonymous cart of the x-cart-token header
+func (oh *OrderHandler) createGuestOrder(c *gin.Context, user *entity.Users) {
+	srvLog := log.WithFields(log.Fields{"func": "OrderHandler.createGuestOrder"})
+
+	guestId, err := oh.cart.ParseGuestToken(c, c.GetHeader("x-cart-token"))
+	if err != nil {
+		srvLog.WithError(err).Warning("oh.cart.ParseGuestToken")
+		httphelper.SendResponse(c, nil, errorstatus.ErrAuth)
+		return
+	}
+	user.GuestId = guestId
+
+	order, address, err := httphelper.GuestOrderForm(c)
+	if err != nil {
+		srvLog.WithError(err).Warning("createGuestOrder.httphelper.GuestOrderForm")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err = oh.ordUsecase.CreateGuestOrder(c, user, order, address)
+	if err != nil {
+		srvLog.WithError(err).Warning("oh.ordUsecase.CreateGuestOrder")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, order.Id, nil)
+}
+
+// getGuestOrder looks the order up by its id and the email given at checkout
+func (oh *OrderHandler) getGuestOrder(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "OrderHandler.getGuestOrder"})
+
+	var lookupReq dto.GuestOrderRequest
+	if err := httphelper.BindJSON(c, &lookupReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := oh.ordUsecase.GetGuestOrder(c, c.Param("orderId"), lookupReq.Email)
+	if err != nil {
+		srvLog.WithError(err).Warning("oh.ordUsecase.GetGuestOrder")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
 func (oh *OrderHandler) updateOrder(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "OrderHandler.updateOrder"})
 
@@ -160,7 +217,6 @@ func (oh *OrderHandler) updateOrder(c *gin.Context) {
 		return
 	}
 	order.Id = orderIduuid
-	order.UserId = user.Id
 	order.UpdateTs = time.Now()
 
 	err = oh.ordUsecase.UpdateOrder(c, user, order)
@@ -185,28 +241,26 @@ func (oh *OrderHandler) updateOrderStatus(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 
-	orderId := c.Param("orderId")
-	orderIduuid := uuid.Must(uuid.Parse(orderId))
-
-	order, err := httphelper.OrderForm(c)
+	orderIduuid, err := uuid.Parse(c.Param("orderId"))
 	if err != nil {
-		srvLog.WithError(err).Warning("updateOrder.httphelper.OrderForm")
-		httphelper.SendResponse(c, nil, err)
+		srvLog.WithError(err).Warning("uuid.Parse.orderId")
+		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
 		return
 	}
-	order.Id = orderIduuid
-	order.UserId = user.Id
-	order.UpdateTs = time.Now()
 
-	err = oh.ordUsecase.UpdateOrder(c, user, order)
+	order := &entity.Order{
+		Id:     orderIduuid,
+		Status: c.PostForm("status"),
+	}
+
+	err = oh.ordUsecase.UpdateOrderStatus(c, user, order)
 	if err != nil {
-		oh.srvLog.WithError(err).Warning("oh.ordUsecase.UpdateOrder")
+		srvLog.WithError(err).Warning("oh.ordUsecase.UpdateOrderStatus")
 		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
 	httphelper.SendResponse(c, sucsess, nil)
-
 }
 
 func (oh *OrderHandler) deleteOrder(c *gin.Context) {
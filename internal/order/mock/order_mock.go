@@ -6,147 +6,235 @@ package orderMock
 
 import (
 	context "context"
-	entity "go-store/internal/entity"
-	dto "go-store/internal/dto"
-	reflect "reflect"
-
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	dto "go-store/internal/dto"
+	entity "go-store/internal/entity"
+	reflect "reflect"
 )
 
-// MockOrderUsecase is a mock of OrderUsecase interface.
+// MockOrderUsecase is a mock of OrderUsecase interface
 type MockOrderUsecase struct {
 	ctrl     *gomock.Controller
 	recorder *MockOrderUsecaseMockRecorder
 }
 
-// MockOrderUsecaseMockRecorder is the mock recorder for MockOrderUsecase.
+// MockOrderUsecaseMockRecorder is the mock recorder for MockOrderUsecase
 type MockOrderUsecaseMockRecorder struct {
 	mock *MockOrderUsecase
 }
 
-// NewMockOrderUsecase creates a new mock instance.
+// NewMockOrderUsecase creates a new mock instance
 func NewMockOrderUsecase(ctrl *gomock.Controller) *MockOrderUsecase {
 	mock := &MockOrderUsecase{ctrl: ctrl}
 	mock.recorder = &MockOrderUsecaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
+// EXPECT returns an object that allows the caller to indicate expected use
 func (m *MockOrderUsecase) EXPECT() *MockOrderUsecaseMockRecorder {
 	return m.recorder
 }
 
-// CreateOrder mocks base method.
-func (m *MockOrderUsecase) CreateOrder(ctx context.Context, claim *entity.Claims, order *entity.Order) error {
+// GetOrders mocks base method
+func (m *MockOrderUsecase) GetOrders(ctx context.Context, user *entity.Users, filter *dto.OrderListFilter, limit, offset int) ([]*entity.OrderJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", ctx, user, filter, limit, offset)
+	ret0, _ := ret[0].([]*entity.OrderJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders
+func (mr *MockOrderUsecaseMockRecorder) GetOrders(ctx, user, filter, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderUsecase)(nil).GetOrders), ctx, user, filter, limit, offset)
+}
+
+// GetOrderById mocks base method
+func (m *MockOrderUsecase) GetOrderById(ctx context.Context, user *entity.Users, orderId string) (*entity.OrderJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderById", ctx, user, orderId)
+	ret0, _ := ret[0].(*entity.OrderJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderById indicates an expected call of GetOrderById
+func (mr *MockOrderUsecaseMockRecorder) GetOrderById(ctx, user, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderById", reflect.TypeOf((*MockOrderUsecase)(nil).GetOrderById), ctx, user, orderId)
+}
+
+// CreateOrder mocks base method
+func (m *MockOrderUsecase) CreateOrder(ctx context.Context, user *entity.Users, order *entity.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrder", ctx, claim, order)
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, user, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateOrder indicates an expected call of CreateOrder.
-func (mr *MockOrderUsecaseMockRecorder) CreateOrder(ctx, claim, order interface{}) *gomock.Call {
+// CreateOrder indicates an expected call of CreateOrder
+func (mr *MockOrderUsecaseMockRecorder) CreateOrder(ctx, user, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderUsecase)(nil).CreateOrder), ctx, claim, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderUsecase)(nil).CreateOrder), ctx, user, order)
 }
 
-// DeleteOrder mocks base method.
-func (m *MockOrderUsecase) DeleteOrder(ctx context.Context, order *entity.Order) error {
+// CreateGuestOrder mocks base method
+func (m *MockOrderUsecase) CreateGuestOrder(ctx context.Context, user *entity.Users, order *entity.Order, address *entity.Address) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrder", ctx, order)
+	ret := m.ctrl.Call(m, "CreateGuestOrder", ctx, user, order, address)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteOrder indicates an expected call of DeleteOrder.
-func (mr *MockOrderUsecaseMockRecorder) DeleteOrder(ctx, order interface{}) *gomock.Call {
+// CreateGuestOrder indicates an expected call of CreateGuestOrder
+func (mr *MockOrderUsecaseMockRecorder) CreateGuestOrder(ctx, user, order, address interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockOrderUsecase)(nil).DeleteOrder), ctx, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestOrder", reflect.TypeOf((*MockOrderUsecase)(nil).CreateGuestOrder), ctx, user, order, address)
 }
 
-// GetOrderById mocks base method.
-func (m *MockOrderUsecase) GetOrderById(ctx context.Context, claim *entity.Claims, orderId string) (*entity.OrderJson, error) {
+// GetGuestOrder mocks base method
+func (m *MockOrderUsecase) GetGuestOrder(ctx context.Context, orderId, email string) (*entity.OrderJson, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderById", ctx, claim, orderId)
+	ret := m.ctrl.Call(m, "GetGuestOrder", ctx, orderId, email)
 	ret0, _ := ret[0].(*entity.OrderJson)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrderById indicates an expected call of GetOrderById.
-func (mr *MockOrderUsecaseMockRecorder) GetOrderById(ctx, claim, orderId interface{}) *gomock.Call {
+// GetGuestOrder indicates an expected call of GetGuestOrder
+func (mr *MockOrderUsecaseMockRecorder) GetGuestOrder(ctx, orderId, email interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderById", reflect.TypeOf((*MockOrderUsecase)(nil).GetOrderById), ctx, claim, orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOrder", reflect.TypeOf((*MockOrderUsecase)(nil).GetGuestOrder), ctx, orderId, email)
 }
 
-// GetOrders mocks base method.
-func (m *MockOrderUsecase) GetOrders(ctx context.Context, claim *entity.Claims, filter *dto.OrderListFilter, limit, offset int) ([]*entity.OrderJson, error) {
+// UpdateOrder mocks base method
+func (m *MockOrderUsecase) UpdateOrder(ctx context.Context, user *entity.Users, order *entity.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrders", ctx, claim, filter, limit, offset)
-	ret0, _ := ret[0].([]*entity.OrderJson)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateOrder", ctx, user, order)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrders indicates an expected call of GetOrders.
-func (mr *MockOrderUsecaseMockRecorder) GetOrders(ctx, claim, filter, limit, offset interface{}) *gomock.Call {
+// UpdateOrder indicates an expected call of UpdateOrder
+func (mr *MockOrderUsecaseMockRecorder) UpdateOrder(ctx, user, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderUsecase)(nil).GetOrders), ctx, claim, filter, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockOrderUsecase)(nil).UpdateOrder), ctx, user, order)
 }
 
-// UpdateOrder mocks base method.
-func (m *MockOrderUsecase) UpdateOrder(ctx context.Context, claim *entity.Claims, order *entity.Order) error {
+// UpdateOrderStatus mocks base method
+func (m *MockOrderUsecase) UpdateOrderStatus(ctx context.Context, user *entity.Users, order *entity.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrder", ctx, claim, order)
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", ctx, user, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateOrder indicates an expected call of UpdateOrder.
-func (mr *MockOrderUsecaseMockRecorder) UpdateOrder(ctx, claim, order interface{}) *gomock.Call {
+// UpdateOrderStatus indicates an expected call of UpdateOrderStatus
+func (mr *MockOrderUsecaseMockRecorder) UpdateOrderStatus(ctx, user, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockOrderUsecase)(nil).UpdateOrder), ctx, claim, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockOrderUsecase)(nil).UpdateOrderStatus), ctx, user, order)
 }
 
-// UpdateOrderStatus mocks base method.
-func (m *MockOrderUsecase) UpdateOrderStatus(ctx context.Context, claim *entity.Claims, order *entity.Order) error {
+// DeleteOrder mocks base method
+func (m *MockOrderUsecase) DeleteOrder(ctx context.Context, order *entity.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrderStatus", ctx, claim, order)
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
-func (mr *MockOrderUsecaseMockRecorder) UpdateOrderStatus(ctx, claim, order interface{}) *gomock.Call {
+// DeleteOrder indicates an expected call of DeleteOrder
+func (mr *MockOrderUsecaseMockRecorder) DeleteOrder(ctx, order interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockOrderUsecase)(nil).DeleteOrder), ctx, order)
+}
+
+// SubscribeOrderEvents mocks base method
+func (m *MockOrderUsecase) SubscribeOrderEvents(ctx context.Context, user *entity.Users, orderId string, lastEventId int64) (<-chan *entity.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeOrderEvents", ctx, user, orderId, lastEventId)
+	ret0, _ := ret[0].(<-chan *entity.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeOrderEvents indicates an expected call of SubscribeOrderEvents
+func (mr *MockOrderUsecaseMockRecorder) SubscribeOrderEvents(ctx, user, orderId, lastEventId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockOrderUsecase)(nil).UpdateOrderStatus), ctx, claim, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeOrderEvents", reflect.TypeOf((*MockOrderUsecase)(nil).SubscribeOrderEvents), ctx, user, orderId, lastEventId)
 }
 
-// MockOrderRepository is a mock of OrderRepository interface.
+// MockOrderRepository is a mock of OrderRepository interface
 type MockOrderRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockOrderRepositoryMockRecorder
 }
 
-// MockOrderRepositoryMockRecorder is the mock recorder for MockOrderRepository.
+// MockOrderRepositoryMockRecorder is the mock recorder for MockOrderRepository
 type MockOrderRepositoryMockRecorder struct {
 	mock *MockOrderRepository
 }
 
-// NewMockOrderRepository creates a new mock instance.
+// NewMockOrderRepository creates a new mock instance
 func NewMockOrderRepository(ctrl *gomock.Controller) *MockOrderRepository {
 	mock := &MockOrderRepository{ctrl: ctrl}
 	mock.recorder = &MockOrderRepositoryMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
+// EXPECT returns an object that allows the caller to indicate expected use
 func (m *MockOrderRepository) EXPECT() *MockOrderRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateOrder mocks base method.
+// GetOrders mocks base method
+func (m *MockOrderRepository) GetOrders(ctx context.Context, filterMap map[string]string, limit, offset, id int) ([]*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", ctx, filterMap, limit, offset, id)
+	ret0, _ := ret[0].([]*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders
+func (mr *MockOrderRepositoryMockRecorder) GetOrders(ctx, filterMap, limit, offset, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderRepository)(nil).GetOrders), ctx, filterMap, limit, offset, id)
+}
+
+// GetOrder mocks base method
+func (m *MockOrderRepository) GetOrder(ctx context.Context, orderId string) (*entity.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, orderId)
+	ret0, _ := ret[0].(*entity.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder
+func (mr *MockOrderRepositoryMockRecorder) GetOrder(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockOrderRepository)(nil).GetOrder), ctx, orderId)
+}
+
+// GetItem mocks base method
+func (m *MockOrderRepository) GetItem(ctx context.Context, orderId uuid.UUID) ([]*entity.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetItem", ctx, orderId)
+	ret0, _ := ret[0].([]*entity.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetItem indicates an expected call of GetItem
+func (mr *MockOrderRepositoryMockRecorder) GetItem(ctx, orderId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockOrderRepository)(nil).GetItem), ctx, orderId)
+}
+
+// CreateOrder mocks base method
 func (m *MockOrderRepository) CreateOrder(ctx context.Context, order *entity.Order, txId int) (*uuid.UUID, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateOrder", ctx, order, txId)
@@ -155,181 +243,237 @@ func (m *MockOrderRepository) CreateOrder(ctx context.Context, order *entity.Ord
 	return ret0, ret1
 }
 
-// CreateOrder indicates an expected call of CreateOrder.
+// CreateOrder indicates an expected call of CreateOrder
 func (mr *MockOrderRepositoryMockRecorder) CreateOrder(ctx, order, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockOrderRepository)(nil).CreateOrder), ctx, order, txId)
 }
 
-// CreateOrderItem mocks base method.
-func (m *MockOrderRepository) CreateOrderItem(ctx context.Context, orderId uuid.UUID, userId, txId int) error {
+// GetCartItems mocks base method
+func (m *MockOrderRepository) GetCartItems(ctx context.Context, owner entity.CartOwner, txId int) ([]*entity.OrderItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCartItems", ctx, owner, txId)
+	ret0, _ := ret[0].([]*entity.OrderItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCartItems indicates an expected call of GetCartItems
+func (mr *MockOrderRepositoryMockRecorder) GetCartItems(ctx, owner, txId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCartItems", reflect.TypeOf((*MockOrderRepository)(nil).GetCartItems), ctx, owner, txId)
+}
+
+// CreateOrderItem mocks base method
+func (m *MockOrderRepository) CreateOrderItem(ctx context.Context, orderId uuid.UUID, items []*entity.OrderItem, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrderItem", ctx, orderId, userId, txId)
+	ret := m.ctrl.Call(m, "CreateOrderItem", ctx, orderId, items, txId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CreateOrderItem indicates an expected call of CreateOrderItem.
-func (mr *MockOrderRepositoryMockRecorder) CreateOrderItem(ctx, orderId, userId, txId interface{}) *gomock.Call {
+// CreateOrderItem indicates an expected call of CreateOrderItem
+func (mr *MockOrderRepositoryMockRecorder) CreateOrderItem(ctx, orderId, items, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItem", reflect.TypeOf((*MockOrderRepository)(nil).CreateOrderItem), ctx, orderId, userId, txId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderItem", reflect.TypeOf((*MockOrderRepository)(nil).CreateOrderItem), ctx, orderId, items, txId)
 }
 
-// DeleteOrder mocks base method.
-func (m *MockOrderRepository) DeleteOrder(ctx context.Context, order *entity.Order) error {
+// ClearCart mocks base method
+func (m *MockOrderRepository) ClearCart(ctx context.Context, owner entity.CartOwner, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteOrder", ctx, order)
+	ret := m.ctrl.Call(m, "ClearCart", ctx, owner, txId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteOrder indicates an expected call of DeleteOrder.
-func (mr *MockOrderRepositoryMockRecorder) DeleteOrder(ctx, order interface{}) *gomock.Call {
+// ClearCart indicates an expected call of ClearCart
+func (mr *MockOrderRepositoryMockRecorder) ClearCart(ctx, owner, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockOrderRepository)(nil).DeleteOrder), ctx, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearCart", reflect.TypeOf((*MockOrderRepository)(nil).ClearCart), ctx, owner, txId)
 }
 
-// GetItem mocks base method.
-func (m *MockOrderRepository) GetItem(ctx context.Context, orderId uuid.UUID) ([]*entity.OrderItem, error) {
+// GetActiveCoupon mocks base method
+func (m *MockOrderRepository) GetActiveCoupon(ctx context.Context, userId, txId int) (*entity.Coupon, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetItem", ctx, orderId)
-	ret0, _ := ret[0].([]*entity.OrderItem)
+	ret := m.ctrl.Call(m, "GetActiveCoupon", ctx, userId, txId)
+	ret0, _ := ret[0].(*entity.Coupon)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetItem indicates an expected call of GetItem.
-func (mr *MockOrderRepositoryMockRecorder) GetItem(ctx, orderId interface{}) *gomock.Call {
+// GetActiveCoupon indicates an expected call of GetActiveCoupon
+func (mr *MockOrderRepositoryMockRecorder) GetActiveCoupon(ctx, userId, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockOrderRepository)(nil).GetItem), ctx, orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveCoupon", reflect.TypeOf((*MockOrderRepository)(nil).GetActiveCoupon), ctx, userId, txId)
 }
 
-// GetOrder mocks base method.
-func (m *MockOrderRepository) GetOrder(ctx context.Context, orderId string) (*entity.Order, error) {
+// RedeemCoupon mocks base method
+func (m *MockOrderRepository) RedeemCoupon(ctx context.Context, code string, orderId uuid.UUID, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrder", ctx, orderId)
-	ret0, _ := ret[0].(*entity.Order)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RedeemCoupon", ctx, code, orderId, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrder indicates an expected call of GetOrder.
-func (mr *MockOrderRepositoryMockRecorder) GetOrder(ctx, orderId interface{}) *gomock.Call {
+// RedeemCoupon indicates an expected call of RedeemCoupon
+func (mr *MockOrderRepositoryMockRecorder) RedeemCoupon(ctx, code, orderId, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockOrderRepository)(nil).GetOrder), ctx, orderId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedeemCoupon", reflect.TypeOf((*MockOrderRepository)(nil).RedeemCoupon), ctx, code, orderId, txId)
 }
 
-// GetOrders mocks base method.
-func (m *MockOrderRepository) GetOrders(ctx context.Context, filterMap map[string]string, limit, offset, id int) ([]*entity.Order, error) {
+// UpdateOrder mocks base method
+func (m *MockOrderRepository) UpdateOrder(ctx context.Context, order *entity.Order, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrders", ctx, filterMap, limit, offset, id)
-	ret0, _ := ret[0].([]*entity.Order)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateOrder", ctx, order, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetOrders indicates an expected call of GetOrders.
-func (mr *MockOrderRepositoryMockRecorder) GetOrders(ctx, filterMap, limit, offset, id interface{}) *gomock.Call {
+// UpdateOrder indicates an expected call of UpdateOrder
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrder(ctx, order, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockOrderRepository)(nil).GetOrders), ctx, filterMap, limit, offset, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrder), ctx, order, txId)
 }
 
-// NewTxId mocks base method.
-func (m *MockOrderRepository) NewTxId(ctx context.Context) (int, error) {
+// UpdateOrderTax mocks base method
+func (m *MockOrderRepository) UpdateOrderTax(ctx context.Context, order *entity.Order, items []*entity.OrderItem, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewTxId", ctx)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "UpdateOrderTax", ctx, order, items, txId)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// NewTxId indicates an expected call of NewTxId.
-func (mr *MockOrderRepositoryMockRecorder) NewTxId(ctx interface{}) *gomock.Call {
+// UpdateOrderTax indicates an expected call of UpdateOrderTax
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrderTax(ctx, order, items, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTxId", reflect.TypeOf((*MockOrderRepository)(nil).NewTxId), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderTax", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderTax), ctx, order, items, txId)
 }
 
-// TxEnd mocks base method.
-func (m *MockOrderRepository) TxEnd(ctx context.Context, txId int, err error) error {
+// UpdateOrderStatus mocks base method
+func (m *MockOrderRepository) UpdateOrderStatus(ctx context.Context, order *entity.Order, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "TxEnd", ctx, txId, err)
+	ret := m.ctrl.Call(m, "UpdateOrderStatus", ctx, order, txId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// TxEnd indicates an expected call of TxEnd.
-func (mr *MockOrderRepositoryMockRecorder) TxEnd(ctx, txId, err interface{}) *gomock.Call {
+// UpdateOrderStatus indicates an expected call of UpdateOrderStatus
+func (mr *MockOrderRepositoryMockRecorder) UpdateOrderStatus(ctx, order, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxEnd", reflect.TypeOf((*MockOrderRepository)(nil).TxEnd), ctx, txId, err)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderStatus), ctx, order, txId)
 }
 
-// UpdateOrder mocks base method.
-func (m *MockOrderRepository) UpdateOrder(ctx context.Context, order *entity.Order) error {
+// DeleteOrder mocks base method
+func (m *MockOrderRepository) DeleteOrder(ctx context.Context, order *entity.Order) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrder", ctx, order)
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, order)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateOrder indicates an expected call of UpdateOrder.
-func (mr *MockOrderRepositoryMockRecorder) UpdateOrder(ctx, order interface{}) *gomock.Call {
+// DeleteOrder indicates an expected call of DeleteOrder
+func (mr *MockOrderRepositoryMockRecorder) DeleteOrder(ctx, order interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrder), ctx, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockOrderRepository)(nil).DeleteOrder), ctx, order)
 }
 
-// UpdateOrderStatus mocks base method.
-func (m *MockOrderRepository) UpdateOrderStatus(ctx context.Context, order *entity.Order) error {
+// CreateOrderEvent mocks base method
+func (m *MockOrderRepository) CreateOrderEvent(ctx context.Context, event *entity.OrderEvent, txId int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateOrderStatus", ctx, order)
+	ret := m.ctrl.Call(m, "CreateOrderEvent", ctx, event, txId)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UpdateOrderStatus indicates an expected call of UpdateOrderStatus.
-func (mr *MockOrderRepositoryMockRecorder) UpdateOrderStatus(ctx, order interface{}) *gomock.Call {
+// CreateOrderEvent indicates an expected call of CreateOrderEvent
+func (mr *MockOrderRepositoryMockRecorder) CreateOrderEvent(ctx, event, txId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrderStatus", reflect.TypeOf((*MockOrderRepository)(nil).UpdateOrderStatus), ctx, order)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderEvent", reflect.TypeOf((*MockOrderRepository)(nil).CreateOrderEvent), ctx, event, txId)
 }
 
-// MockOrderRedisRepository is a mock of OrderRedisRepository interface.
+// GetOrderEvents mocks base method
+func (m *MockOrderRepository) GetOrderEvents(ctx context.Context, filter *entity.OrderEventFilter, afterId int64, limit int) ([]*entity.OrderEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrderEvents", ctx, filter, afterId, limit)
+	ret0, _ := ret[0].([]*entity.OrderEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrderEvents indicates an expected call of GetOrderEvents
+func (mr *MockOrderRepositoryMockRecorder) GetOrderEvents(ctx, filter, afterId, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderEvents", reflect.TypeOf((*MockOrderRepository)(nil).GetOrderEvents), ctx, filter, afterId, limit)
+}
+
+// NewTxId mocks base method
+func (m *MockOrderRepository) NewTxId(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewTxId", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewTxId indicates an expected call of NewTxId
+func (mr *MockOrderRepositoryMockRecorder) NewTxId(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewTxId", reflect.TypeOf((*MockOrderRepository)(nil).NewTxId), ctx)
+}
+
+// TxEnd mocks base method
+func (m *MockOrderRepository) TxEnd(ctx context.Context, txId int, err error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxEnd", ctx, txId, err)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TxEnd indicates an expected call of TxEnd
+func (mr *MockOrderRepositoryMockRecorder) TxEnd(ctx, txId, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxEnd", reflect.TypeOf((*MockOrderRepository)(nil).TxEnd), ctx, txId, err)
+}
+
+// MockOrderRedisRepository is a mock of OrderRedisRepository interface
 type MockOrderRedisRepository struct {
 	ctrl     *gomock.Controller
 	recorder *MockOrderRedisRepositoryMockRecorder
 }
 
-// MockOrderRedisRepositoryMockRecorder is the mock recorder for MockOrderRedisRepository.
+// MockOrderRedisRepositoryMockRecorder is the mock recorder for MockOrderRedisRepository
 type MockOrderRedisRepositoryMockRecorder struct {
 	mock *MockOrderRedisRepository
 }
 
-// NewMockOrderRedisRepository creates a new mock instance.
+// NewMockOrderRedisRepository creates a new mock instance
 func NewMockOrderRedisRepository(ctrl *gomock.Controller) *MockOrderRedisRepository {
 	mock := &MockOrderRedisRepository{ctrl: ctrl}
 	mock.recorder = &MockOrderRedisRepositoryMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
+// EXPECT returns an object that allows the caller to indicate expected use
 func (m *MockOrderRedisRepository) EXPECT() *MockOrderRedisRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GetItem mocks base method.
-func (m *MockOrderRedisRepository) GetItem(ctx context.Context, order_id uuid.UUID) ([]*entity.OrderItem, error) {
+// GetOrderSuperadminQuery mocks base method
+func (m *MockOrderRedisRepository) GetOrderSuperadminQuery(ctx context.Context, limit, offset int, dbSchema string) ([]*entity.Order, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetItem", ctx, order_id)
-	ret0, _ := ret[0].([]*entity.OrderItem)
+	ret := m.ctrl.Call(m, "GetOrderSuperadminQuery", ctx, limit, offset, dbSchema)
+	ret0, _ := ret[0].([]*entity.Order)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetItem indicates an expected call of GetItem.
-func (mr *MockOrderRedisRepositoryMockRecorder) GetItem(ctx, order_id interface{}) *gomock.Call {
+// GetOrderSuperadminQuery indicates an expected call of GetOrderSuperadminQuery
+func (mr *MockOrderRedisRepositoryMockRecorder) GetOrderSuperadminQuery(ctx, limit, offset, dbSchema interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockOrderRedisRepository)(nil).GetItem), ctx, order_id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderSuperadminQuery", reflect.TypeOf((*MockOrderRedisRepository)(nil).GetOrderSuperadminQuery), ctx, limit, offset, dbSchema)
 }
 
-// GetOrderQuery mocks base method.
+// GetOrderQuery mocks base method
 func (m *MockOrderRedisRepository) GetOrderQuery(ctx context.Context, limit, offset, id int, dbSchema string) ([]*entity.Order, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOrderQuery", ctx, limit, offset, id, dbSchema)
@@ -338,23 +482,23 @@ func (m *MockOrderRedisRepository) GetOrderQuery(ctx context.Context, limit, off
 	return ret0, ret1
 }
 
-// GetOrderQuery indicates an expected call of GetOrderQuery.
+// GetOrderQuery indicates an expected call of GetOrderQuery
 func (mr *MockOrderRedisRepositoryMockRecorder) GetOrderQuery(ctx, limit, offset, id, dbSchema interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderQuery", reflect.TypeOf((*MockOrderRedisRepository)(nil).GetOrderQuery), ctx, limit, offset, id, dbSchema)
 }
 
-// GetOrderSuperadminQuery mocks base method.
-func (m *MockOrderRedisRepository) GetOrderSuperadminQuery(ctx context.Context, limit, offset int, dbSchema string) ([]*entity.Order, error) {
+// GetItem mocks base method
+func (m *MockOrderRedisRepository) GetItem(ctx context.Context, order_id uuid.UUID) ([]*entity.OrderItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOrderSuperadminQuery", ctx, limit, offset, dbSchema)
-	ret0, _ := ret[0].([]*entity.Order)
+	ret := m.ctrl.Call(m, "GetItem", ctx, order_id)
+	ret0, _ := ret[0].([]*entity.OrderItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetOrderSuperadminQuery indicates an expected call of GetOrderSuperadminQuery.
-func (mr *MockOrderRedisRepositoryMockRecorder) GetOrderSuperadminQuery(ctx, limit, offset, dbSchema interface{}) *gomock.Call {
+// GetItem indicates an expected call of GetItem
+func (mr *MockOrderRedisRepositoryMockRecorder) GetItem(ctx, order_id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrderSuperadminQuery", reflect.TypeOf((*MockOrderRedisRepository)(nil).GetOrderSuperadminQuery), ctx, limit, offset, dbSchema)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetItem", reflect.TypeOf((*MockOrderRedisRepository)(nil).GetItem), ctx, order_id)
 }
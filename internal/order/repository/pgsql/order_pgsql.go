@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"go-store/internal/entity"
-	"time"
 
 	errorStatus "go-store/utils/errors"
 
@@ -17,6 +16,10 @@ import (
 	log "github.com/sirupsen/logrus"
 )
 
+// orderEventLock serializes the inserts into order_event until their transactions end,
+// so the event ids follow the commit order and a reader after an id never misses a later commit
+const orderEventLock = 0x6f726465
+
 type PgxAccess struct {
 	*database.PgxAccess
 }
@@ -41,11 +44,26 @@ func (d *PgxAccess) GetOrders(ctx context.Context, filterMap map[string]string,
 	dbLog := log.WithFields(log.Fields{"func": "db.GetOrderQuery"})
 	baseQuery := d.Builder.
 		Select("id",
-			"user_id",
+			"COALESCE(user_id, 0)",
+			"COALESCE(email, '')",
+			"COALESCE(region_id, 0)",
+			"COALESCE(address_id, 0)",
+			"COALESCE(recipient, '')",
+			"COALESCE(country, '')",
+			"COALESCE(city, '')",
+			"COALESCE(street, '')",
+			"COALESCE(postcode, '')",
+			"COALESCE(latitude, 0)",
+			"COALESCE(longitude, 0)",
 			"address",
 			"phone",
 			"comment",
 			"status",
+			"subtotal",
+			"tax_total",
+			"shipping_total",
+			"discount_total",
+			"total",
 			"create_ts",
 			"update_ts",
 			"state",
@@ -74,7 +92,7 @@ func (d *PgxAccess) GetOrders(ctx context.Context, filterMap map[string]string,
 	defer rows.Close()
 	for rows.Next() {
 		var tmp entity.Order
-		if err := rows.Scan(&tmp.Id, &tmp.UserId, &tmp.Address, &tmp.Phone, &tmp.Comment, &tmp.Status, &tmp.CreateTs, &tmp.UpdateTs, &tmp.State, &tmp.Version, &tmp.Notes); err != nil {
+		if err := rows.Scan(&tmp.Id, &tmp.UserId, &tmp.Email, &tmp.RegionId, &tmp.AddressId, &tmp.Recipient, &tmp.Country, &tmp.City, &tmp.Street, &tmp.Postcode, &tmp.Latitude, &tmp.Longitude, &tmp.Address, &tmp.Phone, &tmp.Comment, &tmp.Status, &tmp.Subtotal, &tmp.TaxTotal, &tmp.ShippingTotal, &tmp.DiscountTotal, &tmp.Total, &tmp.CreateTs, &tmp.UpdateTs, &tmp.State, &tmp.Version, &tmp.Notes); err != nil {
 			dbLog.WithFields(log.Fields{"order_id": tmp.Id}).Warning(err)
 			err = fmt.Errorf("db.GetOrderQuery: %w", err)
 			return nil, err
@@ -91,8 +109,13 @@ func (d *PgxAccess) GetItem(ctx context.Context, order_id uuid.UUID) (result []*
 	query, args, err := d.Builder.
 		Select("order_item.id",
 			"order_item.order_id",
+			"order_item.sku_id",
 			"order_item.quantity",
 			"order_item.price",
+			"COALESCE(product.tax_class_id, 0)",
+			"order_item.tax_rate",
+			"order_item.tax_amount",
+			"order_item.subtotal",
 			"order_item.create_ts",
 			"order_item.update_ts",
 			"order_item.state",
@@ -101,6 +124,7 @@ func (d *PgxAccess) GetItem(ctx context.Context, order_id uuid.UUID) (result []*
 			"sku.small_name").
 		From("order_item").
 		InnerJoin("sku ON order_item.sku_id = sku.id").
+		InnerJoin("product ON product.id = sku.product_id").
 		Where("order_item.state = 'enabled' AND order_item.order_id = $1", order_id).
 		ToSql()
 	if err != nil {
@@ -121,7 +145,7 @@ func (d *PgxAccess) GetItem(ctx context.Context, order_id uuid.UUID) (result []*
 	defer rows.Close()
 	for rows.Next() {
 		var tmp entity.OrderItem
-		if err := rows.Scan(&tmp.Id, &tmp.OrderId, &tmp.Quantity, &tmp.Price, &tmp.CreateTs, &tmp.UpdateTs, &tmp.State, &tmp.Version, &tmp.Sku.Sku, &tmp.Sku.SmallImage); err != nil {
+		if err := rows.Scan(&tmp.Id, &tmp.OrderId, &tmp.SkuId, &tmp.Quantity, &tmp.Price, &tmp.TaxClassId, &tmp.TaxRate, &tmp.TaxAmount, &tmp.Subtotal, &tmp.CreateTs, &tmp.UpdateTs, &tmp.State, &tmp.Version, &tmp.Sku.Sku, &tmp.Sku.SmallImage); err != nil {
 			dbLog.WithFields(log.Fields{"item_id": tmp.Id}).Warning(err)
 			err = fmt.Errorf("db: %w", err)
 			return nil, err
@@ -146,23 +170,53 @@ func (d *PgxAccess) CreateOrder(ctx context.Context, order *entity.Order, txId i
 		Columns(
 			"id",
 			"user_id",
+			"email",
+			"region_id",
+			"address_id",
+			"recipient",
+			"country",
+			"city",
+			"street",
+			"postcode",
+			"latitude",
+			"longitude",
 			"address",
 			"phone",
 			"comment",
 			"notes",
 			"status",
+			"subtotal",
+			"tax_total",
+			"shipping_total",
+			"discount_total",
+			"total",
 			"create_ts",
 			"update_ts",
 			"state",
 			"version").
 		Values(
 			squirrel.Expr("uuid_generate_v4()"),
-			order.UserId,
+			nullableId(order.UserId),
+			order.Email,
+			nullableId(order.RegionId),
+			nullableId(order.AddressId),
+			order.Recipient,
+			order.Country,
+			order.City,
+			order.Street,
+			order.Postcode,
+			order.Latitude,
+			order.Longitude,
 			order.Address,
 			order.Phone,
 			order.Comment,
 			order.Notes,
 			order.Status,
+			order.Subtotal,
+			order.TaxTotal,
+			order.ShippingTotal,
+			order.DiscountTotal,
+			order.Total,
 			order.CreateTs,
 			order.UpdateTs,
 			order.State,
@@ -182,47 +236,95 @@ func (d *PgxAccess) CreateOrder(ctx context.Context, order *entity.Order, txId i
 	return orderId, nil
 }
 
-func (d *PgxAccess) CreateOrderItem(ctx context.Context, orderId uuid.UUID, userId int, txId int) error {
+// GetCartItems locks the cart lines of the owner and returns them priced by the current sku price
+func (d *PgxAccess) GetCartItems(ctx context.Context, owner entity.CartOwner, txId int) (result []*entity.OrderItem, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetCartItems"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - GetCartItems - d.GetTxById")
+		return nil, err
+	}
+
+	query, args, err := d.Builder.
+		Select("cart.sku_id",
+			"cart.quantity",
+			"sku.price",
+			"COALESCE(product.tax_class_id, 0)").
+		From("cart").
+		InnerJoin("sku ON sku.id = cart.sku_id").
+		InnerJoin("product ON product.id = sku.product_id").
+		Where("cart.state = 'enabled'").
+		Where(ownerEq(owner)).
+		Suffix("FOR UPDATE OF cart").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - GetCartItems - r.Builder - query")
+		return nil, err
+	}
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		err = fmt.Errorf("db.GetCartItems: %w", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.OrderItem{}
+		if err := rows.Scan(&tmp.SkuId, &tmp.Quantity, &tmp.Price, &tmp.TaxClassId); err != nil {
+			dbLog.WithFields(log.Fields{"owner": owner}).Warning(err)
+			return nil, err
+		}
+		result = append(result, tmp)
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) CreateOrderItem(ctx context.Context, orderId uuid.UUID, items []*entity.OrderItem, txId int) error {
 	dbLog := log.WithFields(log.Fields{"func": "pg.CreateOrderItem"})
-	var idCount int
 
 	tx, err := d.GetTxById(txId)
 	if err != nil {
 		dbLog.WithError(err).Errorf("OrderRepo - CreateOrderItem - d.GetTxById")
 		return err
 	}
+	if len(items) == 0 {
+		return errorStatus.ErrNotFound
+	}
 
-	queryStr := fmt.Sprintf(`WITH I AS ( INSERT
-		INTO "order_item"(
-			order_id,
-			sku_id,
-			quantity,
-			price,
-			create_ts,
-			update_ts,
-			state,
-			version)
-		SELECT
-			$2,
-			cart.sku_id,
-			cart.quantity,
-			sku.price,
-			$3,
-			$4,
-			$5,
-			$6
-		FROM "cart"
-		INNER JOIN "sku" ON sku.id = cart.sku_id
-		RETURNING id ), D AS(
-			DELETE FROM "cart"
-			WHERE cart.user_id = $1)
-		SELECT id FROM I`)
-	row := tx.QueryRow(ctx, queryStr, userId, orderId, time.Now(), time.Now(), entity.Enabled, 0)
-	err = row.Scan(&idCount)
-	if err == pgx.ErrNoRows {
-		err = errorStatus.ErrNotFound
+	baseQuery := d.Builder.
+		Insert("order_item").
+		Columns("order_id",
+			"sku_id",
+			"quantity",
+			"price",
+			"tax_rate",
+			"tax_amount",
+			"subtotal",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version")
+	for _, item := range items {
+		item.SetDefaults()
+		baseQuery = baseQuery.Values(orderId,
+			item.SkuId,
+			item.Quantity,
+			item.Price,
+			item.TaxRate,
+			item.TaxAmount,
+			item.Subtotal,
+			item.CreateTs,
+			item.UpdateTs,
+			item.State,
+			item.Version)
+	}
+	query, args, err := baseQuery.ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - CreateOrderItem - r.Builder - query")
 		return err
 	}
+	_, err = tx.Exec(ctx, query, args...)
 	if err != nil {
 		dbLog.WithError(err).Errorf("PgxAccess - CreateOrderItem - Exec")
 		return err
@@ -230,16 +332,122 @@ func (d *PgxAccess) CreateOrderItem(ctx context.Context, orderId uuid.UUID, user
 	return nil
 }
 
+func (d *PgxAccess) ClearCart(ctx context.Context, owner entity.CartOwner, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.ClearCart"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - ClearCart - d.GetTxById")
+		return err
+	}
+
+	query, args, err := d.Builder.
+		Delete("cart").
+		Where(ownerEq(owner)).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - ClearCart - r.Builder - query")
+		return err
+	}
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - ClearCart - Exec")
+		return err
+	}
+	return nil
+}
+
+// GetActiveCoupon locks the best unused and unexpired coupon of the user for the order transaction
+func (d *PgxAccess) GetActiveCoupon(ctx context.Context, userId int, txId int) (result *entity.Coupon, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetActiveCoupon"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - GetActiveCoupon - d.GetTxById")
+		return nil, err
+	}
+
+	query, args, err := d.Builder.
+		Select("code",
+			"user_id",
+			"percent",
+			"expire_ts",
+			"create_ts").
+		From("coupon").
+		Where("user_id = ? AND used_ts IS NULL AND expire_ts > ?", userId, entity.NowUTC()).
+		OrderBy("percent DESC").
+		Limit(1).
+		Suffix("FOR UPDATE").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - GetActiveCoupon - r.Builder - query")
+		return nil, err
+	}
+	result = &entity.Coupon{}
+	err = tx.QueryRow(ctx, query, args...).Scan(&result.Code, &result.UserId, &result.Percent, &result.ExpireTs, &result.CreateTs)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) RedeemCoupon(ctx context.Context, code string, orderId uuid.UUID, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.RedeemCoupon"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - RedeemCoupon - d.GetTxById")
+		return err
+	}
+
+	query, args, err := d.Builder.
+		Update("coupon").
+		Set("used_ts", entity.NowUTC()).
+		Set("order_id", orderId).
+		Where("code = ? AND used_ts IS NULL", code).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepo - RedeemCoupon - r.Builder - query")
+		return err
+	}
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - RedeemCoupon - Exec")
+		return err
+	}
+	return nil
+}
+
 func (d *PgxAccess) GetOrder(ctx context.Context, orderId string) (result *entity.Order, err error) {
 	order := &entity.Order{}
 	dbLog := log.WithFields(log.Fields{"func": "db.GetOrder"})
 	query, args, err := d.Builder.
 		Select("id",
-			"user_id",
+			"COALESCE(user_id, 0)",
+			"COALESCE(email, '')",
+			"COALESCE(region_id, 0)",
+			"COALESCE(address_id, 0)",
+			"COALESCE(recipient, '')",
+			"COALESCE(country, '')",
+			"COALESCE(city, '')",
+			"COALESCE(street, '')",
+			"COALESCE(postcode, '')",
+			"COALESCE(latitude, 0)",
+			"COALESCE(longitude, 0)",
 			"address",
 			"phone",
 			"comment",
 			"status",
+			"subtotal",
+			"tax_total",
+			"shipping_total",
+			"discount_total",
+			"total",
+			"create_ts",
 			"notes").
 		From("orders").
 		Where("orders.id = $1 AND orders.state = 'enabled'", orderId).
@@ -249,7 +457,7 @@ func (d *PgxAccess) GetOrder(ctx context.Context, orderId string) (result *entit
 		return nil, err
 	}
 	row := d.Pool.QueryRow(ctx, query, args...)
-	err = row.Scan(&order.Id, &order.UserId, &order.Address, &order.Phone, &order.Comment, &order.Status, &order.Notes)
+	err = row.Scan(&order.Id, &order.UserId, &order.Email, &order.RegionId, &order.AddressId, &order.Recipient, &order.Country, &order.City, &order.Street, &order.Postcode, &order.Latitude, &order.Longitude, &order.Address, &order.Phone, &order.Comment, &order.Status, &order.Subtotal, &order.TaxTotal, &order.ShippingTotal, &order.DiscountTotal, &order.Total, &order.CreateTs, &order.Notes)
 	if err == pgx.ErrNoRows {
 		err = errorStatus.ErrNotFound
 		return nil, err
@@ -262,8 +470,14 @@ func (d *PgxAccess) GetOrder(ctx context.Context, orderId string) (result *entit
 
 }
 
-func (d *PgxAccess) UpdateOrder(ctx context.Context, order *entity.Order) error {
+func (d *PgxAccess) UpdateOrder(ctx context.Context, order *entity.Order, txId int) error {
 	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateOrder"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - UpdateOrder - d.GetTxById")
+		return err
+	}
 	queryStr := `UPDATE public."orders" AS o
 	SET
 	address = COALESCE(NULLIF($3, ''), o.address),
@@ -271,13 +485,21 @@ func (d *PgxAccess) UpdateOrder(ctx context.Context, order *entity.Order) error
 	comment = COALESCE(NULLIF($5, ''), o.comment),
 	notes = COALESCE(NULLIF($6, ''), o.notes),
 	update_ts = COALESCE($7, o.update_ts),
+	address_id = COALESCE(NULLIF($8, 0), o.address_id),
+	recipient = COALESCE(NULLIF($9, ''), o.recipient),
+	country = COALESCE(NULLIF($10, ''), o.country),
+	city = COALESCE(NULLIF($11, ''), o.city),
+	street = COALESCE(NULLIF($12, ''), o.street),
+	postcode = COALESCE(NULLIF($13, ''), o.postcode),
+	latitude = CASE WHEN $8 = 0 THEN o.latitude ELSE $14 END,
+	longitude = CASE WHEN $8 = 0 THEN o.longitude ELSE $15 END,
 	version = o.version + 1
-	FROM public."users" AS u
-	WHERE o.id = $1 AND o.user_id = $2 OR u.role = 'ADMIN'
+	WHERE o.id = $1 AND ($2 = 0 OR o.user_id = $2)
 	RETURNING o.id;`
 	dbLog.Info(order.UserId)
-	row := d.Pool.QueryRow(ctx, queryStr, order.Id, order.UserId, order.Address, order.Phone, order.Comment, order.Notes, order.UpdateTs)
-	err := row.Scan(&order.Id)
+	row := tx.QueryRow(ctx, queryStr, order.Id, order.UserId, order.Address, order.Phone, order.Comment, order.Notes, order.UpdateTs,
+		order.AddressId, order.Recipient, order.Country, order.City, order.Street, order.Postcode, order.Latitude, order.Longitude)
+	err = row.Scan(&order.Id)
 	if err == pgx.ErrNoRows {
 		return errorStatus.ErrNotFound
 	}
@@ -288,21 +510,78 @@ func (d *PgxAccess) UpdateOrder(ctx context.Context, order *entity.Order) error
 	return nil
 }
 
-func (d *PgxAccess) UpdateOrderStatus(ctx context.Context, order *entity.Order) error {
+// UpdateOrderTax stores the tax breakdown of the order recalculated for its region
+func (d *PgxAccess) UpdateOrderTax(ctx context.Context, order *entity.Order, items []*entity.OrderItem, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateOrderTax"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - UpdateOrderTax - d.GetTxById")
+		return err
+	}
+
+	query, args, err := d.Builder.
+		Update("orders").
+		Set("region_id", nullableId(order.RegionId)).
+		Set("subtotal", order.Subtotal).
+		Set("tax_total", order.TaxTotal).
+		Set("total", order.Total).
+		Where("orders.id = ?", order.Id).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - UpdateOrderTax - r.Builder - query")
+		return err
+	}
+	if _, err = tx.Exec(ctx, query, args...); err != nil {
+		dbLog.WithError(err).Errorf("PgxAccess - UpdateOrderTax - Exec")
+		return err
+	}
+
+	for _, item := range items {
+		query, args, err = d.Builder.
+			Update("order_item").
+			Set("tax_rate", item.TaxRate).
+			Set("tax_amount", item.TaxAmount).
+			Set("subtotal", item.Subtotal).
+			Set("update_ts", order.UpdateTs).
+			Set("version", squirrel.Expr("version+1")).
+			Where("order_item.id = ? AND order_item.order_id = ?", item.Id, order.Id).
+			ToSql()
+		if err != nil {
+			dbLog.WithError(err).Errorf("OrderRepository - UpdateOrderTax - r.Builder - item query")
+			return err
+		}
+		if _, err = tx.Exec(ctx, query, args...); err != nil {
+			dbLog.WithError(err).Errorf("PgxAccess - UpdateOrderTax - item Exec")
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *PgxAccess) UpdateOrderStatus(ctx context.Context, order *entity.Order, txId int) error {
 	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateOrderStatus"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - UpdateOrderStatus - d.GetTxById")
+		return err
+	}
+
 	query, args, err := d.Builder.
 		Update("orders").
 		Set("status", order.Status).
 		Set("update_ts", order.UpdateTs).
 		Set("version", squirrel.Expr("version+1")).
-		Where("orders.id = $1", order.Id).
+		Where("orders.id = ?", order.Id).
+		Suffix("RETURNING COALESCE(user_id, 0)").
 		ToSql()
 	if err != nil {
 		dbLog.WithError(err).Errorf("UserLogRepo - UpdateOrderStatus - r.Builder - query")
 		return err
 	}
-	row := d.Pool.QueryRow(ctx, query, args...)
-	err = row.Scan(&order.Id)
+	row := tx.QueryRow(ctx, query, args...)
+	err = row.Scan(&order.UserId)
 	if err == pgx.ErrNoRows {
 		return errorStatus.ErrNotFound
 	}
@@ -338,3 +617,102 @@ func (d *PgxAccess) DeleteOrder(ctx context.Context, order *entity.Order) error
 	}
 	return nil
 }
+
+func (d *PgxAccess) CreateOrderEvent(ctx context.Context, event *entity.OrderEvent, txId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateOrderEvent"})
+
+	tx, err := d.GetTxById(txId)
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - CreateOrderEvent - d.GetTxById")
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", orderEventLock)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+
+	query, args, err := d.Builder.
+		Insert("order_event").
+		Columns("order_id",
+			"user_id",
+			"type",
+			"status",
+			"create_ts").
+		Values(event.OrderId,
+			nullableId(event.UserId),
+			event.Type,
+			event.Status,
+			event.CreateTs).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - CreateOrderEvent - r.Builder - query")
+		return err
+	}
+	err = tx.QueryRow(ctx, query, args...).Scan(&event.Id)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+// GetOrderEvents returns the events of the filter following afterId, oldest first
+func (d *PgxAccess) GetOrderEvents(ctx context.Context, filter *entity.OrderEventFilter, afterId int64, limit int) (result []*entity.OrderEvent, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetOrderEvents"})
+
+	baseQuery := d.Builder.
+		Select("id",
+			"order_id",
+			"COALESCE(user_id, 0)",
+			"type",
+			"status",
+			"create_ts").
+		From("order_event").
+		Where("id > ?", afterId).
+		OrderBy("id").
+		Limit(uint64(limit))
+	if filter.OrderId != uuid.Nil {
+		baseQuery = baseQuery.Where("order_id = ?", filter.OrderId)
+	}
+	if filter.UserId != 0 {
+		baseQuery = baseQuery.Where("user_id = ?", filter.UserId)
+	}
+	query, args, err := baseQuery.ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("OrderRepository - GetOrderEvents - r.Builder - query")
+		return nil, err
+	}
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, fmt.Errorf("pg.GetOrderEvents: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.OrderEvent{}
+		if err := rows.Scan(&tmp.Id, &tmp.OrderId, &tmp.UserId, &tmp.Type, &tmp.Status, &tmp.CreateTs); err != nil {
+			dbLog.Warning(err)
+			return nil, err
+		}
+		result = append(result, tmp)
+	}
+	return result, nil
+}
+
+// ownerEq filters the cart lines of a user or, for anonymous carts, of a guest
+func ownerEq(owner entity.CartOwner) squirrel.Eq {
+	if owner.UserId != 0 {
+		return squirrel.Eq{"cart.user_id": owner.UserId}
+	}
+	return squirrel.Eq{"cart.guest_id": owner.GuestId}
+}
+
+func nullableId(id int) interface{} {
+	if id == 0 {
+		return nil
+	}
+	return id
+}
@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+const (
+	eventReplayBatch = 100
+	// the events committed by the other instances are picked up this often
+	eventPollInterval = 5 * time.Second
+)
+
+// orderEventHub wakes the subscribers of this instance when a matching order event is committed.
+// The subscribers read the events from order_event themselves, its ids follow the commit order,
+// so an event is never skipped when another one with a higher id is delivered first
+type orderEventHub struct {
+	mu   sync.Mutex
+	subs map[chan struct{}]*entity.OrderEventFilter
+}
+
+func newOrderEventHub() *orderEventHub {
+	return &orderEventHub{
+		subs: make(map[chan struct{}]*entity.OrderEventFilter),
+	}
+}
+
+func (h *orderEventHub) subscribe(filter *entity.OrderEventFilter) chan struct{} {
+	ch := make(chan struct{}, 1)
+	h.mu.Lock()
+	h.subs[ch] = filter
+	h.mu.Unlock()
+	return ch
+}
+
+func (h *orderEventHub) unsubscribe(ch chan struct{}) {
+	h.mu.Lock()
+	delete(h.subs, ch)
+	h.mu.Unlock()
+}
+
+// publish wakes the subscribers of the event, a subscriber that is already woken reads it with the others
+func (h *orderEventHub) publish(event *entity.OrderEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch, filter := range h.subs {
+		if !filter.Match(event) {
+			continue
+		}
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// SubscribeOrderEvents streams the events of the order, or of all orders visible to the user if orderId is empty,
+// starting after lastEventId. The channel is closed when the context is done or the events can't be read,
+// the subscriber resumes with the id of the last received event
+func (o *OrderUsecase) SubscribeOrderEvents(ctx context.Context, user *entity.Users, orderId string, lastEventId int64) (<-chan *entity.OrderEvent, error) {
+	ctLog := log.WithFields(log.Fields{"func": "OrderUsecase.SubscribeOrderEvents"})
+
+	filter := &entity.OrderEventFilter{}
+	if orderId != "" {
+		id, err := uuid.Parse(orderId)
+		if err != nil {
+			return nil, errorStatus.ErrBadReq
+		}
+		filter.OrderId = id
+	}
+	// without the order:read permission only the events of the users orders
+	if !user.Role.Can(entity.PermOrderRead) {
+		if user.Id == 0 {
+			return nil, errorStatus.ErrAuth
+		}
+		filter.UserId = user.Id
+	}
+
+	// the first page is read here so a failing database fails the call instead of the stream,
+	// the subscription comes first so no wake up is lost in between
+	wake := o.events.subscribe(filter)
+	backlog, err := o.orderRepo.GetOrderEvents(ctx, filter, lastEventId, eventReplayBatch)
+	if err != nil {
+		ctLog.WithError(err).Warning("o.orderRepo.GetOrderEvents")
+		o.events.unsubscribe(wake)
+		return nil, errorStatus.ErrInternalServer
+	}
+
+	out := make(chan *entity.OrderEvent)
+	go func() {
+		defer close(out)
+		defer o.events.unsubscribe(wake)
+
+		lastId := lastEventId
+		// catchUp sends the events committed after the last sent one, in the order of their ids
+		catchUp := func() bool {
+			for {
+				for _, event := range backlog {
+					select {
+					case out <- event:
+						lastId = event.Id
+					case <-ctx.Done():
+						return false
+					}
+				}
+				if len(backlog) < eventReplayBatch {
+					return true
+				}
+				backlog, err = o.orderRepo.GetOrderEvents(ctx, filter, lastId, eventReplayBatch)
+				if err != nil {
+					ctLog.WithError(err).Warning("o.orderRepo.GetOrderEvents")
+					return false
+				}
+			}
+		}
+
+		poll := time.NewTicker(eventPollInterval)
+		defer poll.Stop()
+		for catchUp() {
+			select {
+			case <-wake:
+			case <-poll.C:
+			case <-ctx.Done():
+				return
+			}
+			backlog, err = o.orderRepo.GetOrderEvents(ctx, filter, lastId, eventReplayBatch)
+			if err != nil {
+				ctLog.WithError(err).Warning("o.orderRepo.GetOrderEvents")
+				return
+			}
+		}
+	}()
+	return out, nil
+}
@@ -2,15 +2,19 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	gomock "github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
+	addressMocks "go-store/internal/address/mock"
 	"go-store/internal/dto"
 	"go-store/internal/entity"
 	mocks "go-store/internal/order/mock"
+	taxMocks "go-store/internal/tax/mock"
+	errorStatus "go-store/utils/errors"
 )
 
 func TestOrder(t *testing.T) {
@@ -21,6 +25,8 @@ func TestOrder(t *testing.T) {
 	defer mockCtrl.Finish()
 
 	storageMock := mocks.NewMockOrderRepository(mockCtrl)
+	taxMock := taxMocks.NewMockTaxRepository(mockCtrl)
+	addressMock := addressMocks.NewMockAddressRepository(mockCtrl)
 
 	t.Run("get order success", func(t *testing.T) {
 		storageMock.EXPECT().GetOrders(ctx, any, any, any, any).Return([]*entity.Order{{Id: uuid.UUID{3}}}, nil).Times(1)
@@ -40,4 +46,292 @@ func TestOrder(t *testing.T) {
 		req.Equal(uuid.UUID{3}, ordJs[0].Id)
 	})
 
+	t.Run("create order with tax success", func(t *testing.T) {
+		items := []*entity.OrderItem{
+			{SkuId: 1, Price: 100, Quantity: 2, TaxClassId: 1},
+			{SkuId: 2, Price: 120, Quantity: 1, TaxClassId: 2},
+			{SkuId: 3, Price: 50, Quantity: 1},
+		}
+		rates := []*entity.TaxRate{
+			{TaxClassId: 1, RegionId: 7, Rate: 10},
+			{TaxClassId: 2, RegionId: 7, Rate: 20, Inclusive: true},
+			{TaxClassId: 1, RegionId: 1, Rate: 25},
+		}
+		orderId := uuid.UUID{4}
+		address := &entity.Address{Id: 9, UserId: 1, Recipient: "John Doe", Phone: "+100", Country: "US", RegionId: 7, City: "Boston", Street: "Main st. 1", Postcode: "02101"}
+		addressMock.EXPECT().GetAddress(ctx, 1, 9).Return(address, nil).Times(1)
+		storageMock.EXPECT().NewTxId(ctx).Return(1, nil).Times(1)
+		storageMock.EXPECT().GetCartItems(ctx, any, 1).Return(items, nil).Times(1)
+		taxMock.EXPECT().GetRatesByRegion(ctx, 7).Return(rates, nil).Times(1)
+		storageMock.EXPECT().GetActiveCoupon(ctx, 1, 1).Return(nil, errorStatus.ErrNotFound).Times(1)
+		storageMock.EXPECT().CreateOrder(ctx, any, 1).Return(&orderId, nil).Times(1)
+		storageMock.EXPECT().CreateOrderItem(ctx, orderId, items, 1).Return(nil).Times(1)
+		storageMock.EXPECT().ClearCart(ctx, any, 1).Return(nil).Times(1)
+		storageMock.EXPECT().CreateOrderEvent(ctx, any, 1).Return(nil).Times(1)
+		storageMock.EXPECT().TxEnd(ctx, 1, nil).Return(nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo:   storageMock,
+			taxRepo:     taxMock,
+			addressRepo: addressMock,
+			events:      newOrderEventHub(),
+		}
+		user := &entity.Users{Id: 1, RegionId: 3}
+		order := &entity.Order{AddressId: 9}
+
+		err := ordUsc.CreateOrder(ctx, user, order)
+		req.NoError(err)
+		req.Equal(float32(20), items[0].TaxAmount)
+		req.Equal(float32(20), items[1].TaxAmount)
+		req.Equal(float32(100), items[1].Subtotal)
+		req.Equal(float32(0), items[2].TaxAmount)
+		req.Equal(float32(350), order.Subtotal)
+		req.Equal(float32(40), order.TaxTotal)
+		req.Equal(float32(390), order.Total)
+		req.Equal(7, order.RegionId)
+		req.Equal("John Doe", order.Recipient)
+		req.Equal("+100", order.Phone)
+		req.Equal("Main st. 1, Boston, 02101, US", order.Address)
+	})
+
+	t.Run("create order without address", func(t *testing.T) {
+		addressMock.EXPECT().GetDefaultShipping(ctx, 1).Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo:   storageMock,
+			taxRepo:     taxMock,
+			addressRepo: addressMock,
+		}
+		user := &entity.Users{Id: 1}
+
+		err := ordUsc.CreateOrder(ctx, user, &entity.Order{})
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
+
+	t.Run("create guest order without email", func(t *testing.T) {
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+		}
+		guest := &entity.Users{GuestId: uuid.UUID{6}}
+		address := &entity.Address{Recipient: "John Doe", Phone: "+100", Country: "US", City: "Boston", Street: "Main st. 1"}
+
+		err := ordUsc.CreateGuestOrder(ctx, guest, &entity.Order{}, address)
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+
+	t.Run("create guest order returns its id", func(t *testing.T) {
+		items := []*entity.OrderItem{{SkuId: 1, Price: 100, Quantity: 1}}
+		orderId := uuid.UUID{5}
+		storageMock.EXPECT().NewTxId(ctx).Return(3, nil).Times(1)
+		storageMock.EXPECT().GetCartItems(ctx, entity.CartOwner{GuestId: uuid.UUID{6}}, 3).Return(items, nil).Times(1)
+		taxMock.EXPECT().GetRatesByRegion(ctx, 0).Return(nil, nil).Times(1)
+		storageMock.EXPECT().CreateOrder(ctx, any, 3).Return(&orderId, nil).Times(1)
+		storageMock.EXPECT().CreateOrderItem(ctx, orderId, items, 3).Return(nil).Times(1)
+		storageMock.EXPECT().ClearCart(ctx, any, 3).Return(nil).Times(1)
+		storageMock.EXPECT().CreateOrderEvent(ctx, any, 3).Return(nil).Times(1)
+		storageMock.EXPECT().TxEnd(ctx, 3, nil).Return(nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			taxRepo:   taxMock,
+			events:    newOrderEventHub(),
+		}
+		guest := &entity.Users{GuestId: uuid.UUID{6}}
+		address := &entity.Address{Recipient: "John Doe", Phone: "+100", Country: "US", City: "Boston", Street: "Main st. 1"}
+		order := &entity.Order{Email: "john@example.com"}
+
+		err := ordUsc.CreateGuestOrder(ctx, guest, order, address)
+		req.NoError(err)
+		req.Equal(orderId, order.Id)
+	})
+
+	t.Run("create guest order fails when the commit fails", func(t *testing.T) {
+		commitErr := errors.New("commit failed")
+		items := []*entity.OrderItem{{SkuId: 1, Price: 100, Quantity: 1}}
+		orderId := uuid.UUID{5}
+		storageMock.EXPECT().NewTxId(ctx).Return(5, nil).Times(1)
+		storageMock.EXPECT().GetCartItems(ctx, entity.CartOwner{GuestId: uuid.UUID{6}}, 5).Return(items, nil).Times(1)
+		taxMock.EXPECT().GetRatesByRegion(ctx, 0).Return(nil, nil).Times(1)
+		storageMock.EXPECT().CreateOrder(ctx, any, 5).Return(&orderId, nil).Times(1)
+		storageMock.EXPECT().CreateOrderItem(ctx, orderId, items, 5).Return(nil).Times(1)
+		storageMock.EXPECT().ClearCart(ctx, any, 5).Return(nil).Times(1)
+		storageMock.EXPECT().CreateOrderEvent(ctx, any, 5).Return(nil).Times(1)
+		storageMock.EXPECT().TxEnd(ctx, 5, nil).Return(commitErr).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			taxRepo:   taxMock,
+			events:    newOrderEventHub(),
+		}
+		guest := &entity.Users{GuestId: uuid.UUID{6}}
+		address := &entity.Address{Recipient: "John Doe", Phone: "+100", Country: "US", City: "Boston", Street: "Main st. 1"}
+
+		err := ordUsc.CreateGuestOrder(ctx, guest, &entity.Order{Email: "john@example.com"}, address)
+		req.ErrorIs(err, commitErr)
+	})
+
+	t.Run("get guest order with wrong email", func(t *testing.T) {
+		orderId := uuid.UUID{7}
+		storageMock.EXPECT().GetOrder(ctx, orderId.String()).Return(&entity.Order{Id: orderId, Email: "john@example.com"}, nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+		}
+
+		_, err := ordUsc.GetGuestOrder(ctx, orderId.String(), "jane@example.com")
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
+
+	t.Run("update order status streams the event after the replay", func(t *testing.T) {
+		orderId := uuid.UUID{8}
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		storageMock.EXPECT().GetOrderEvents(subCtx, &entity.OrderEventFilter{OrderId: orderId}, int64(0), eventReplayBatch).
+			Return([]*entity.OrderEvent{{Id: 1, OrderId: orderId, Type: entity.OrderCreated}}, nil).Times(1)
+		storageMock.EXPECT().NewTxId(ctx).Return(2, nil).Times(1)
+		storageMock.EXPECT().UpdateOrderStatus(ctx, any, 2).Return(nil).Times(1)
+		storageMock.EXPECT().CreateOrderEvent(ctx, any, 2).DoAndReturn(func(_ context.Context, event *entity.OrderEvent, _ int) error {
+			event.Id = 2
+			return nil
+		}).Times(1)
+		storageMock.EXPECT().TxEnd(ctx, 2, nil).Return(nil).Times(1)
+		// the committed event is read after the last sent one
+		storageMock.EXPECT().GetOrderEvents(subCtx, &entity.OrderEventFilter{OrderId: orderId}, int64(1), eventReplayBatch).
+			Return([]*entity.OrderEvent{{Id: 2, OrderId: orderId, Type: entity.OrderCancelled}}, nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			events:    newOrderEventHub(),
+		}
+		admin := &entity.Users{Id: 2, Role: entity.UserRoleAdmin}
+
+		events, err := ordUsc.SubscribeOrderEvents(subCtx, admin, orderId.String(), 0)
+		req.NoError(err)
+		err = ordUsc.UpdateOrderStatus(ctx, admin, &entity.Order{Id: orderId, Status: entity.OrderStatusCancelled})
+		req.NoError(err)
+
+		first := <-events
+		req.Equal(int64(1), first.Id)
+		second := <-events
+		req.Equal(int64(2), second.Id)
+		req.Equal(entity.OrderCancelled, second.Type)
+	})
+
+	t.Run("subscribe order events replays the backlog page by page", func(t *testing.T) {
+		subCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		filter := &entity.OrderEventFilter{UserId: 5}
+		page := make([]*entity.OrderEvent, eventReplayBatch)
+		for i := range page {
+			page[i] = &entity.OrderEvent{Id: int64(i + 1), UserId: 5}
+		}
+		storageMock.EXPECT().GetOrderEvents(subCtx, filter, int64(0), eventReplayBatch).Return(page, nil).Times(1)
+		storageMock.EXPECT().GetOrderEvents(subCtx, filter, int64(eventReplayBatch), eventReplayBatch).
+			Return([]*entity.OrderEvent{{Id: eventReplayBatch + 1, UserId: 5}}, nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			events:    newOrderEventHub(),
+		}
+		customer := &entity.Users{Id: 5, Role: entity.UserRoleUser}
+
+		events, err := ordUsc.SubscribeOrderEvents(subCtx, customer, "", 0)
+		req.NoError(err)
+		for i := int64(1); i <= eventReplayBatch+1; i++ {
+			event := <-events
+			req.Equal(i, event.Id)
+		}
+	})
+
+	t.Run("update order status fails when the commit fails", func(t *testing.T) {
+		commitErr := errors.New("commit failed")
+		storageMock.EXPECT().NewTxId(ctx).Return(4, nil).Times(1)
+		storageMock.EXPECT().UpdateOrderStatus(ctx, any, 4).Return(nil).Times(1)
+		storageMock.EXPECT().CreateOrderEvent(ctx, any, 4).Return(nil).Times(1)
+		storageMock.EXPECT().TxEnd(ctx, 4, nil).Return(commitErr).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			events:    newOrderEventHub(),
+		}
+		admin := &entity.Users{Id: 2, Role: entity.UserRoleAdmin}
+
+		err := ordUsc.UpdateOrderStatus(ctx, admin, &entity.Order{Id: uuid.UUID{8}, Status: entity.OrderStatusCancelled})
+		req.ErrorIs(err, commitErr)
+	})
+
+	t.Run("update order status needs the permission", func(t *testing.T) {
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			events:    newOrderEventHub(),
+		}
+		support := &entity.Users{Id: 3, Role: entity.UserRoleSupportAgent}
+
+		err := ordUsc.UpdateOrderStatus(ctx, support, &entity.Order{Id: uuid.UUID{8}, Status: entity.OrderStatusCancelled})
+		req.ErrorIs(err, errorStatus.ErrPermission)
+	})
+
+	t.Run("get orders of a customer", func(t *testing.T) {
+		storageMock.EXPECT().GetOrders(ctx, map[string]string{"user_id": "4"}, 5, 0, 4).Return([]*entity.Order{}, nil).Times(1)
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+		}
+		manager := &entity.Users{Id: 4, Role: entity.UserRoleCatalogManager}
+
+		ordJs, err := ordUsc.GetOrders(ctx, manager, nil, 5, 0)
+		req.NoError(err)
+		req.Empty(ordJs)
+	})
+
+	t.Run("subscribe order events as guest", func(t *testing.T) {
+		ordUsc := &OrderUsecase{
+			orderRepo: storageMock,
+			events:    newOrderEventHub(),
+		}
+
+		_, err := ordUsc.SubscribeOrderEvents(ctx, &entity.Users{Role: entity.UserRoleGuest}, "", 0)
+		req.ErrorIs(err, errorStatus.ErrAuth)
+	})
+
+	t.Run("staff moves the order to another region", func(t *testing.T) {
+		orderId := uuid.UUID{9}
+		items := []*entity.OrderItem{{Id: 1, SkuId: 1, Price: 100, Quantity: 2, TaxClassId: 1}}
+		storageMock.EXPECT().GetOrder(ctx, orderId.String()).Return(&entity.Order{Id: orderId, UserId: 5, RegionId: 7, ShippingTotal: 10}, nil).Times(1)
+		addressMock.EXPECT().GetAddress(ctx, 5, 11).Return(&entity.Address{Id: 11, UserId: 5, Recipient: "John Doe", Country: "US", RegionId: 8, City: "Austin", Street: "Main st. 2"}, nil).Times(1)
+		storageMock.EXPECT().GetItem(ctx, orderId).Return(items, nil).Times(1)
+		taxMock.EXPECT().GetRatesByRegion(ctx, 8).Return([]*entity.TaxRate{{TaxClassId: 1, RegionId: 8, Rate: 5}}, nil).Times(1)
+		storageMock.EXPECT().NewTxId(ctx).Return(4, nil).Times(1)
+		storageMock.EXPECT().UpdateOrder(ctx, any, 4).Return(nil).Times(1)
+		storageMock.EXPECT().UpdateOrderTax(ctx, any, items, 4).Return(nil).Times(1)
+		storageMock.EXPECT().TxEnd(ctx, 4, nil).Return(nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo:   storageMock,
+			taxRepo:     taxMock,
+			addressRepo: addressMock,
+		}
+		manager := &entity.Users{Id: 2, Role: entity.UserRoleAdmin}
+		order := &entity.Order{Id: orderId, AddressId: 11}
+
+		err := ordUsc.UpdateOrder(ctx, manager, order)
+		req.NoError(err)
+		req.Equal(8, order.RegionId)
+		req.Equal(float32(10), items[0].TaxAmount)
+		req.Equal(float32(10), order.TaxTotal)
+		req.Equal(float32(220), order.Total)
+	})
+
+	t.Run("update the order of another user", func(t *testing.T) {
+		orderId := uuid.UUID{10}
+		storageMock.EXPECT().GetOrder(ctx, orderId.String()).Return(&entity.Order{Id: orderId, UserId: 5}, nil).Times(1)
+
+		ordUsc := &OrderUsecase{
+			orderRepo:   storageMock,
+			addressRepo: addressMock,
+		}
+		user := &entity.Users{Id: 6, Role: entity.UserRoleUser}
+
+		err := ordUsc.UpdateOrder(ctx, user, &entity.Order{Id: orderId, AddressId: 11})
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
 }
@@ -3,6 +3,9 @@ package usecase
 import (
 	"context"
 	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
 
 	log "github.com/sirupsen/logrus"
 
@@ -13,13 +16,19 @@ import (
 
 // OrderUsecase will initiate usecase of entity.OrderRepository interface
 type OrderUsecase struct {
-	orderRepo entity.OrderRepository
+	orderRepo   entity.OrderRepository
+	taxRepo     entity.TaxRepository
+	addressRepo entity.AddressRepository
+	events      *orderEventHub
 }
 
 // NewOrderUsecase will create new an OrderUsecase object representation of entity.OrderUsecase interface
-func NewOrderUsecase(o entity.OrderRepository) entity.OrderUsecase {
+func NewOrderUsecase(o entity.OrderRepository, t entity.TaxRepository, a entity.AddressRepository) entity.OrderUsecase {
 	return &OrderUsecase{
-		orderRepo: o,
+		orderRepo:   o,
+		taxRepo:     t,
+		addressRepo: a,
+		events:      newOrderEventHub(),
 	}
 }
 
@@ -31,8 +40,8 @@ func (o *OrderUsecase) GetOrders(ctx context.Context, user *entity.Users, filter
 	if filter != nil {
 		filterMap = filter.ToSqlFilterMap()
 	}
-	// if not admin then filter only current users order
-	if user.Role != entity.UserRoleAdmin {
+	// without the order:read permission only the orders of the current user
+	if !user.Role.Can(entity.PermOrderRead) {
 		filterMap["user_id"] = strconv.Itoa(user.Id)
 	}
 	orders, err = o.orderRepo.GetOrders(ctx, filterMap, limit, offset, user.Id)
@@ -52,18 +61,13 @@ func (o *OrderUsecase) GetOrders(ctx context.Context, user *entity.Users, filter
 			return nil, err
 		}
 
-		var totalSum, subTotal float32
 		orderResp[idx] = mapOrderToJSON(order)
 
 		OrderItemList := make([]entity.OrderItemSkuJson, len(orderItems))
 		for idx, orderItem := range orderItems {
-			subTotal = float32(orderItem.Quantity) * orderItem.Price
 			OrderItemList[idx] = mapOrderItemToJSON(orderItem)
-			OrderItemList[idx].Subtotal = subTotal
-			totalSum = subTotal + totalSum
 		}
 		orderResp[idx].OrderItem = OrderItemList
-		orderResp[idx].TotalSum = totalSum
 	}
 	result = orderResp
 	return
@@ -73,11 +77,29 @@ func mapOrderToJSON(s *entity.Order) *entity.OrderJson {
 	return &entity.OrderJson{
 		Id:       s.Id,
 		UserId:   s.UserId,
+		Email:    s.Email,
 		Address:  s.Address,
 		Phone:    s.Phone,
 		Comment:  s.Comment,
 		Status:   s.Status,
 		CreateTs: s.CreateTs,
+		Delivery: &entity.AddressJson{
+			Id:        s.AddressId,
+			Recipient: s.Recipient,
+			Phone:     s.Phone,
+			Country:   s.Country,
+			RegionId:  s.RegionId,
+			City:      s.City,
+			Street:    s.Street,
+			Postcode:  s.Postcode,
+			Latitude:  s.Latitude,
+			Longitude: s.Longitude,
+		},
+		Subtotal: s.Subtotal,
+		Tax:      s.TaxTotal,
+		Shipping: s.ShippingTotal,
+		Discount: s.DiscountTotal,
+		Total:    s.Total,
 	}
 }
 
@@ -87,6 +109,9 @@ func mapOrderItemToJSON(s *entity.OrderItem) entity.OrderItemSkuJson {
 		SkuName:   s.Sku.Sku,
 		Quantity:  s.Quantity,
 		Price:     s.Price,
+		Subtotal:  s.Subtotal,
+		TaxRate:   s.TaxRate,
+		Tax:       s.TaxAmount,
 		SmallName: s.Sku.SmallImage,
 	}
 }
@@ -101,10 +126,37 @@ func (o *OrderUsecase) GetOrderById(ctx context.Context, user *entity.Users, ord
 		return nil, err
 	}
 
-	if !((user.Role == entity.UserRoleAdmin) || (user.Id == order.UserId)) {
+	if !(user.Role.Can(entity.PermOrderRead) || (user.Id != 0 && user.Id == order.UserId)) {
 		return nil, errorStatus.ErrAuth
 	}
 
+	return o.orderWithItems(ctx, order)
+}
+
+// GetGuestOrder looks the order up by its id and the email given at checkout
+func (o *OrderUsecase) GetGuestOrder(ctx context.Context, orderId string, email string) (result *entity.OrderJson, err error) {
+	srvLog := log.WithFields(log.Fields{"func": "OrderUsecase.GetGuestOrder"})
+
+	if _, err := uuid.Parse(orderId); err != nil || email == "" {
+		return nil, errorStatus.ErrNotFound
+	}
+
+	order, err := o.orderRepo.GetOrder(ctx, orderId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.orderRepo.GetOrder")
+		return nil, err
+	}
+	// the same error for a wrong email doesn't reveal existing orders
+	if !strings.EqualFold(order.Email, strings.TrimSpace(email)) {
+		return nil, errorStatus.ErrNotFound
+	}
+
+	return o.orderWithItems(ctx, order)
+}
+
+func (o *OrderUsecase) orderWithItems(ctx context.Context, order *entity.Order) (result *entity.OrderJson, err error) {
+	srvLog := log.WithFields(log.Fields{"func": "OrderUsecase.orderWithItems"})
+
 	orderItems, err := o.orderRepo.GetItem(ctx, order.Id)
 	if err != nil {
 		srvLog.WithError(err).Warning("o.orderRepo.GetItem")
@@ -112,18 +164,13 @@ func (o *OrderUsecase) GetOrderById(ctx context.Context, user *entity.Users, ord
 		return nil, err
 	}
 
-	var totalSum, subTotal float32
 	orderResp := mapOrderToJSON(order)
 
 	OrderItemList := make([]entity.OrderItemSkuJson, len(orderItems))
 	for idx, orderItem := range orderItems {
-		subTotal = float32(orderItem.Quantity) * orderItem.Price
 		OrderItemList[idx] = mapOrderItemToJSON(orderItem)
-		OrderItemList[idx].Subtotal = subTotal
-		totalSum = subTotal + totalSum
 	}
 	orderResp.OrderItem = OrderItemList
-	orderResp.TotalSum = totalSum
 
 	return orderResp, nil
 }
@@ -132,11 +179,49 @@ func (o *OrderUsecase) CreateOrder(ctx context.Context, user *entity.Users, orde
 	srvLog := log.WithFields(log.Fields{"func": "OrderUsecase.CreateOrder"})
 
 	order.UserId = user.Id
+	address, err := o.orderAddress(ctx, user, order.AddressId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.orderAddress")
+		return err
+	}
+	order.SetAddress(address)
+	if order.RegionId == 0 {
+		order.RegionId = user.RegionId
+	}
+	order.Email = user.Email
+
+	return o.placeOrder(ctx, user.CartOwner(), order)
+}
+
+// CreateGuestOrder checks out the anonymous cart of the guest to the address given at checkout
+func (o *OrderUsecase) CreateGuestOrder(ctx context.Context, user *entity.Users, order *entity.Order, address *entity.Address) error {
+	if user.GuestId == uuid.Nil {
+		return errorStatus.ErrAuth
+	}
+	order.Email = strings.TrimSpace(order.Email)
+	if !strings.Contains(order.Email, "@") || address.Phone == "" || address.Recipient == "" ||
+		address.Country == "" || address.City == "" || address.Street == "" {
+		return errorStatus.ErrBadReq
+	}
+
+	order.UserId = 0
+	order.AddressId = 0
+	address.Id = 0
+	order.SetAddress(address)
+
+	return o.placeOrder(ctx, user.CartOwner(), order)
+}
+
+// placeOrder turns the cart of the owner into the order lines
+func (o *OrderUsecase) placeOrder(ctx context.Context, owner entity.CartOwner, order *entity.Order) (err error) {
+	srvLog := log.WithFields(log.Fields{"func": "OrderUsecase.placeOrder"})
+
 	order.SetDefaults()
 
 	//rollback
 	var txId int
-	txId, err := o.orderRepo.NewTxId(ctx)
+	var event *entity.OrderEvent
+	txId, err = o.orderRepo.NewTxId(ctx)
 	if err != nil {
 		srvLog.WithError(err).Error("OrderUsecase - error processing o.orderRepo.NewTxId")
 		return err
@@ -147,48 +232,231 @@ func (o *OrderUsecase) CreateOrder(ctx context.Context, user *entity.Users, orde
 			srvLog.WithError(err).Error("OrderUsecase - error processing o.orderRepo.TxEnd")
 			return
 		}
+		if event != nil {
+			o.events.publish(event)
+		}
 	}()
 
+	items, err := o.orderRepo.GetCartItems(ctx, owner, txId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.orderRepo.GetCartItems")
+		return err
+	}
+	if len(items) == 0 {
+		err = errorStatus.ErrNotFound
+		return err
+	}
+
+	err = o.applyTax(ctx, order, items)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.applyTax")
+		return err
+	}
+
+	// the coupon of an abandoned cart reminder goes to the next order of the user
+	var coupon *entity.Coupon
+	if owner.UserId != 0 {
+		coupon, err = o.orderRepo.GetActiveCoupon(ctx, owner.UserId, txId)
+		if err != nil && err != errorStatus.ErrNotFound {
+			srvLog.WithError(err).Warning("o.orderRepo.GetActiveCoupon")
+			return err
+		}
+		err = nil
+		if coupon != nil {
+			order.DiscountTotal = coupon.Discount(order.Subtotal)
+			order.CalcTotals(items)
+		}
+	}
+
 	orderId, err := o.orderRepo.CreateOrder(ctx, order, txId)
 	if err != nil {
 		srvLog.WithError(err).Warning("o.orderRepo.CreateOrder")
 		return err
 	}
+	order.Id = *orderId
+
+	if coupon != nil {
+		err = o.orderRepo.RedeemCoupon(ctx, coupon.Code, *orderId, txId)
+		if err != nil {
+			srvLog.WithError(err).Warning("o.orderRepo.RedeemCoupon")
+			return err
+		}
+	}
 
-	err = o.orderRepo.CreateOrderItem(ctx, *orderId, order.UserId, txId)
+	err = o.orderRepo.CreateOrderItem(ctx, *orderId, items, txId)
 	if err != nil {
 		srvLog.WithError(err).Warning("o.orderRepo.CreateOrderItem")
 		return err
 	}
 
+	err = o.orderRepo.ClearCart(ctx, owner, txId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.orderRepo.ClearCart")
+		return err
+	}
+
+	created := entity.NewOrderEvent(order, entity.OrderCreated)
+	err = o.orderRepo.CreateOrderEvent(ctx, created, txId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.orderRepo.CreateOrderEvent")
+		return err
+	}
+	event = created
+
+	return nil
+}
+
+// orderAddress returns the address book entry the order is shipped to,
+// the default shipping address is used when no address is given
+func (o *OrderUsecase) orderAddress(ctx context.Context, user *entity.Users, addressId int) (*entity.Address, error) {
+	if addressId == 0 {
+		return o.addressRepo.GetDefaultShipping(ctx, user.Id)
+	}
+	return o.addressRepo.GetAddress(ctx, user.Id, addressId)
+}
+
+// applyTax calculates tax of every line by the rates of the order region
+// and fills the order breakdown
+func (o *OrderUsecase) applyTax(ctx context.Context, order *entity.Order, items []*entity.OrderItem) error {
+	rates, err := o.taxRepo.GetRatesByRegion(ctx, order.RegionId)
+	if err != nil {
+		return err
+	}
+	// rates of the region itself come first and win over the main region ones
+	rateByClass := make(map[int]*entity.TaxRate, len(rates))
+	for _, rate := range rates {
+		if _, ok := rateByClass[rate.TaxClassId]; !ok {
+			rateByClass[rate.TaxClassId] = rate
+		}
+	}
+
+	for _, item := range items {
+		rate := rateByClass[item.TaxClassId]
+		item.Subtotal, item.TaxAmount = rate.Apply(item.Price, item.Quantity)
+		if rate != nil {
+			item.TaxRate = rate.Rate
+		}
+	}
+	order.CalcTotals(items)
 	return nil
 }
 
-func (o *OrderUsecase) UpdateOrder(ctx context.Context, user *entity.Users, order *entity.Order) error {
+// UpdateOrder changes the delivery details of the order. The new address is taken from the address book
+// of the order owner, a new region recalculates the tax of the order
+func (o *OrderUsecase) UpdateOrder(ctx context.Context, user *entity.Users, order *entity.Order) (err error) {
 	srvLog := log.WithFields(log.Fields{"func": "OrderUsecase.UpdateOrder"})
 
-	err := o.orderRepo.UpdateOrder(ctx, order)
+	// the order is looked up among the ones of the user, the order:write permission reaches them all
+	order.UserId = user.Id
+	if user.Role.Can(entity.PermOrderWrite) {
+		order.UserId = 0
+	}
+
+	var items []*entity.OrderItem
+	if order.AddressId != 0 {
+		current, err := o.orderRepo.GetOrder(ctx, order.Id.String())
+		if err != nil {
+			srvLog.WithError(err).Warning("o.orderRepo.GetOrder")
+			return err
+		}
+		if order.UserId != 0 && current.UserId != order.UserId {
+			return errorStatus.ErrNotFound
+		}
+		address, err := o.addressRepo.GetAddress(ctx, current.UserId, order.AddressId)
+		if err != nil {
+			srvLog.WithError(err).Warning("o.addressRepo.GetAddress")
+			return err
+		}
+		order.RegionId = current.RegionId
+		order.SetAddress(address)
+
+		if order.RegionId != current.RegionId {
+			items, err = o.orderRepo.GetItem(ctx, order.Id)
+			if err != nil {
+				srvLog.WithError(err).Warning("o.orderRepo.GetItem")
+				return err
+			}
+			order.ShippingTotal = current.ShippingTotal
+			order.DiscountTotal = current.DiscountTotal
+			if err = o.applyTax(ctx, order, items); err != nil {
+				srvLog.WithError(err).Warning("o.applyTax")
+				return err
+			}
+		}
+	}
+
+	txId, err := o.orderRepo.NewTxId(ctx)
+	if err != nil {
+		srvLog.WithError(err).Error("OrderUsecase - error processing o.orderRepo.NewTxId")
+		return err
+	}
+	defer func() {
+		err = o.orderRepo.TxEnd(ctx, txId, err)
+		if err != nil {
+			srvLog.WithError(err).Error("OrderUsecase - error processing o.orderRepo.TxEnd")
+		}
+	}()
+
+	err = o.orderRepo.UpdateOrder(ctx, order, txId)
 	if err != nil {
 		srvLog.WithError(err).Warning("o.orderRepo.UpdateOrder")
 		return err
 	}
+	if items != nil {
+		err = o.orderRepo.UpdateOrderTax(ctx, order, items, txId)
+		if err != nil {
+			srvLog.WithError(err).Warning("o.orderRepo.UpdateOrderTax")
+			return err
+		}
+	}
 
 	return nil
 }
 
-func (o *OrderUsecase) UpdateOrderStatus(ctx context.Context, user *entity.Users, order *entity.Order) error {
+func (o *OrderUsecase) UpdateOrderStatus(ctx context.Context, user *entity.Users, order *entity.Order) (err error) {
 	srvLog := log.WithFields(log.Fields{"func": "OrderUsecase.UpdateOrderStatus"})
 
-	if user.Role != "ADMIN" {
-		return errorStatus.ErrAuth
+	if !user.Role.Can(entity.PermOrderStatus) {
+		return errorStatus.ErrPermission
+	}
+
+	if order.Status == "" {
+		return errorStatus.ErrBadReq
+	}
+	order.UpdateTs = entity.NowUTC()
+
+	var event *entity.OrderEvent
+	txId, err := o.orderRepo.NewTxId(ctx)
+	if err != nil {
+		srvLog.WithError(err).Error("OrderUsecase - error processing o.orderRepo.NewTxId")
+		return err
 	}
+	// the subscribers get the event once the status change is committed
+	defer func() {
+		err = o.orderRepo.TxEnd(ctx, txId, err)
+		if err != nil {
+			srvLog.WithError(err).Error("OrderUsecase - error processing o.orderRepo.TxEnd")
+			return
+		}
+		if event != nil {
+			o.events.publish(event)
+		}
+	}()
 
-	err := o.orderRepo.UpdateOrderStatus(ctx, order)
+	err = o.orderRepo.UpdateOrderStatus(ctx, order, txId)
 	if err != nil {
 		srvLog.WithError(err).Warning("o.orderRepo.UpdateOrderStatus")
 		return err
 	}
 
+	changed := entity.NewOrderEvent(order, entity.StatusEventType(order.Status))
+	err = o.orderRepo.CreateOrderEvent(ctx, changed, txId)
+	if err != nil {
+		srvLog.WithError(err).Warning("o.orderRepo.CreateOrderEvent")
+		return err
+	}
+	event = changed
+
 	return nil
 }
 
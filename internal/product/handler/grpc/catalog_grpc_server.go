@@ -0,0 +1,305 @@
+package grpc_server
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"go-store/internal/dto"
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+const nanosPerUnit = 1e9
+
+func (g *grpcServer) ListSkus(ctx context.Context, req *ListSkusRequest) (*ListSkusResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.ListSkus"})
+
+	userRole := grpchelper.UserFromContext(ctx).Role
+	result, err := g.usecases.ProductUsecase.GetSku(ctx, int(req.Limit), int(req.Offset), &userRole, mapProductFilter(req.Filter))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.GetSku")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	res := &ListSkusResponse{
+		Total: int32(result.Total),
+		Skus:  make([]*CatalogSku, len(result.SkuJson)),
+	}
+	for idx, sku := range result.SkuJson {
+		res.Skus[idx] = mapCatalogSku(sku)
+	}
+	return res, nil
+}
+
+func (g *grpcServer) GetSku(ctx context.Context, req *GetSkuRequest) (*CatalogSku, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.GetSku"})
+
+	userRole := grpchelper.UserFromContext(ctx).Role
+	sku, err := g.usecases.ProductUsecase.GetSingleProduct(ctx, req.SkuCode, &userRole)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.GetSingleProduct")
+		return nil, grpchelper.StatusError(err)
+	}
+	return mapCatalogSku(sku), nil
+}
+
+func (g *grpcServer) GetSkuOption(ctx context.Context, req *SkuValueIdRequest) (*CatalogOption, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.GetSkuOption"})
+
+	userRole := grpchelper.UserFromContext(ctx).Role
+	option, err := g.usecases.ProductUsecase.GetSkuOption(ctx, int(req.SkuValueId), &userRole)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.GetSkuOption")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	res := &CatalogOption{
+		Id:     int32(option.Id),
+		Name:   option.Name,
+		Values: make([]*CatalogOptionValue, len(option.OptionValueJson)),
+	}
+	for idx, value := range option.OptionValueJson {
+		res.Values[idx] = &CatalogOptionValue{Id: int32(value.Id), Name: value.Name}
+	}
+	return res, nil
+}
+
+func (g *grpcServer) ListProducts(ctx context.Context, req *ListProductsRequest) (*ListProductsResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.ListProducts"})
+
+	result, err := g.usecases.ProductUsecase.GetProductSkus(ctx, int(req.Limit), int(req.Offset), int(req.CategoryId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.GetProductSkus")
+		return nil, grpchelper.StatusError(err)
+	}
+
+	res := &ListProductsResponse{
+		Total:    int32(result.Total),
+		Products: make([]*CatalogProduct, len(result.ProductJson)),
+	}
+	for idx, prod := range result.ProductJson {
+		product := &CatalogProduct{
+			ProductId:   int32(prod.ProductId),
+			Name:        prod.ProductName,
+			Description: prod.Description,
+			CategoryId:  int32(prod.CategoryId),
+			CreateTs:    timestamppb.New(prod.CreateTs),
+			Variants:    make([]*CatalogProductSku, len(prod.ProductSkuJson)),
+		}
+		for i, sku := range prod.ProductSkuJson {
+			product.Variants[i] = &CatalogProductSku{
+				SkuId:    int32(sku.SkuId),
+				SkuCode:  sku.SkuCode,
+				Price:    moneyFromFloat(sku.SkuPrice),
+				Quantity: int32(sku.SkuQuantity),
+				Image:    sku.SkuImage,
+			}
+		}
+		res.Products[idx] = product
+	}
+	return res, nil
+}
+
+func (g *grpcServer) CreateProduct(ctx context.Context, req *ProductInput) (*ProductIdRequest, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.CreateProduct"})
+
+	prod := mapProductInput(req)
+	productId, err := g.usecases.ProductUsecase.CreateProduct(ctx, prod)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.CreateProduct")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &ProductIdRequest{ProductId: int32(*productId)}, nil
+}
+
+func (g *grpcServer) UpdateProduct(ctx context.Context, req *UpdateProductRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.UpdateProduct"})
+
+	if req.Product == nil {
+		return nil, grpchelper.StatusError(errorStatus.ErrBadReq)
+	}
+	prod := mapProductInput(req.Product)
+	prod.Id = int(req.ProductId)
+	err := g.usecases.ProductUsecase.UpdateProduct(ctx, prod)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.UpdateProduct")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) DeleteProduct(ctx context.Context, req *ProductIdRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.DeleteProduct"})
+
+	err := g.usecases.ProductUsecase.DeleteProduct(ctx, int(req.ProductId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.DeleteProduct")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) CreateSku(ctx context.Context, req *SkuInput) (*SkuCodeResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.CreateSku"})
+
+	sku, err := mapSkuInput(req)
+	if err != nil {
+		return nil, grpchelper.StatusError(err)
+	}
+	err = g.usecases.ProductUsecase.CreateSku(ctx, sku)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.CreateSku")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &SkuCodeResponse{SkuCode: sku.Sku}, nil
+}
+
+func (g *grpcServer) UpdateSku(ctx context.Context, req *SkuInput) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.UpdateSku"})
+
+	if req.SkuCode == "" {
+		return nil, grpchelper.StatusError(errorStatus.ErrBadReq)
+	}
+	sku, err := mapSkuInput(req)
+	if err != nil {
+		return nil, grpchelper.StatusError(err)
+	}
+	err = g.usecases.ProductUsecase.UpdateSku(ctx, sku)
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.UpdateSku")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) DeleteSku(ctx context.Context, req *SkuIdRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.DeleteSku"})
+
+	err := g.usecases.ProductUsecase.DeleteSku(ctx, int(req.SkuId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.DeleteSku")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) AddSkuOption(ctx context.Context, req *SkuOptionRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.AddSkuOption"})
+
+	if req.SkuCode == "" {
+		return nil, grpchelper.StatusError(errorStatus.ErrBadReq)
+	}
+	err := g.usecases.ProductUsecase.CreateProductOption(ctx, &dto.ProductOptionRequest{
+		SkuId:         req.SkuCode,
+		OptionId:      int(req.OptionId),
+		OptionValueId: int(req.OptionValueId),
+	})
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.CreateProductOption")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (g *grpcServer) RemoveSkuOption(ctx context.Context, req *SkuValueIdRequest) (*emptypb.Empty, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{"grpc": "ProductHandler.RemoveSkuOption"})
+
+	err := g.usecases.ProductUsecase.DeleteProductOption(ctx, int(req.SkuValueId))
+	if err != nil {
+		glog.WithError(err).Warning("g.usecases.ProductUsecase.DeleteProductOption")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func mapCatalogSku(s *entity.SkuJson) *CatalogSku {
+	return &CatalogSku{
+		SkuId:       int32(s.SkuId),
+		SkuCode:     s.SkuCode,
+		ProductName: s.ProductName,
+		Description: s.Description,
+		CategoryId:  int32(s.CategoryId),
+		Price:       moneyFromFloat(s.SkuPrice),
+		Quantity:    int32(s.SkuQuantity),
+		Image:       s.SkuImage,
+		CountViewed: int32(s.CountViewed),
+		SkuValueIds: s.SkuValueId,
+		CreateTs:    timestamppb.New(s.CreateTs),
+	}
+}
+
+func mapProductInput(p *ProductInput) *entity.Product {
+	now := time.Now()
+	return &entity.Product{
+		ProductName: p.Name,
+		Description: p.Description,
+		CategoryId:  int(p.CategoryId),
+		BrandId:     int(p.BrandId),
+		RegionId:    int(p.RegionId),
+		TaxClassId:  int(p.TaxClassId),
+		CreateTs:    now,
+		UpdateTs:    now,
+		State:       entity.Enabled,
+		Version:     0,
+	}
+}
+
+// mapSkuInput reads the sku like the http sku forms, the images are the paths of already uploaded files
+func mapSkuInput(s *SkuInput) (*entity.Sku, error) {
+	var violations []errorStatus.FieldViolation
+	state := entity.Enabled
+	if s.State != "" {
+		var err error
+		state, err = entity.ParseState(s.State)
+		if err != nil {
+			violations = append(violations, errorStatus.FieldViolation{Field: "State", Description: "unknown state"})
+		}
+	}
+	if s.Price == nil {
+		violations = append(violations, errorStatus.FieldViolation{Field: "Price", Description: "required"})
+	}
+	if s.Quantity < 0 {
+		violations = append(violations, errorStatus.FieldViolation{Field: "Quantity", Description: "must not be negative"})
+	}
+	if s.MaxPerOrder < 0 {
+		violations = append(violations, errorStatus.FieldViolation{Field: "MaxPerOrder", Description: "must not be negative"})
+	}
+	if len(violations) > 0 {
+		return nil, errorStatus.ErrBadReq.WithViolations(violations...)
+	}
+
+	now := time.Now()
+	return &entity.Sku{
+		ProductId:   int(s.ProductId),
+		Sku:         s.SkuCode,
+		Price:       s.Price.toFloat(),
+		Quantity:    int(s.Quantity),
+		MaxPerOrder: int(s.MaxPerOrder),
+		LargeImage:  strings.Join(s.Images, ","),
+		CreateTs:    now,
+		UpdateTs:    now,
+		State:       state,
+		Version:     0,
+	}, nil
+}
+
+func moneyFromFloat(amount float32) *Money {
+	rounded := entity.RoundMoney(amount)
+	units, frac := math.Modf(float64(rounded))
+	return &Money{
+		Units: int64(units),
+		Nanos: int32(math.Round(frac*100) * nanosPerUnit / 100),
+	}
+}
+
+func (m *Money) toFloat() float32 {
+	return entity.RoundMoney(float32(float64(m.Units) + float64(m.Nanos)/nanosPerUnit))
+}
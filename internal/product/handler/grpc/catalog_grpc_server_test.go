@@ -0,0 +1,137 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/product/mock"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+func TestCatalogServer(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	prodMock := mocks.NewMockProductUsecase(mockCtrl)
+	srv := &grpcServer{
+		usecases: &entity.Usecases{
+			ProductUsecase: prodMock,
+		},
+	}
+	guest := &entity.Users{Role: entity.UserRoleGuest}
+	ctx := grpchelper.ContextWithUser(context.Background(), guest)
+
+	t.Run("list skus with the price as money", func(t *testing.T) {
+		result := &entity.ResultSkuJSon{
+			Total:   1,
+			SkuJson: []*entity.SkuJson{{SkuId: 3, SkuCode: "tee-red", SkuPrice: 12.5, SkuValueId: []int32{7}}},
+		}
+		prodMock.EXPECT().GetSku(ctx, 10, 0, &guest.Role, any).Return(result, nil).Times(1)
+
+		res, err := srv.ListSkus(ctx, &ListSkusRequest{Limit: 10, Filter: &ProductRequestFilter{CategoryId: 2}})
+		req.NoError(err)
+		req.Equal(int32(1), res.Total)
+		req.Equal("tee-red", res.Skus[0].SkuCode)
+		req.Equal(&Money{Units: 12, Nanos: 500000000}, res.Skus[0].Price)
+		req.Equal([]int32{7}, res.Skus[0].SkuValueIds)
+	})
+
+	t.Run("get a missing sku", func(t *testing.T) {
+		prodMock.EXPECT().GetSingleProduct(ctx, "none", &guest.Role).Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		_, err := srv.GetSku(ctx, &GetSkuRequest{SkuCode: "none"})
+		req.Equal(codes.NotFound, status.Code(err))
+	})
+
+	t.Run("list products with their variants", func(t *testing.T) {
+		result := &entity.ResultProductJSon{
+			Total: 1,
+			ProductJson: []*entity.ProductJson{{
+				ProductId:      4,
+				ProductName:    "Tee",
+				ProductSkuJson: []*entity.ProductSkuJson{{SkuId: 3, SkuCode: "tee-red", SkuPrice: 0.1}},
+			}},
+		}
+		prodMock.EXPECT().GetProductSkus(ctx, 5, 0, 2).Return(result, nil).Times(1)
+
+		res, err := srv.ListProducts(ctx, &ListProductsRequest{Limit: 5, CategoryId: 2})
+		req.NoError(err)
+		req.Equal(int32(4), res.Products[0].ProductId)
+		req.Equal(&Money{Nanos: 100000000}, res.Products[0].Variants[0].Price)
+	})
+
+	t.Run("create sku", func(t *testing.T) {
+		prodMock.EXPECT().CreateSku(ctx, any).DoAndReturn(func(_ context.Context, sku *entity.Sku) error {
+			req.Equal(float32(19.99), sku.Price)
+			req.Equal("a.png,b.png", sku.LargeImage)
+			req.Equal(entity.Enabled, sku.State)
+			sku.Sku = "tee-blue"
+			return nil
+		}).Times(1)
+
+		res, err := srv.CreateSku(ctx, &SkuInput{ProductId: 4, Price: &Money{Units: 19, Nanos: 990000000}, Quantity: 3, Images: []string{"a.png", "b.png"}})
+		req.NoError(err)
+		req.Equal("tee-blue", res.SkuCode)
+	})
+
+	t.Run("create sku with invalid fields", func(t *testing.T) {
+		_, err := srv.CreateSku(ctx, &SkuInput{ProductId: 4, Quantity: -1, State: "gone"})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+		violations := grpchelper.DomainError(status.Convert(err)).Violations
+		req.ElementsMatch([]errorStatus.FieldViolation{
+			{Field: "State", Description: "unknown state"},
+			{Field: "Price", Description: "required"},
+			{Field: "Quantity", Description: "must not be negative"},
+		}, violations)
+	})
+
+	t.Run("update sku without the code", func(t *testing.T) {
+		_, err := srv.UpdateSku(ctx, &SkuInput{Price: &Money{Units: 1}})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("update product", func(t *testing.T) {
+		prodMock.EXPECT().UpdateProduct(ctx, any).DoAndReturn(func(_ context.Context, prod *entity.Product) error {
+			req.Equal(4, prod.Id)
+			req.Equal("Tee", prod.ProductName)
+			return nil
+		}).Times(1)
+
+		_, err := srv.UpdateProduct(ctx, &UpdateProductRequest{ProductId: 4, Product: &ProductInput{Name: "Tee"}})
+		req.NoError(err)
+	})
+
+	t.Run("update product without the product", func(t *testing.T) {
+		_, err := srv.UpdateProduct(ctx, &UpdateProductRequest{ProductId: 4})
+		req.Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("add option to a sku", func(t *testing.T) {
+		prodMock.EXPECT().CreateProductOption(ctx, any).Return(nil).Times(1)
+
+		_, err := srv.AddSkuOption(ctx, &SkuOptionRequest{SkuCode: "tee-red", OptionId: 1, OptionValueId: 2})
+		req.NoError(err)
+	})
+
+	t.Run("remove a missing sku option", func(t *testing.T) {
+		prodMock.EXPECT().DeleteProductOption(ctx, 9).Return(errorStatus.ErrNotFound).Times(1)
+
+		_, err := srv.RemoveSkuOption(ctx, &SkuValueIdRequest{SkuValueId: 9})
+		req.Equal(codes.NotFound, status.Code(err))
+	})
+}
+
+func TestMoney(t *testing.T) {
+	for _, amount := range []float32{0, 0.01, 0.1, 12.5, 19.99, 1000.05} {
+		require.Equal(t, amount, moneyFromFloat(amount).toFloat())
+	}
+}
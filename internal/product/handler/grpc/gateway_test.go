@@ -0,0 +1,131 @@
+package grpc_server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/test/bufconn"
+
+	"go-store/internal/dto"
+	"go-store/internal/entity"
+	mocks "go-store/internal/product/mock"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+// TestGatewayRoutes calls the product service through the REST routes of its http annotations
+func TestGatewayRoutes(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	prodMock := mocks.NewMockProductUsecase(mockCtrl)
+	guestRole := entity.UserRoleGuest
+
+	// the metadata of the last call, the interceptors read the tokens from it
+	var callMd metadata.MD
+	lis := bufconn.Listen(1 << 20)
+	gsrv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, msg interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		callMd, _ = metadata.FromIncomingContext(ctx)
+		return handler(ctx, msg)
+	}))
+	RegisterServices(gsrv, &entity.Usecases{ProductUsecase: prodMock})
+	go gsrv.Serve(lis)
+	defer gsrv.Stop()
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	req.NoError(err)
+	defer conn.Close()
+
+	mux := grpchelper.NewGatewayMux()
+	req.NoError(RegisterGateway(ctx, mux, conn))
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Any("/api/v2/*path", grpchelper.GatewayHandler(mux))
+
+	serve := func(method, target, body string, header http.Header) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, target, strings.NewReader(body))
+		for key, values := range header {
+			r.Header[key] = values
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("product listing with the filter of the query", func(t *testing.T) {
+		categoryId := 2
+		prodMock.EXPECT().GetSku(any, 10, 0, &guestRole, any).
+			DoAndReturn(func(_ context.Context, _ int, _ int, _ *entity.UserRole, filter *dto.ProductListFilter) (*entity.ResultSkuJSon, error) {
+				req.Equal(&categoryId, filter.CategoryId)
+				return &entity.ResultSkuJSon{Total: 1, SkuJson: []*entity.SkuJson{{SkuCode: "tee-red"}}}, nil
+			}).Times(1)
+
+		w := serve(http.MethodGet, "/api/v2/product-skus?Limit=10&filter.CategoryId=2", "", nil)
+		req.Equal(http.StatusOK, w.Code)
+		var res map[string]interface{}
+		req.NoError(json.Unmarshal(w.Body.Bytes(), &res))
+		req.Equal("1", res["Total"])
+	})
+
+	t.Run("single product by the sku code of the path", func(t *testing.T) {
+		prodMock.EXPECT().GetSingleProduct(any, "tee-red", &guestRole).Return(&entity.SkuJson{SkuCode: "tee-red"}, nil).Times(1)
+
+		w := serve(http.MethodGet, "/api/v2/product-skus/tee-red", "", nil)
+		req.Equal(http.StatusOK, w.Code)
+		req.Contains(w.Body.String(), `"SkuCode":"tee-red"`)
+	})
+
+	t.Run("missing sku is the not found problem", func(t *testing.T) {
+		prodMock.EXPECT().GetSingleProduct(any, "none", &guestRole).Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		w := serve(http.MethodGet, "/api/v2/skus/none", "", nil)
+		req.Equal(http.StatusNotFound, w.Code)
+		req.Equal(errorStatus.ProblemContentType, w.Header().Get("Content-Type"))
+	})
+
+	t.Run("create product from the body with the access token", func(t *testing.T) {
+		productId := 4
+		prodMock.EXPECT().CreateProduct(any, any).DoAndReturn(func(_ context.Context, prod *entity.Product) (*int, error) {
+			req.Equal("Tee", prod.ProductName)
+			req.Equal(2, prod.CategoryId)
+			return &productId, nil
+		}).Times(1)
+
+		w := serve(http.MethodPost, "/api/v2/products", `{"Name":"Tee","CategoryId":2}`,
+			http.Header{"X-Access-Token": {"token"}, "X-Forwarded-For": {"10.0.0.1"}})
+		req.Equal(http.StatusOK, w.Code)
+		req.Contains(w.Body.String(), `"ProductId":4`)
+		req.Equal([]string{"token"}, callMd.Get("x-access-token"))
+		req.NotContains(callMd.Get("x-forwarded-for"), "10.0.0.1")
+	})
+
+	t.Run("delete sku by the id of the path", func(t *testing.T) {
+		prodMock.EXPECT().DeleteSku(any, 5).Return(nil).Times(1)
+
+		w := serve(http.MethodDelete, "/api/v2/sku-ids/5", "", nil)
+		req.Equal(http.StatusOK, w.Code)
+	})
+
+	t.Run("unknown route", func(t *testing.T) {
+		w := serve(http.MethodGet, "/api/v2/unknown", "", nil)
+		req.Equal(http.StatusNotFound, w.Code)
+	})
+}
@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -22,8 +23,23 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ProductHandlerClient interface {
+	//string typed listings of the skus
 	Products(ctx context.Context, in *ProductRequest, opts ...grpc.CallOption) (*ProductReponse, error)
 	ProductSingle(ctx context.Context, in *ProductRequest, opts ...grpc.CallOption) (*ProductReponse, error)
+	ListSkus(ctx context.Context, in *ListSkusRequest, opts ...grpc.CallOption) (*ListSkusResponse, error)
+	GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*CatalogSku, error)
+	GetSkuOption(ctx context.Context, in *SkuValueIdRequest, opts ...grpc.CallOption) (*CatalogOption, error)
+	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	//admin
+	CreateProduct(ctx context.Context, in *ProductInput, opts ...grpc.CallOption) (*ProductIdRequest, error)
+	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteProduct(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateSku(ctx context.Context, in *SkuInput, opts ...grpc.CallOption) (*SkuCodeResponse, error)
+	UpdateSku(ctx context.Context, in *SkuInput, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	//DeleteSku takes the numeric sku id, the other sku paths the sku code
+	DeleteSku(ctx context.Context, in *SkuIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AddSkuOption(ctx context.Context, in *SkuOptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveSkuOption(ctx context.Context, in *SkuValueIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type productHandlerClient struct {
@@ -52,12 +68,135 @@ func (c *productHandlerClient) ProductSingle(ctx context.Context, in *ProductReq
 	return out, nil
 }
 
+func (c *productHandlerClient) ListSkus(ctx context.Context, in *ListSkusRequest, opts ...grpc.CallOption) (*ListSkusResponse, error) {
+	out := new(ListSkusResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/ListSkus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*CatalogSku, error) {
+	out := new(CatalogSku)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/GetSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) GetSkuOption(ctx context.Context, in *SkuValueIdRequest, opts ...grpc.CallOption) (*CatalogOption, error) {
+	out := new(CatalogOption)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/GetSkuOption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/ListProducts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) CreateProduct(ctx context.Context, in *ProductInput, opts ...grpc.CallOption) (*ProductIdRequest, error) {
+	out := new(ProductIdRequest)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/CreateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/UpdateProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) DeleteProduct(ctx context.Context, in *ProductIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/DeleteProduct", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) CreateSku(ctx context.Context, in *SkuInput, opts ...grpc.CallOption) (*SkuCodeResponse, error) {
+	out := new(SkuCodeResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/CreateSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) UpdateSku(ctx context.Context, in *SkuInput, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/UpdateSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) DeleteSku(ctx context.Context, in *SkuIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/DeleteSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) AddSkuOption(ctx context.Context, in *SkuOptionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/AddSkuOption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productHandlerClient) RemoveSkuOption(ctx context.Context, in *SkuValueIdRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/grpc_handler.ProductHandler/RemoveSkuOption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductHandlerServer is the server API for ProductHandler service.
 // All implementations must embed UnimplementedProductHandlerServer
 // for forward compatibility
 type ProductHandlerServer interface {
+	//string typed listings of the skus
 	Products(context.Context, *ProductRequest) (*ProductReponse, error)
 	ProductSingle(context.Context, *ProductRequest) (*ProductReponse, error)
+	ListSkus(context.Context, *ListSkusRequest) (*ListSkusResponse, error)
+	GetSku(context.Context, *GetSkuRequest) (*CatalogSku, error)
+	GetSkuOption(context.Context, *SkuValueIdRequest) (*CatalogOption, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	//admin
+	CreateProduct(context.Context, *ProductInput) (*ProductIdRequest, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*emptypb.Empty, error)
+	DeleteProduct(context.Context, *ProductIdRequest) (*emptypb.Empty, error)
+	CreateSku(context.Context, *SkuInput) (*SkuCodeResponse, error)
+	UpdateSku(context.Context, *SkuInput) (*emptypb.Empty, error)
+	//DeleteSku takes the numeric sku id, the other sku paths the sku code
+	DeleteSku(context.Context, *SkuIdRequest) (*emptypb.Empty, error)
+	AddSkuOption(context.Context, *SkuOptionRequest) (*emptypb.Empty, error)
+	RemoveSkuOption(context.Context, *SkuValueIdRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedProductHandlerServer()
 }
 
@@ -71,6 +210,42 @@ func (UnimplementedProductHandlerServer) Products(context.Context, *ProductReque
 func (UnimplementedProductHandlerServer) ProductSingle(context.Context, *ProductRequest) (*ProductReponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProductSingle not implemented")
 }
+func (UnimplementedProductHandlerServer) ListSkus(context.Context, *ListSkusRequest) (*ListSkusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSkus not implemented")
+}
+func (UnimplementedProductHandlerServer) GetSku(context.Context, *GetSkuRequest) (*CatalogSku, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSku not implemented")
+}
+func (UnimplementedProductHandlerServer) GetSkuOption(context.Context, *SkuValueIdRequest) (*CatalogOption, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkuOption not implemented")
+}
+func (UnimplementedProductHandlerServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductHandlerServer) CreateProduct(context.Context, *ProductInput) (*ProductIdRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductHandlerServer) UpdateProduct(context.Context, *UpdateProductRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductHandlerServer) DeleteProduct(context.Context, *ProductIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductHandlerServer) CreateSku(context.Context, *SkuInput) (*SkuCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSku not implemented")
+}
+func (UnimplementedProductHandlerServer) UpdateSku(context.Context, *SkuInput) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSku not implemented")
+}
+func (UnimplementedProductHandlerServer) DeleteSku(context.Context, *SkuIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSku not implemented")
+}
+func (UnimplementedProductHandlerServer) AddSkuOption(context.Context, *SkuOptionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSkuOption not implemented")
+}
+func (UnimplementedProductHandlerServer) RemoveSkuOption(context.Context, *SkuValueIdRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSkuOption not implemented")
+}
 func (UnimplementedProductHandlerServer) mustEmbedUnimplementedProductHandlerServer() {}
 
 // UnsafeProductHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +295,222 @@ func _ProductHandler_ProductSingle_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductHandler_ListSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).ListSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/ListSkus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).ListSkus(ctx, req.(*ListSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_GetSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).GetSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/GetSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).GetSku(ctx, req.(*GetSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_GetSkuOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuValueIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).GetSkuOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/GetSkuOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).GetSkuOption(ctx, req.(*SkuValueIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/ListProducts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/CreateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).CreateProduct(ctx, req.(*ProductInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/UpdateProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProductIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/DeleteProduct",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).DeleteProduct(ctx, req.(*ProductIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_CreateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).CreateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/CreateSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).CreateSku(ctx, req.(*SkuInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_UpdateSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuInput)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).UpdateSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/UpdateSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).UpdateSku(ctx, req.(*SkuInput))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_DeleteSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).DeleteSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/DeleteSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).DeleteSku(ctx, req.(*SkuIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_AddSkuOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).AddSkuOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/AddSkuOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).AddSkuOption(ctx, req.(*SkuOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductHandler_RemoveSkuOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SkuValueIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductHandlerServer).RemoveSkuOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.ProductHandler/RemoveSkuOption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductHandlerServer).RemoveSkuOption(ctx, req.(*SkuValueIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductHandler_ServiceDesc is the grpc.ServiceDesc for ProductHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +526,54 @@ var ProductHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProductSingle",
 			Handler:    _ProductHandler_ProductSingle_Handler,
 		},
+		{
+			MethodName: "ListSkus",
+			Handler:    _ProductHandler_ListSkus_Handler,
+		},
+		{
+			MethodName: "GetSku",
+			Handler:    _ProductHandler_GetSku_Handler,
+		},
+		{
+			MethodName: "GetSkuOption",
+			Handler:    _ProductHandler_GetSkuOption_Handler,
+		},
+		{
+			MethodName: "ListProducts",
+			Handler:    _ProductHandler_ListProducts_Handler,
+		},
+		{
+			MethodName: "CreateProduct",
+			Handler:    _ProductHandler_CreateProduct_Handler,
+		},
+		{
+			MethodName: "UpdateProduct",
+			Handler:    _ProductHandler_UpdateProduct_Handler,
+		},
+		{
+			MethodName: "DeleteProduct",
+			Handler:    _ProductHandler_DeleteProduct_Handler,
+		},
+		{
+			MethodName: "CreateSku",
+			Handler:    _ProductHandler_CreateSku_Handler,
+		},
+		{
+			MethodName: "UpdateSku",
+			Handler:    _ProductHandler_UpdateSku_Handler,
+		},
+		{
+			MethodName: "DeleteSku",
+			Handler:    _ProductHandler_DeleteSku_Handler,
+		},
+		{
+			MethodName: "AddSkuOption",
+			Handler:    _ProductHandler_AddSkuOption_Handler,
+		},
+		{
+			MethodName: "RemoveSkuOption",
+			Handler:    _ProductHandler_RemoveSkuOption_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/product/handler/grpc/product_grpc_handler.proto",
@@ -10,10 +10,11 @@ import (
 	"github.com/bnkamalesh/webgo/v6"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
-	status "google.golang.org/grpc/status"
+
+	grpchelper "go-store/utils/grpc"
 )
 
 type grpcServer struct {
@@ -21,6 +22,36 @@ type grpcServer struct {
 	UnimplementedProductHandlerServer
 }
 
+// AuthPermissions are the permissions required by the catalog methods, the mutations of the catalog
+var AuthPermissions = map[string]entity.Permission{
+	"/grpc_handler.ProductHandler/Products":        entity.PermGuest,
+	"/grpc_handler.ProductHandler/ProductSingle":   entity.PermGuest,
+	"/grpc_handler.ProductHandler/ListSkus":        entity.PermGuest,
+	"/grpc_handler.ProductHandler/GetSku":          entity.PermGuest,
+	"/grpc_handler.ProductHandler/GetSkuOption":    entity.PermGuest,
+	"/grpc_handler.ProductHandler/ListProducts":    entity.PermGuest,
+	"/grpc_handler.ProductHandler/CreateProduct":   entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/UpdateProduct":   entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/DeleteProduct":   entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/CreateSku":       entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/UpdateSku":       entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/DeleteSku":       entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/AddSkuOption":    entity.PermProductWrite,
+	"/grpc_handler.ProductHandler/RemoveSkuOption": entity.PermProductWrite,
+}
+
+// RegisterServices registers the product service on the shared gRPC server
+func RegisterServices(gsrv *grpc.Server, us *entity.Usecases) {
+	RegisterProductHandlerServer(gsrv, &grpcServer{
+		usecases: us,
+	})
+}
+
+// RegisterGateway routes the REST calls of the product service through the gateway mux to the gRPC server
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterProductHandlerHandler(ctx, mux, conn)
+}
+
 func NewGRPCServer(cfg *entity.Config, us *entity.Usecases) (*grpc.Server, net.Listener, error) {
 	host := fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)
 	lis, err := net.Listen("tcp", host)
@@ -44,27 +75,13 @@ func (g *grpcServer) Products(ctx context.Context, productGrpc *ProductRequest)
 		"grpc": "Products",
 	})
 
-	catId := int(productGrpc.Filter.CategoryId)
-	brandId := int(productGrpc.Filter.BrandId)
-	regionId := int(productGrpc.Filter.RegionId)
-	start := float32(productGrpc.Filter.SkuPriceStart)
-	end := float32(productGrpc.Filter.SkuPriceEnd)
+	filter := mapProductFilter(productGrpc.Filter)
 
-	filter := &dto.ProductListFilter{
-		ProductName: productGrpc.Filter.ProductName,
-		Description: productGrpc.Filter.Description,
-		CategoryId:  &catId,
-		BrandId:     &brandId,
-		RegionId:    &regionId,
-		PriceStart:  &start,
-		PriceEnd:    &end,
-	}
-
-	userRole := entity.UserRole("USER")
+	userRole := grpchelper.UserFromContext(ctx).Role
 	productUsecase, err := g.usecases.ProductUsecase.GetSku(ctx, int(productGrpc.Limit), int(productGrpc.Offset), &userRole, filter)
 	if err != nil {
 		glog.WithError(err).Error("Products - error while processing g.usecases.ProductUsecase.GetSku")
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpchelper.StatusError(err)
 	}
 	var res ProductReponse
 	productSku := make([]*SkuJson, len(productUsecase.SkuJson))
@@ -76,6 +93,44 @@ func (g *grpcServer) Products(ctx context.Context, productGrpc *ProductRequest)
 	return &res, nil
 }
 
+func (g *grpcServer) ProductSingle(ctx context.Context, productGrpc *ProductRequest) (*ProductReponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{
+		"grpc": "ProductSingle",
+	})
+
+	userRole := grpchelper.UserFromContext(ctx).Role
+	sku, err := g.usecases.ProductUsecase.GetSingleProduct(ctx, productGrpc.SkuCode, &userRole)
+	if err != nil {
+		glog.WithError(err).Warning("ProductSingle - error while processing g.usecases.ProductUsecase.GetSingleProduct")
+		return nil, grpchelper.StatusError(err)
+	}
+	return &ProductReponse{
+		Total:   "1",
+		SkuJson: []*SkuJson{mapProductSku(sku)},
+	}, nil
+}
+
+func mapProductFilter(f *ProductRequestFilter) *dto.ProductListFilter {
+	if f == nil {
+		return &dto.ProductListFilter{}
+	}
+	catId := int(f.CategoryId)
+	brandId := int(f.BrandId)
+	regionId := int(f.RegionId)
+	start := f.SkuPriceStart
+	end := f.SkuPriceEnd
+
+	return &dto.ProductListFilter{
+		ProductName: f.ProductName,
+		Description: f.Description,
+		CategoryId:  &catId,
+		BrandId:     &brandId,
+		RegionId:    &regionId,
+		PriceStart:  &start,
+		PriceEnd:    &end,
+	}
+}
+
 func mapProductSku(s *entity.SkuJson) *SkuJson {
 	return &SkuJson{
 		ProductName: s.ProductName,
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"encoding/json"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
@@ -34,21 +33,21 @@ func NewProductHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entity
 	}
 	h := handler.Group("/product")
 	{
-		h.POST("", mdw, ph.products)
-		h.POST("/:skuCode", mdw, ph.singleProduct)
-		h.POST("/skuvalue/:skuValueId", mdw, ph.optionBySkuValue)
+		h.GET("", mdw, ph.products)
+		h.GET("/:skuCode", mdw, ph.singleProduct)
+		h.GET("/skuvalue/:skuValueId", mdw, ph.optionBySkuValue)
 	}
-	a := handler.Group("/admin")
+	a := handler.Group("/admin", mdw, httphelper.RequirePermission(entity.PermProductWrite))
 	{
-		a.POST("/products", mdw, ph.productBySkus)
-		a.POST("/product", mdw, ph.createProduct)
-		a.PUT("/product/:productId", mdw, ph.updateProduct)
-		a.DELETE("/product/:productId", mdw, ph.deleteProduct)
-		a.POST("/sku/option/:sku", mdw, ph.createProductOption) //add id's of option and value.
-		a.DELETE("/sku/option/:skuValueId", mdw, ph.deleteProductOption)
-		a.POST("/product/:productId/sku", mdw, ph.createSku)
-		a.PUT("/sku/:sku", mdw, ph.updateSku)
-		a.DELETE("/sku/:skuId", mdw, ph.deleteSku)
+		a.POST("/products", ph.productBySkus)
+		a.POST("/product", ph.createProduct)
+		a.PUT("/product/:productId", ph.updateProduct)
+		a.DELETE("/product/:productId", ph.deleteProduct)
+		a.POST("/sku/option/:sku", ph.createProductOption) //add id's of option and value.
+		a.DELETE("/sku/option/:skuValueId", ph.deleteProductOption)
+		a.POST("/product/:productId/sku", ph.createSku)
+		a.PUT("/sku/:sku", ph.updateSku)
+		a.DELETE("/sku/:skuId", ph.deleteSku)
 	}
 
 }
@@ -65,14 +64,14 @@ func (ph *ProductHandler) products(c *gin.Context) {
 	}
 	user := userCtx.(*entity.Users)
 	var listReq dto.ProductListRequest
-	if err := json.NewDecoder(c.Request.Body).Decode(&listReq); err != nil {
-		srvLog.WithError(err).Error("format is wrong")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+	if err := httphelper.BindQuery(c, &listReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindQuery")
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
-	srvLog.WithFields(log.Fields{"limit": listReq.Limit, "offset": listReq.Offset, "filter": listReq.Filter})
+	srvLog.WithFields(log.Fields{"limit": listReq.Limit, "offset": listReq.Offset, "filter": listReq.Filter()})
 
-	result, err := ph.PrUsecase.GetSku(c, listReq.Limit, listReq.Offset, &user.Role, listReq.Filter)
+	result, err := ph.PrUsecase.GetSku(c, listReq.Limit, listReq.Offset, &user.Role, listReq.Filter())
 	if err != nil {
 		srvLog.Warning("Cannot GetSkuProduct, Err: ", err)
 		httphelper.SendResponse(c, nil, err)
@@ -90,7 +89,7 @@ func (ph *ProductHandler) productBySkus(c *gin.Context) {
 	pageParam, err := httphelper.SortingParams(c)
 	if err != nil {
 		srvLog.Warning(err)
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
@@ -138,14 +137,6 @@ func (ph *ProductHandler) singleProduct(c *gin.Context) {
 func (ph *ProductHandler) createProduct(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "CreateProduct"})
 
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	prodForm, err := httphelper.ProdCreateForm(c)
 	if err != nil {
 		srvLog.WithError(err).Warning("httphelper.ProdCreateForm")
@@ -165,14 +156,6 @@ func (ph *ProductHandler) createProduct(c *gin.Context) {
 func (ph *ProductHandler) updateProduct(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "ProductRemove"})
 
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	productIdStr := c.Param("productId")
 
 	productId, err := strconv.Atoi(productIdStr)
@@ -201,14 +184,6 @@ func (ph *ProductHandler) updateProduct(c *gin.Context) {
 
 func (ph *ProductHandler) deleteProduct(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "ProductRemove"})
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	productIdStr := c.Param("productId")
 
 	productId, err := strconv.Atoi(productIdStr)
@@ -229,15 +204,12 @@ func (ph *ProductHandler) deleteProduct(c *gin.Context) {
 
 func (ph *ProductHandler) createProductOption(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "CreateProductOption"})
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	optionForm, err := httphelper.OptionForm(c)
+	if err != nil {
+		srvLog.WithError(err).Warning("httphelper.OptionForm")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
 
 	err = ph.PrUsecase.CreateProductOption(c, optionForm)
 	if err != nil {
@@ -251,14 +223,6 @@ func (ph *ProductHandler) createProductOption(c *gin.Context) {
 
 func (ph *ProductHandler) deleteProductOption(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "ProductRemove"})
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	skuValueIdStr := c.Param("skuValueId")
 
 	skuValueId, err := strconv.Atoi(skuValueIdStr)
@@ -279,14 +243,6 @@ func (ph *ProductHandler) deleteProductOption(c *gin.Context) {
 
 func (ph *ProductHandler) createSku(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "CreateProduct"})
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	skuForm, err := httphelper.SkuCreateForm(c)
 	if err != nil {
 		srvLog.WithError(err).Warning("httphelper.ProdCreateForm")
@@ -306,14 +262,6 @@ func (ph *ProductHandler) createSku(c *gin.Context) {
 
 func (ph *ProductHandler) updateSku(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "CreateProduct"})
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	skuForm, err := httphelper.SkuUpdateForm(c)
 	if err != nil {
 		srvLog.WithError(err).Warning("httphelper.ProdCreateForm")
@@ -333,14 +281,6 @@ func (ph *ProductHandler) updateSku(c *gin.Context) {
 
 func (ph *ProductHandler) deleteSku(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "DeleteSku"})
-	// exist := httphelper.CheckIfAdmin(c)
-	// if !exist {
-	// 	err := errorstatus.ErrAuth
-	// 	srvLog.WithError(err).Warning("Not ADMIN role. Access denied")
-	// 	httphelper.SendResponse(c, nil, err)
-	// 	return
-	// }
-
 	skuIdStr := c.Param("skuId")
 	skuId, err := strconv.Atoi(skuIdStr)
 	if err != nil {
@@ -175,6 +175,7 @@ func (d *PgxAccess) GetSingleProduct(ctx context.Context, skuCode string, skuId
 			"sku.sku",
 			"sku.price",
 			"sku.quantity",
+			"sku.max_per_order",
 			"sku.large_name",
 			"sku.small_name",
 			"sku.thumb_name",
@@ -200,7 +201,7 @@ func (d *PgxAccess) GetSingleProduct(ctx context.Context, skuCode string, skuId
 		return nil, nil, err
 	}
 	row := d.Pool.QueryRow(ctx, query, args...)
-	err = row.Scan(&sku.Id, &sku.ProductId, &sku.Sku, &sku.Price, &sku.Quantity, &sku.LargeImage, &sku.SmallImage, &sku.ThumbImage, &sku.CountViewed, &sku.CreateTs, &sku.UpdateTs, &sku.State, &sku.Version, &prod.ProductName, &prod.Description, &prod.CategoryId, &prod.CreateTs)
+	err = row.Scan(&sku.Id, &sku.ProductId, &sku.Sku, &sku.Price, &sku.Quantity, &sku.MaxPerOrder, &sku.LargeImage, &sku.SmallImage, &sku.ThumbImage, &sku.CountViewed, &sku.CreateTs, &sku.UpdateTs, &sku.State, &sku.Version, &prod.ProductName, &prod.Description, &prod.CategoryId, &prod.CreateTs)
 	if err == pgx.ErrNoRows {
 		err = errorStatus.ErrNotFound
 		return nil, nil, err
@@ -221,6 +222,7 @@ func (d *PgxAccess) CreateProduct(ctx context.Context, prod *entity.Product) (pr
 			"category_id",
 			"brand_id",
 			"region_id",
+			"tax_class_id",
 			"create_ts",
 			"update_ts",
 			"state",
@@ -230,6 +232,8 @@ func (d *PgxAccess) CreateProduct(ctx context.Context, prod *entity.Product) (pr
 			prod.CategoryId,
 			prod.BrandId,
 			prod.RegionId,
+			// a product without a tax class has none, 0 isn't a class
+			squirrel.Expr("NULLIF(?, 0)", prod.TaxClassId),
 			prod.CreateTs,
 			prod.UpdateTs,
 			prod.State,
@@ -259,10 +263,11 @@ func (d *PgxAccess) UpdateProduct(ctx context.Context, prod *entity.Product) err
 			"description":  prod.Description,
 			"brand_id":     prod.BrandId,
 			"region_id":    prod.RegionId,
+			"tax_class_id": squirrel.Expr("NULLIF(?, 0)", prod.TaxClassId),
 			"update_ts":    prod.UpdateTs,
 			"state":        prod.State}).
 		Set("version", squirrel.Expr("version+1")).
-		Where("product.id = $8", prod.Id).
+		Where("product.id = $9", prod.Id).
 		ToSql()
 	if err != nil {
 		dbLog.WithError(err).Errorf("ProductRepository - UpdateProduct - r.Builder - query")
@@ -314,6 +319,7 @@ func (d *PgxAccess) CreateSku(ctx context.Context, sku *entity.Sku) (err error)
 			"sku",
 			"price",
 			"quantity",
+			"max_per_order",
 			"large_name",
 			"small_name",
 			"thumb_name",
@@ -326,6 +332,7 @@ func (d *PgxAccess) CreateSku(ctx context.Context, sku *entity.Sku) (err error)
 			sku.Sku,
 			sku.Price,
 			sku.Quantity,
+			sku.MaxPerOrder,
 			sku.LargeImage,
 			sku.SmallImage,
 			sku.ThumbImage,
@@ -352,15 +359,16 @@ func (d *PgxAccess) UpdateSku(ctx context.Context, sku *entity.Sku) error {
 	query, args, err := d.Builder.
 		Update("sku").
 		SetMap(map[string]interface{}{
-			"price":      sku.Price,
-			"quantity":   sku.Quantity,
-			"large_name": sku.LargeImage,
-			"small_name": sku.SmallImage,
-			"thumb_name": sku.ThumbImage,
-			"update_ts":  sku.UpdateTs,
-			"state":      sku.State}).
+			"price":         sku.Price,
+			"quantity":      sku.Quantity,
+			"max_per_order": sku.MaxPerOrder,
+			"large_name":    sku.LargeImage,
+			"small_name":    sku.SmallImage,
+			"thumb_name":    sku.ThumbImage,
+			"update_ts":     sku.UpdateTs,
+			"state":         sku.State}).
 		Set("version", squirrel.Expr("version+1")).
-		Where("sku.sku = $8", sku.Sku).
+		Where("sku.sku = ?", sku.Sku).
 		ToSql()
 
 	if err != nil {
@@ -16,16 +16,17 @@ func BenchmarkOrder(b *testing.B) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(b)
 	defer mockCtrl.Finish()
+	role := entity.UserRoleAdmin
 
 	storageMock := mocks.NewMockProductRepository(mockCtrl)
 
 	b.Run("get product success", func(b *testing.B) {
-		storageMock.EXPECT().GetSku(ctx, any, any, any).Return([]entity.Sku{{Id: 44}}, nil).Times(1)
+		storageMock.EXPECT().GetSku(ctx, any, any, any).Return([]*entity.Sku{}, []*entity.Product{}, nil).Times(1)
 
 		prodUsc := &ProductUsecase{
 			productRepo: storageMock,
 		}
 		filter := &dto.ProductListFilter{}
-		prodUsc.GetSku(ctx, 5, 0, filter)
+		prodUsc.GetSku(ctx, 5, 0, &role, filter)
 	})
 }
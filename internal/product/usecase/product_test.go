@@ -19,22 +19,21 @@ func TestProduct(t *testing.T) {
 	ctx := context.Background()
 	mockCtrl := gomock.NewController(t)
 	defer mockCtrl.Finish()
+	role := entity.UserRoleAdmin
 
 	productMock := mocks.NewMockProductRepository(mockCtrl)
 	optionMock := optionMocks.NewMockOptionRepository(mockCtrl)
 	cachedProd := mocks.NewMockProdRedisRepository(mockCtrl)
 	t.Run("get product sku(admin)  success", func(t *testing.T) {
-		productMock.EXPECT().GetSku(ctx, any, any, any).Return([]*entity.Sku{{Id: 44}}, nil).Times(1)
-		optionMock.EXPECT().GetSkuValue(ctx, 44).Return([]*entity.SkuValue{{Id: 5, SkuId: 44}}, nil).Times(1)
-		optionMock.EXPECT().GetOption(ctx, 0).Return(&entity.Option{Id: 6, CategoryId: 3}, nil)
-		optionMock.EXPECT().GetOptionValue(ctx, 0).Return(&entity.OptionValue{Id: 8, OptionId: 6}, nil)
+		productMock.EXPECT().GetSku(ctx, any, any, any).Return([]*entity.Sku{{Id: 44}}, []*entity.Product{{Id: 3}}, nil).Times(1)
+		optionMock.EXPECT().GetSkuValue(ctx, 44, any).Return([]*entity.SkuValue{{Id: 5, SkuId: 44}}, nil).Times(1)
 		prodUsc := &ProductUsecase{
 			productRepo:   productMock,
 			optionRepo:    optionMock,
 			prodRedisRepo: cachedProd,
 		}
 		filter := &dto.ProductListFilter{}
-		skuJs, err := prodUsc.GetSku(ctx, 5, 0, filter)
+		skuJs, err := prodUsc.GetSku(ctx, 5, 0, &role, filter)
 		req.NoError(err)
 		req.Equal(44, skuJs.SkuJson[0].SkuId)
 	})
@@ -61,7 +60,7 @@ func TestProduct(t *testing.T) {
 			prodRedisRepo: cachedProd,
 		}
 
-		skuJs, err := prodUsc.GetSingleProduct(ctx, "skuCode")
+		skuJs, err := prodUsc.GetSingleProduct(ctx, "skuCode", &role)
 		req.NoError(err)
 		req.Equal(44, skuJs.SkuId)
 	})
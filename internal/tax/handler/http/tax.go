@@ -0,0 +1,228 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/dto"
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	httphelper "go-store/utils/http"
+)
+
+// TaxHandler  represent the httphandler for tax classes and rates
+type TaxHandler struct {
+	taxUC  entity.TaxUsecase
+	srvLog *logrus.Entry
+}
+
+// NewTaxHandler will initialize the admin tax resources endpoint
+func NewTaxHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entity.Usecases, srvLog *logrus.Entry) {
+	th := &TaxHandler{
+		taxUC:  uc.TaxUsecase,
+		srvLog: srvLog,
+	}
+	a := handler.Group("/admin/tax", mdw, httphelper.RequirePermission(entity.PermTaxWrite))
+	{
+		a.GET("/classes", th.getClasses)
+		a.POST("/class", th.createClass)
+		a.PUT("/class/:classId", th.updateClass)
+		a.DELETE("/class/:classId", th.deleteClass)
+		a.GET("/rates", th.getRates)
+		a.POST("/rate", th.createRate)
+		a.PUT("/rate/:rateId", th.updateRate)
+		a.DELETE("/rate/:rateId", th.deleteRate)
+	}
+}
+
+func (th *TaxHandler) getClasses(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.getClasses"})
+
+	result, err := th.taxUC.GetClasses(c)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.GetClasses")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
+func (th *TaxHandler) createClass(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.createClass"})
+
+	var classReq dto.TaxClassRequest
+	if err := httphelper.BindJSON(c, &classReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	class := &entity.TaxClass{
+		Name:        classReq.Name,
+		Description: classReq.Description,
+	}
+	err := th.taxUC.CreateClass(c, class)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.CreateClass")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, class.Id, nil)
+}
+
+func (th *TaxHandler) updateClass(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.updateClass"})
+
+	classId, err := strconv.Atoi(c.Param("classId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.classId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	var classReq dto.TaxClassRequest
+	if err := httphelper.BindJSON(c, &classReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	class := &entity.TaxClass{
+		Id:          classId,
+		Name:        classReq.Name,
+		Description: classReq.Description,
+	}
+	err = th.taxUC.UpdateClass(c, class)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.UpdateClass")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, httphelper.Success, nil)
+}
+
+func (th *TaxHandler) deleteClass(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.deleteClass"})
+
+	classId, err := strconv.Atoi(c.Param("classId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.classId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	err = th.taxUC.DeleteClass(c, classId)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.DeleteClass")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, httphelper.Success, nil)
+}
+
+func (th *TaxHandler) getRates(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.getRates"})
+
+	var filter dto.TaxRateFilter
+	if err := httphelper.BindQuery(c, &filter); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindQuery")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := th.taxUC.GetRates(c, filter.RegionId)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.GetRates")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
+func (th *TaxHandler) createRate(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.createRate"})
+
+	var rateReq dto.TaxRateRequest
+	if err := httphelper.BindJSON(c, &rateReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	rate := mapRateRequest(&rateReq)
+	err := th.taxUC.CreateRate(c, rate)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.CreateRate")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, rate.Id, nil)
+}
+
+func (th *TaxHandler) updateRate(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.updateRate"})
+
+	rateId, err := strconv.Atoi(c.Param("rateId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.rateId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	var rateReq dto.TaxRateRequest
+	if err := httphelper.BindJSON(c, &rateReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	rate := mapRateRequest(&rateReq)
+	rate.Id = rateId
+	err = th.taxUC.UpdateRate(c, rate)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.UpdateRate")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, httphelper.Success, nil)
+}
+
+func (th *TaxHandler) deleteRate(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "TaxHandler.deleteRate"})
+
+	rateId, err := strconv.Atoi(c.Param("rateId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("strconv.Atoi.rateId")
+		httphelper.SendResponse(c, nil, errorStatus.ErrBadReq)
+		return
+	}
+
+	err = th.taxUC.DeleteRate(c, rateId)
+	if err != nil {
+		srvLog.WithError(err).Warning("th.taxUC.DeleteRate")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, httphelper.Success, nil)
+}
+
+func mapRateRequest(r *dto.TaxRateRequest) *entity.TaxRate {
+	return &entity.TaxRate{
+		TaxClassId: r.TaxClassId,
+		RegionId:   r.RegionId,
+		Name:       r.Name,
+		Rate:       r.Rate,
+		Inclusive:  r.Inclusive,
+	}
+}
@@ -0,0 +1,301 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/entity/tax.go
+
+// Package taxMock is a generated GoMock package.
+package taxMock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entity "go-store/internal/entity"
+	reflect "reflect"
+)
+
+// MockTaxUsecase is a mock of TaxUsecase interface
+type MockTaxUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxUsecaseMockRecorder
+}
+
+// MockTaxUsecaseMockRecorder is the mock recorder for MockTaxUsecase
+type MockTaxUsecaseMockRecorder struct {
+	mock *MockTaxUsecase
+}
+
+// NewMockTaxUsecase creates a new mock instance
+func NewMockTaxUsecase(ctrl *gomock.Controller) *MockTaxUsecase {
+	mock := &MockTaxUsecase{ctrl: ctrl}
+	mock.recorder = &MockTaxUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTaxUsecase) EXPECT() *MockTaxUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetClasses mocks base method
+func (m *MockTaxUsecase) GetClasses(ctx context.Context) ([]*entity.TaxClassJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClasses", ctx)
+	ret0, _ := ret[0].([]*entity.TaxClassJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClasses indicates an expected call of GetClasses
+func (mr *MockTaxUsecaseMockRecorder) GetClasses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClasses", reflect.TypeOf((*MockTaxUsecase)(nil).GetClasses), ctx)
+}
+
+// CreateClass mocks base method
+func (m *MockTaxUsecase) CreateClass(ctx context.Context, class *entity.TaxClass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClass", ctx, class)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClass indicates an expected call of CreateClass
+func (mr *MockTaxUsecaseMockRecorder) CreateClass(ctx, class interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClass", reflect.TypeOf((*MockTaxUsecase)(nil).CreateClass), ctx, class)
+}
+
+// UpdateClass mocks base method
+func (m *MockTaxUsecase) UpdateClass(ctx context.Context, class *entity.TaxClass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClass", ctx, class)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClass indicates an expected call of UpdateClass
+func (mr *MockTaxUsecaseMockRecorder) UpdateClass(ctx, class interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClass", reflect.TypeOf((*MockTaxUsecase)(nil).UpdateClass), ctx, class)
+}
+
+// DeleteClass mocks base method
+func (m *MockTaxUsecase) DeleteClass(ctx context.Context, classId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClass", ctx, classId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClass indicates an expected call of DeleteClass
+func (mr *MockTaxUsecaseMockRecorder) DeleteClass(ctx, classId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClass", reflect.TypeOf((*MockTaxUsecase)(nil).DeleteClass), ctx, classId)
+}
+
+// GetRates mocks base method
+func (m *MockTaxUsecase) GetRates(ctx context.Context, regionId int) ([]*entity.TaxRateJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx, regionId)
+	ret0, _ := ret[0].([]*entity.TaxRateJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates
+func (mr *MockTaxUsecaseMockRecorder) GetRates(ctx, regionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockTaxUsecase)(nil).GetRates), ctx, regionId)
+}
+
+// CreateRate mocks base method
+func (m *MockTaxUsecase) CreateRate(ctx context.Context, rate *entity.TaxRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRate indicates an expected call of CreateRate
+func (mr *MockTaxUsecaseMockRecorder) CreateRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRate", reflect.TypeOf((*MockTaxUsecase)(nil).CreateRate), ctx, rate)
+}
+
+// UpdateRate mocks base method
+func (m *MockTaxUsecase) UpdateRate(ctx context.Context, rate *entity.TaxRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRate indicates an expected call of UpdateRate
+func (mr *MockTaxUsecaseMockRecorder) UpdateRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRate", reflect.TypeOf((*MockTaxUsecase)(nil).UpdateRate), ctx, rate)
+}
+
+// DeleteRate mocks base method
+func (m *MockTaxUsecase) DeleteRate(ctx context.Context, rateId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, rateId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate
+func (mr *MockTaxUsecaseMockRecorder) DeleteRate(ctx, rateId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockTaxUsecase)(nil).DeleteRate), ctx, rateId)
+}
+
+// MockTaxRepository is a mock of TaxRepository interface
+type MockTaxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaxRepositoryMockRecorder
+}
+
+// MockTaxRepositoryMockRecorder is the mock recorder for MockTaxRepository
+type MockTaxRepositoryMockRecorder struct {
+	mock *MockTaxRepository
+}
+
+// NewMockTaxRepository creates a new mock instance
+func NewMockTaxRepository(ctrl *gomock.Controller) *MockTaxRepository {
+	mock := &MockTaxRepository{ctrl: ctrl}
+	mock.recorder = &MockTaxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockTaxRepository) EXPECT() *MockTaxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetClasses mocks base method
+func (m *MockTaxRepository) GetClasses(ctx context.Context) ([]*entity.TaxClass, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClasses", ctx)
+	ret0, _ := ret[0].([]*entity.TaxClass)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClasses indicates an expected call of GetClasses
+func (mr *MockTaxRepositoryMockRecorder) GetClasses(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClasses", reflect.TypeOf((*MockTaxRepository)(nil).GetClasses), ctx)
+}
+
+// CreateClass mocks base method
+func (m *MockTaxRepository) CreateClass(ctx context.Context, class *entity.TaxClass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClass", ctx, class)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateClass indicates an expected call of CreateClass
+func (mr *MockTaxRepositoryMockRecorder) CreateClass(ctx, class interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClass", reflect.TypeOf((*MockTaxRepository)(nil).CreateClass), ctx, class)
+}
+
+// UpdateClass mocks base method
+func (m *MockTaxRepository) UpdateClass(ctx context.Context, class *entity.TaxClass) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClass", ctx, class)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClass indicates an expected call of UpdateClass
+func (mr *MockTaxRepositoryMockRecorder) UpdateClass(ctx, class interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClass", reflect.TypeOf((*MockTaxRepository)(nil).UpdateClass), ctx, class)
+}
+
+// DeleteClass mocks base method
+func (m *MockTaxRepository) DeleteClass(ctx context.Context, classId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClass", ctx, classId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteClass indicates an expected call of DeleteClass
+func (mr *MockTaxRepositoryMockRecorder) DeleteClass(ctx, classId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClass", reflect.TypeOf((*MockTaxRepository)(nil).DeleteClass), ctx, classId)
+}
+
+// GetRates mocks base method
+func (m *MockTaxRepository) GetRates(ctx context.Context, regionId int) ([]*entity.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRates", ctx, regionId)
+	ret0, _ := ret[0].([]*entity.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRates indicates an expected call of GetRates
+func (mr *MockTaxRepositoryMockRecorder) GetRates(ctx, regionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRates", reflect.TypeOf((*MockTaxRepository)(nil).GetRates), ctx, regionId)
+}
+
+// GetRatesByRegion mocks base method
+func (m *MockTaxRepository) GetRatesByRegion(ctx context.Context, regionId int) ([]*entity.TaxRate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatesByRegion", ctx, regionId)
+	ret0, _ := ret[0].([]*entity.TaxRate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatesByRegion indicates an expected call of GetRatesByRegion
+func (mr *MockTaxRepositoryMockRecorder) GetRatesByRegion(ctx, regionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatesByRegion", reflect.TypeOf((*MockTaxRepository)(nil).GetRatesByRegion), ctx, regionId)
+}
+
+// CreateRate mocks base method
+func (m *MockTaxRepository) CreateRate(ctx context.Context, rate *entity.TaxRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRate indicates an expected call of CreateRate
+func (mr *MockTaxRepositoryMockRecorder) CreateRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRate", reflect.TypeOf((*MockTaxRepository)(nil).CreateRate), ctx, rate)
+}
+
+// UpdateRate mocks base method
+func (m *MockTaxRepository) UpdateRate(ctx context.Context, rate *entity.TaxRate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRate", ctx, rate)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRate indicates an expected call of UpdateRate
+func (mr *MockTaxRepositoryMockRecorder) UpdateRate(ctx, rate interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRate", reflect.TypeOf((*MockTaxRepository)(nil).UpdateRate), ctx, rate)
+}
+
+// DeleteRate mocks base method
+func (m *MockTaxRepository) DeleteRate(ctx context.Context, rateId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRate", ctx, rateId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRate indicates an expected call of DeleteRate
+func (mr *MockTaxRepositoryMockRecorder) DeleteRate(ctx, rateId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRate", reflect.TypeOf((*MockTaxRepository)(nil).DeleteRate), ctx, rateId)
+}
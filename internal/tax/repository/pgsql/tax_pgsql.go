@@ -0,0 +1,306 @@
+package pgsql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/utils/database"
+	errorStatus "go-store/utils/errors"
+)
+
+type PgxAccess struct {
+	*database.PgxAccess
+}
+
+// NewPgxTaxRepository will create an object that represent the entity.TaxRepository interface
+func NewPgxTaxRepository(pgx *database.PgxAccess) entity.TaxRepository {
+	return &PgxAccess{pgx}
+}
+
+func (d *PgxAccess) GetClasses(ctx context.Context) (result []*entity.TaxClass, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetClasses"})
+	query, args, err := d.Builder.
+		Select("id",
+			"name",
+			"description",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version").
+		From("tax_class").
+		Where("state = 'enabled'").
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - GetClasses - r.Builder - query")
+		return nil, err
+	}
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		err = fmt.Errorf("pg.GetClasses: %w", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.TaxClass{}
+		if err := rows.Scan(&tmp.Id, &tmp.Name, &tmp.Description, &tmp.CreateTs, &tmp.UpdateTs, &tmp.State, &tmp.Version); err != nil {
+			dbLog.WithFields(log.Fields{"taxClassId": tmp.Id}).Warning(err)
+			return nil, err
+		}
+		result = append(result, tmp)
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) CreateClass(ctx context.Context, class *entity.TaxClass) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateClass"})
+	query, args, err := d.Builder.
+		Insert("tax_class").
+		Columns("name",
+			"description",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version").
+		Values(class.Name,
+			class.Description,
+			class.CreateTs,
+			class.UpdateTs,
+			class.State,
+			class.Version).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - CreateClass - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&class.Id)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) UpdateClass(ctx context.Context, class *entity.TaxClass) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateClass"})
+	query, args, err := d.Builder.
+		Update("tax_class").
+		SetMap(map[string]interface{}{
+			"name":        class.Name,
+			"description": class.Description,
+			"update_ts":   class.UpdateTs}).
+		Set("version", squirrel.Expr("version+1")).
+		Where("id = ? AND state = 'enabled'", class.Id).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - UpdateClass - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&class.Id)
+	if err == pgx.ErrNoRows {
+		return errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) DeleteClass(ctx context.Context, classId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.DeleteClass"})
+	query, args, err := d.Builder.
+		Update("tax_class").
+		Set("state", entity.Deleted).
+		Set("update_ts", entity.NowUTC()).
+		Set("version", squirrel.Expr("version+1")).
+		Where("id = ? AND state = 'enabled'", classId).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - DeleteClass - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&classId)
+	if err == pgx.ErrNoRows {
+		return errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) GetRates(ctx context.Context, regionId int) (result []*entity.TaxRate, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetRates"})
+	baseQuery := d.Builder.
+		Select("id",
+			"tax_class_id",
+			"region_id",
+			"name",
+			"rate",
+			"inclusive",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version").
+		From("tax_rate").
+		Where("state = 'enabled'").
+		OrderBy("region_id", "tax_class_id")
+	if regionId > 0 {
+		baseQuery = baseQuery.Where("region_id = ?", regionId)
+	}
+	query, args, err := baseQuery.ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - GetRates - r.Builder - query")
+		return nil, err
+	}
+	return d.scanRates(ctx, query, args...)
+}
+
+// GetRatesByRegion returns rates of the region and of its main region,
+// rates of the region itself come first
+func (d *PgxAccess) GetRatesByRegion(ctx context.Context, regionId int) (result []*entity.TaxRate, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.GetRatesByRegion"})
+	query, args, err := d.Builder.
+		Select("tax_rate.id",
+			"tax_rate.tax_class_id",
+			"tax_rate.region_id",
+			"tax_rate.name",
+			"tax_rate.rate",
+			"tax_rate.inclusive",
+			"tax_rate.create_ts",
+			"tax_rate.update_ts",
+			"tax_rate.state",
+			"tax_rate.version").
+		From("tax_rate").
+		Where("tax_rate.state = 'enabled'").
+		Where("(tax_rate.region_id = ? OR tax_rate.region_id = (SELECT region.main_region FROM region WHERE region.id = ?))", regionId, regionId).
+		OrderByClause("tax_rate.region_id = ? DESC, tax_rate.tax_class_id", regionId).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - GetRatesByRegion - r.Builder - query")
+		return nil, err
+	}
+	return d.scanRates(ctx, query, args...)
+}
+
+func (d *PgxAccess) scanRates(ctx context.Context, query string, args ...interface{}) (result []*entity.TaxRate, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.scanRates"})
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		err = fmt.Errorf("pg.scanRates: %w", err)
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		tmp := &entity.TaxRate{}
+		if err := rows.Scan(&tmp.Id, &tmp.TaxClassId, &tmp.RegionId, &tmp.Name, &tmp.Rate, &tmp.Inclusive, &tmp.CreateTs, &tmp.UpdateTs, &tmp.State, &tmp.Version); err != nil {
+			dbLog.WithFields(log.Fields{"taxRateId": tmp.Id}).Warning(err)
+			return nil, err
+		}
+		result = append(result, tmp)
+	}
+	return result, nil
+}
+
+func (d *PgxAccess) CreateRate(ctx context.Context, rate *entity.TaxRate) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateRate"})
+	query, args, err := d.Builder.
+		Insert("tax_rate").
+		Columns("tax_class_id",
+			"region_id",
+			"name",
+			"rate",
+			"inclusive",
+			"create_ts",
+			"update_ts",
+			"state",
+			"version").
+		Values(rate.TaxClassId,
+			rate.RegionId,
+			rate.Name,
+			rate.Rate,
+			rate.Inclusive,
+			rate.CreateTs,
+			rate.UpdateTs,
+			rate.State,
+			rate.Version).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - CreateRate - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&rate.Id)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) UpdateRate(ctx context.Context, rate *entity.TaxRate) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateRate"})
+	query, args, err := d.Builder.
+		Update("tax_rate").
+		SetMap(map[string]interface{}{
+			"tax_class_id": rate.TaxClassId,
+			"region_id":    rate.RegionId,
+			"name":         rate.Name,
+			"rate":         rate.Rate,
+			"inclusive":    rate.Inclusive,
+			"update_ts":    rate.UpdateTs}).
+		Set("version", squirrel.Expr("version+1")).
+		Where("id = ? AND state = 'enabled'", rate.Id).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - UpdateRate - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&rate.Id)
+	if err == pgx.ErrNoRows {
+		return errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+func (d *PgxAccess) DeleteRate(ctx context.Context, rateId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.DeleteRate"})
+	query, args, err := d.Builder.
+		Update("tax_rate").
+		Set("state", entity.Deleted).
+		Set("update_ts", entity.NowUTC()).
+		Set("version", squirrel.Expr("version+1")).
+		Where("id = ? AND state = 'enabled'", rateId).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("TaxRepository - DeleteRate - r.Builder - query")
+		return err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&rateId)
+	if err == pgx.ErrNoRows {
+		return errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package usecase
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+// TaxUsecase will initiate usecase of entity.TaxRepository interface
+type TaxUsecase struct {
+	taxRepo entity.TaxRepository
+}
+
+// NewTaxUsecase will create new an TaxUsecase object representation of entity.TaxUsecase interface
+func NewTaxUsecase(t entity.TaxRepository) entity.TaxUsecase {
+	return &TaxUsecase{
+		taxRepo: t,
+	}
+}
+
+func (t *TaxUsecase) GetClasses(ctx context.Context) (result []*entity.TaxClassJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.GetClasses"})
+
+	classes, err := t.taxRepo.GetClasses(ctx)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.GetClasses")
+		return nil, errorStatus.ErrInternalServer
+	}
+
+	result = make([]*entity.TaxClassJson, len(classes))
+	for idx, class := range classes {
+		result[idx] = &entity.TaxClassJson{
+			Id:          class.Id,
+			Name:        class.Name,
+			Description: class.Description,
+		}
+	}
+	return result, nil
+}
+
+func (t *TaxUsecase) CreateClass(ctx context.Context, class *entity.TaxClass) error {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.CreateClass"})
+
+	if class.Name == "" {
+		return errorStatus.ErrBadReq
+	}
+	class.SetDefaults()
+	err := t.taxRepo.CreateClass(ctx, class)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.CreateClass")
+		return err
+	}
+	return nil
+}
+
+func (t *TaxUsecase) UpdateClass(ctx context.Context, class *entity.TaxClass) error {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.UpdateClass"})
+
+	if class.Name == "" {
+		return errorStatus.ErrBadReq
+	}
+	class.UpdateTs = entity.NowUTC()
+	err := t.taxRepo.UpdateClass(ctx, class)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.UpdateClass")
+		return err
+	}
+	return nil
+}
+
+func (t *TaxUsecase) DeleteClass(ctx context.Context, classId int) error {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.DeleteClass"})
+
+	err := t.taxRepo.DeleteClass(ctx, classId)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.DeleteClass")
+		return err
+	}
+	return nil
+}
+
+func (t *TaxUsecase) GetRates(ctx context.Context, regionId int) (result []*entity.TaxRateJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.GetRates"})
+
+	rates, err := t.taxRepo.GetRates(ctx, regionId)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.GetRates")
+		return nil, errorStatus.ErrInternalServer
+	}
+
+	result = make([]*entity.TaxRateJson, len(rates))
+	for idx, rate := range rates {
+		result[idx] = mapTaxRateToJSON(rate)
+	}
+	return result, nil
+}
+
+func mapTaxRateToJSON(r *entity.TaxRate) *entity.TaxRateJson {
+	return &entity.TaxRateJson{
+		Id:         r.Id,
+		TaxClassId: r.TaxClassId,
+		RegionId:   r.RegionId,
+		Name:       r.Name,
+		Rate:       r.Rate,
+		Inclusive:  r.Inclusive,
+	}
+}
+
+func (t *TaxUsecase) CreateRate(ctx context.Context, rate *entity.TaxRate) error {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.CreateRate"})
+
+	if !validRate(rate) {
+		return errorStatus.ErrBadReq
+	}
+	rate.SetDefaults()
+	err := t.taxRepo.CreateRate(ctx, rate)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.CreateRate")
+		return err
+	}
+	return nil
+}
+
+func (t *TaxUsecase) UpdateRate(ctx context.Context, rate *entity.TaxRate) error {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.UpdateRate"})
+
+	if !validRate(rate) {
+		return errorStatus.ErrBadReq
+	}
+	rate.UpdateTs = entity.NowUTC()
+	err := t.taxRepo.UpdateRate(ctx, rate)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.UpdateRate")
+		return err
+	}
+	return nil
+}
+
+func (t *TaxUsecase) DeleteRate(ctx context.Context, rateId int) error {
+	ctLog := log.WithFields(log.Fields{"func": "TaxUsecase.DeleteRate"})
+
+	err := t.taxRepo.DeleteRate(ctx, rateId)
+	if err != nil {
+		ctLog.WithError(err).Warning("t.taxRepo.DeleteRate")
+		return err
+	}
+	return nil
+}
+
+func validRate(rate *entity.TaxRate) bool {
+	return rate.TaxClassId > 0 && rate.RegionId > 0 && rate.Rate >= 0 && rate.Rate < 100
+}
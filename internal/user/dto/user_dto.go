@@ -1,6 +1,118 @@
 package dto
 
 type Credentials struct {
-	Username string `json:"username" example:"userX"`
-	Password string `json:"password" example:"qwerty1234"`
+	Username string `json:"username" example:"userX" validate:"required"`
+	Password string `json:"password" example:"qwerty1234" validate:"required"`
+	Device   string `json:"device" example:"Pixel 7" validate:"max=100"`
+}
+
+// UserForm -.
+type UserForm struct {
+	Username   string `form:"username" validate:"required,min=3,max=32"`
+	FullName   string `form:"fullName" validate:"max=100"`
+	Password   string `form:"password" validate:"required,min=8,max=72"`
+	Email      string `form:"email" validate:"required,email"`
+	Phone      string `form:"phone" validate:"required_if=SendMethod sms,omitempty,phone"`
+	Address    string `form:"address" validate:"max=255"`
+	RegionId   int    `form:"regionId" validate:"required,gt=0"`
+	SendMethod string `form:"sendMethod" validate:"omitempty,oneof=email sms"`
+}
+
+// PasswordResetRequest -.
+type PasswordResetRequest struct {
+	Email string `json:"email" example:"user@example.com" validate:"required,email"`
+}
+
+// PasswordResetConfirm -.
+type PasswordResetConfirm struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8,max=72"`
+}
+
+// ActivateRequest -.
+type ActivateRequest struct {
+	Username string `json:"username" example:"userX" validate:"required"`
+	Code     string `json:"code" example:"123456" validate:"required,numeric"`
+}
+
+// ResendCodeRequest -.
+type ResendCodeRequest struct {
+	Username   string `json:"username" example:"userX" validate:"required"`
+	SendMethod string `json:"sendMethod" example:"sms" validate:"omitempty,oneof=email sms"`
+}
+
+// MfaVerifyRequest -.
+type MfaVerifyRequest struct {
+	Challenge string `json:"challenge" validate:"required,uuid"`
+	Code      string `json:"code" example:"123456" validate:"required,max=32"`
+}
+
+// MfaEnrollRequest -.
+type MfaEnrollRequest struct {
+	Challenge string `json:"challenge" validate:"required,uuid"`
+}
+
+// TotpCodeRequest -.
+type TotpCodeRequest struct {
+	Code string `json:"code" example:"123456" validate:"required,max=32"`
+}
+
+// RoleRequest -.
+type RoleRequest struct {
+	Role string `json:"role" example:"CATALOG_MANAGER" validate:"required,role"`
+}
+
+// OidcCallback -. the provider sends the error instead of the code when the user cancels the login
+type OidcCallback struct {
+	Code  string `form:"code" validate:"required_without=Error,max=2048"`
+	State string `form:"state" validate:"required,max=128"`
+	Error string `form:"error" validate:"max=128"`
+}
+
+// ProfileRequest -. the missing fields are kept, an empty phone number or address clears it
+type ProfileRequest struct {
+	FullName    *string `json:"fullName" example:"John Doe" validate:"omitempty,max=100"`
+	PhoneNumber *string `json:"phoneNumber" example:"+99365777777" validate:"omitempty,phone"`
+	Address     *string `json:"address" validate:"omitempty,max=70"`
+	RegionId    *int    `json:"regionId" validate:"omitempty,gt=0"`
+}
+
+// PasswordChangeRequest -.
+type PasswordChangeRequest struct {
+	Password    string `json:"password" validate:"required,max=72"`
+	NewPassword string `json:"newPassword" validate:"required,min=8,max=72"`
+}
+
+// EmailChangeRequest -.
+type EmailChangeRequest struct {
+	Email    string `json:"email" example:"user@example.com" validate:"required,email,max=70"`
+	Password string `json:"password" validate:"required,max=72"`
+}
+
+// EmailConfirmRequest -.
+type EmailConfirmRequest struct {
+	Code string `json:"code" example:"123456" validate:"required,numeric"`
+}
+
+// AccountDeleteRequest -.
+type AccountDeleteRequest struct {
+	Password string `json:"password" validate:"required,max=72"`
+}
+
+// UserSearchRequest -. the dates are days of the registration, both of them are included
+type UserSearchRequest struct {
+	Email       string `form:"email" example:"example.com" validate:"max=70"`
+	PhoneNumber string `form:"phoneNumber" example:"99365" validate:"max=70"`
+	State       string `form:"state" example:"blocked" validate:"omitempty,oneof=enabled disabled blocked deleted"`
+	Role        string `form:"role" example:"USER" validate:"omitempty,role"`
+	CreatedFrom string `form:"createdFrom" example:"2024-01-01" validate:"omitempty,datetime=2006-01-02"`
+	CreatedTo   string `form:"createdTo" example:"2024-01-31" validate:"omitempty,datetime=2006-01-02"`
+	Limit       int    `form:"limit" example:"20" validate:"required,gt=0,max=100"`
+	Offset      int    `form:"offset" example:"0" validate:"gte=0"`
+}
+
+// UserStateRequest -. the reason is kept in the audit log of the user
+type UserStateRequest struct {
+	State  string `json:"state" example:"blocked" validate:"required,oneof=enabled blocked"`
+	Reason string `json:"reason" example:"chargeback fraud" validate:"max=255"`
 }
@@ -0,0 +1,121 @@
+package grpc_server
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	grpc "google.golang.org/grpc"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	grpchelper "go-store/utils/grpc"
+)
+
+const (
+	accessTokenKey = "x-access-token"
+	authorization  = "authorization"
+	bearerPrefix   = "Bearer "
+)
+
+// MethodPermissions lists the permission required to call a method, keyed by the full method name.
+// The methods missing from the list are denied, the ones open to guests require entity.PermGuest
+type MethodPermissions map[string]entity.Permission
+
+// ServerPermissions open the health checks and the reflection of the server to anyone
+var ServerPermissions = map[string]entity.Permission{
+	"/grpc.health.v1.Health/Check":                                   entity.PermGuest,
+	"/grpc.health.v1.Health/Watch":                                   entity.PermGuest,
+	"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": entity.PermGuest,
+}
+
+// tokenRequest is implemented by the requests carrying the access token in the message itself
+type tokenRequest interface {
+	GetToken() string
+}
+
+// UnaryAuthInterceptor is the gRPC counterpart of the http ValidateJWT middleware,
+// it resolves the user of the bearer token and enforces the permission of the method
+func UnaryAuthInterceptor(uc entity.UserUsecase, tokenConf *entity.TokenConf, policy MethodPermissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		tokenStr := accessToken(ctx)
+		if r, ok := req.(tokenRequest); ok && tokenStr == "" {
+			tokenStr = r.GetToken()
+		}
+		user, err := authorize(ctx, uc, tokenConf, tokenStr, policy, info.FullMethod)
+		if err != nil {
+			log.WithFields(log.Fields{"func": "grpc.UnaryAuthInterceptor", "method": info.FullMethod}).WithError(err).Warning("authorize")
+			return nil, err
+		}
+		return handler(grpchelper.ContextWithUser(ctx, user), req)
+	}
+}
+
+// StreamAuthInterceptor does the same for streams, the token is read from the metadata only
+func StreamAuthInterceptor(uc entity.UserUsecase, tokenConf *entity.TokenConf, policy MethodPermissions) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		user, err := authorize(ctx, uc, tokenConf, accessToken(ctx), policy, info.FullMethod)
+		if err != nil {
+			log.WithFields(log.Fields{"func": "grpc.StreamAuthInterceptor", "method": info.FullMethod}).WithError(err).Warning("authorize")
+			return err
+		}
+		return handler(srv, &authStream{ServerStream: ss, ctx: grpchelper.ContextWithUser(ctx, user)})
+	}
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+// accessToken reads the x-access-token metadata or the bearer of the authorization one
+func accessToken(ctx context.Context) string {
+	if tokenStr := grpchelper.MetadataValue(ctx, accessTokenKey); tokenStr != "" {
+		return tokenStr
+	}
+	if bearer := grpchelper.MetadataValue(ctx, authorization); strings.HasPrefix(bearer, bearerPrefix) {
+		return strings.TrimPrefix(bearer, bearerPrefix)
+	}
+	return ""
+}
+
+// authorize resolves the user of the token and checks it against the permission of the method,
+// a method without a permission is denied to everyone
+func authorize(ctx context.Context, uc entity.UserUsecase, tokenConf *entity.TokenConf, tokenStr string, policy MethodPermissions, method string) (*entity.Users, error) {
+	perm, ok := policy[method]
+	if !ok {
+		return nil, grpchelper.StatusError(errorStatus.ErrPermission)
+	}
+
+	user := &entity.Users{
+		Role: entity.UserRoleGuest,
+	}
+	if tokenStr != "" {
+		claims, err := uc.ParseToken(ctx, tokenStr, tokenConf.AccessKeys)
+		if err != nil {
+			return nil, grpchelper.StatusError(errorStatus.ErrAuth)
+		}
+		user, err = uc.ValidateToken(ctx, claims, claims.UID, false)
+		if err != nil {
+			return nil, grpchelper.StatusError(errorStatus.ErrAuth)
+		}
+		err = uc.TokenExpire(ctx, claims, tokenConf.AutoLogoffTimeout)
+		if err != nil {
+			return nil, grpchelper.StatusError(errorStatus.ErrAuth)
+		}
+	}
+
+	if user.Role.Can(perm) {
+		return user, nil
+	}
+	if user.Role == entity.UserRoleGuest {
+		return nil, grpchelper.StatusError(errorStatus.ErrAuth)
+	}
+	return nil, grpchelper.StatusError(errorStatus.ErrPermission)
+}
@@ -0,0 +1,152 @@
+package grpc_server
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	_cartGrpc "go-store/internal/cart/handler/grpc"
+	_catGrpc "go-store/internal/category/handler/grpc"
+	"go-store/internal/entity"
+	_orderGrpc "go-store/internal/order/handler/grpc"
+	_prodGrpc "go-store/internal/product/handler/grpc"
+	mocks "go-store/internal/user/mock"
+	grpchelper "go-store/utils/grpc"
+	"go-store/utils/jwt"
+)
+
+type tokenMessage struct {
+	token string
+}
+
+func (m *tokenMessage) GetToken() string {
+	return m.token
+}
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	userMock := mocks.NewMockUserUsecase(mockCtrl)
+	tokenConf := &entity.TokenConf{}
+	policy := MethodPermissions{
+		"/test.Service/Public":  entity.PermGuest,
+		"/test.Service/Own":     entity.PermSignedIn,
+		"/test.Service/Catalog": entity.PermProductWrite,
+	}
+	interceptor := UnaryAuthInterceptor(userMock, tokenConf, policy)
+
+	call := func(ctx context.Context, method string, msg interface{}) (*entity.Users, error) {
+		var user *entity.Users
+		_, err := interceptor(ctx, msg, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			user = grpchelper.UserFromContext(ctx)
+			return nil, nil
+		})
+		return user, err
+	}
+	signIn := func(token string, user *entity.Users) {
+		claims := &entity.JwtClaims{Id: user.Id, UID: "uid-" + token}
+		userMock.EXPECT().ParseToken(any, token, tokenConf.AccessKeys).Return(claims, nil).Times(1)
+		userMock.EXPECT().ValidateToken(any, claims, claims.UID, false).Return(user, nil).Times(1)
+		userMock.EXPECT().TokenExpire(any, claims, tokenConf.AutoLogoffTimeout).Return(nil).Times(1)
+	}
+
+	t.Run("method without a policy is denied", func(t *testing.T) {
+		_, err := call(context.Background(), "/test.Service/New", nil)
+		req.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("method without a policy is denied to the admin", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(accessTokenKey, "admin-token"))
+
+		_, err := call(ctx, "/test.Service/New", nil)
+		req.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("public method is open to the guest", func(t *testing.T) {
+		user, err := call(context.Background(), "/test.Service/Public", nil)
+		req.NoError(err)
+		req.Equal(entity.UserRoleGuest, user.Role)
+	})
+
+	t.Run("guest must sign in", func(t *testing.T) {
+		_, err := call(context.Background(), "/test.Service/Own", nil)
+		req.Equal(codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("bearer token of the metadata", func(t *testing.T) {
+		signIn("user-token", &entity.Users{Id: 7, Role: entity.UserRoleUser})
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorization, bearerPrefix+"user-token"))
+
+		user, err := call(ctx, "/test.Service/Own", nil)
+		req.NoError(err)
+		req.Equal(7, user.Id)
+	})
+
+	t.Run("token of the request message", func(t *testing.T) {
+		signIn("message-token", &entity.Users{Id: 8, Role: entity.UserRoleUser})
+
+		user, err := call(context.Background(), "/test.Service/Own", &tokenMessage{token: "message-token"})
+		req.NoError(err)
+		req.Equal(8, user.Id)
+	})
+
+	t.Run("role without the permission", func(t *testing.T) {
+		signIn("user-token", &entity.Users{Id: 7, Role: entity.UserRoleUser})
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(accessTokenKey, "user-token"))
+
+		_, err := call(ctx, "/test.Service/Catalog", nil)
+		req.Equal(codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("role with the permission", func(t *testing.T) {
+		signIn("manager-token", &entity.Users{Id: 9, Role: entity.UserRoleCatalogManager})
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(accessTokenKey, "manager-token"))
+
+		user, err := call(ctx, "/test.Service/Catalog", nil)
+		req.NoError(err)
+		req.Equal(9, user.Id)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		userMock.EXPECT().ParseToken(any, "forged", tokenConf.AccessKeys).Return(nil, jwt.ErrSignature).Times(1)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(accessTokenKey, "forged"))
+
+		_, err := call(ctx, "/test.Service/Public", nil)
+		req.Equal(codes.Unauthenticated, status.Code(err))
+	})
+}
+
+// TestServicePolicies fails for the methods added to a service without an entry of its policy,
+// the interceptor denies them to everyone
+func TestServicePolicies(t *testing.T) {
+	services := []struct {
+		desc   grpc.ServiceDesc
+		policy map[string]entity.Permission
+	}{
+		{GrpcHandler_ServiceDesc, AuthPermissions},
+		{_cartGrpc.CartHandler_ServiceDesc, _cartGrpc.AuthPermissions},
+		{_cartGrpc.Checkout_ServiceDesc, _cartGrpc.AuthPermissions},
+		{_catGrpc.CategoryHandler_ServiceDesc, _catGrpc.AuthPermissions},
+		{_orderGrpc.OrderHandler_ServiceDesc, _orderGrpc.AuthPermissions},
+		{_prodGrpc.ProductHandler_ServiceDesc, _prodGrpc.AuthPermissions},
+	}
+	for _, service := range services {
+		for _, method := range service.desc.Methods {
+			fullMethod := "/" + service.desc.ServiceName + "/" + method.MethodName
+			require.Contains(t, service.policy, fullMethod)
+		}
+		for _, stream := range service.desc.Streams {
+			fullMethod := "/" + service.desc.ServiceName + "/" + stream.StreamName
+			require.Contains(t, service.policy, fullMethod)
+		}
+	}
+}
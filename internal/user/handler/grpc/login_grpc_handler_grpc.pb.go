@@ -23,6 +23,7 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GrpcHandlerClient interface {
 	LoginHandler(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	VerifyMfaHandler(ctx context.Context, in *MfaRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 }
 
 type grpcHandlerClient struct {
@@ -42,11 +43,21 @@ func (c *grpcHandlerClient) LoginHandler(ctx context.Context, in *LoginRequest,
 	return out, nil
 }
 
+func (c *grpcHandlerClient) VerifyMfaHandler(ctx context.Context, in *MfaRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/grpc_handler.GrpcHandler/VerifyMfaHandler", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GrpcHandlerServer is the server API for GrpcHandler service.
 // All implementations must embed UnimplementedGrpcHandlerServer
 // for forward compatibility
 type GrpcHandlerServer interface {
 	LoginHandler(context.Context, *LoginRequest) (*LoginResponse, error)
+	VerifyMfaHandler(context.Context, *MfaRequest) (*LoginResponse, error)
 	mustEmbedUnimplementedGrpcHandlerServer()
 }
 
@@ -57,6 +68,9 @@ type UnimplementedGrpcHandlerServer struct {
 func (UnimplementedGrpcHandlerServer) LoginHandler(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoginHandler not implemented")
 }
+func (UnimplementedGrpcHandlerServer) VerifyMfaHandler(context.Context, *MfaRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyMfaHandler not implemented")
+}
 func (UnimplementedGrpcHandlerServer) mustEmbedUnimplementedGrpcHandlerServer() {}
 
 // UnsafeGrpcHandlerServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +102,24 @@ func _GrpcHandler_LoginHandler_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GrpcHandler_VerifyMfaHandler_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MfaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GrpcHandlerServer).VerifyMfaHandler(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/grpc_handler.GrpcHandler/VerifyMfaHandler",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GrpcHandlerServer).VerifyMfaHandler(ctx, req.(*MfaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GrpcHandler_ServiceDesc is the grpc.ServiceDesc for GrpcHandler service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,6 +131,10 @@ var GrpcHandler_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginHandler",
 			Handler:    _GrpcHandler_LoginHandler_Handler,
 		},
+		{
+			MethodName: "VerifyMfaHandler",
+			Handler:    _GrpcHandler_VerifyMfaHandler_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/user/handler/grpc/login_grpc_handler.proto",
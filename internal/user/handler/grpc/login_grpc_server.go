@@ -9,17 +9,38 @@ import (
 	"github.com/bnkamalesh/webgo/v6"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	grpc "google.golang.org/grpc"
-	codes "google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
-	status "google.golang.org/grpc/status"
+
+	grpchelper "go-store/utils/grpc"
 )
 
+// AuthPermissions open the sign in methods to anyone
+var AuthPermissions = map[string]entity.Permission{
+	"/grpc_handler.GrpcHandler/LoginHandler":     entity.PermGuest,
+	"/grpc_handler.GrpcHandler/VerifyMfaHandler": entity.PermGuest,
+}
+
 type grpcServer struct {
-	usecases *entity.Usecases
+	usecases  *entity.Usecases
+	tokenConf *entity.TokenConf
 	UnimplementedGrpcHandlerServer
 }
 
+// RegisterServices registers the auth service on the shared gRPC server
+func RegisterServices(gsrv *grpc.Server, us *entity.Usecases, tokenConf *entity.TokenConf) {
+	RegisterGrpcHandlerServer(gsrv, &grpcServer{
+		usecases:  us,
+		tokenConf: tokenConf,
+	})
+}
+
+// RegisterGateway routes the REST calls of the auth service through the gateway mux to the gRPC server
+func RegisterGateway(ctx context.Context, mux *runtime.ServeMux, conn *grpc.ClientConn) error {
+	return RegisterGrpcHandlerHandler(ctx, mux, conn)
+}
+
 func NewGRPCServer(cfg *entity.Config, us *entity.Usecases) (*grpc.Server, net.Listener, error) {
 	host := fmt.Sprintf("%s:%s", cfg.Host, cfg.Port)
 	lis, err := net.Listen("tcp", host)
@@ -42,16 +63,50 @@ func (g *grpcServer) LoginHandler(ctx context.Context, loginGrpc *LoginRequest)
 	glog := log.WithContext(ctx).WithFields(log.Fields{
 		"grpc": "LoginHandler",
 	})
-	tokenConf := &entity.TokenConf{}
-	loginUsecase, err := g.usecases.UserUsecase.Login(ctx, loginGrpc.Username, loginGrpc.Password, tokenConf)
+	client := &entity.SessionClient{
+		Device:    loginGrpc.Device,
+		IP:        grpchelper.ClientIP(ctx),
+		UserAgent: grpchelper.UserAgent(ctx),
+	}
+	loginUsecase, err := g.usecases.UserUsecase.Login(ctx, loginGrpc.Username, loginGrpc.Password, client, g.tokenConf)
 	if err != nil {
 		glog.WithError(err).Error("LoginHandler - error while processing g.usecases.UserUsecase.LoginHandler")
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, grpchelper.StatusError(err)
 	}
 
-	res := LoginResponse{
-		Username: loginUsecase.Username,
-		Role:     string(loginUsecase.Role),
+	return loginResponse(loginUsecase), nil
+}
+
+// VerifyMfaHandler passes the challenge of the login with the totp or a recovery code
+func (g *grpcServer) VerifyMfaHandler(ctx context.Context, mfaGrpc *MfaRequest) (*LoginResponse, error) {
+	glog := log.WithContext(ctx).WithFields(log.Fields{
+		"grpc": "VerifyMfaHandler",
+	})
+	userJs, err := g.usecases.UserUsecase.VerifyMfa(ctx, mfaGrpc.Challenge, mfaGrpc.Code, g.tokenConf)
+	if err != nil {
+		glog.WithError(err).Error("VerifyMfaHandler - error while processing g.usecases.UserUsecase.VerifyMfa")
+		return nil, grpchelper.StatusError(err)
+	}
+	return loginResponse(userJs), nil
+}
+
+func loginResponse(userJs *entity.UserJson) *LoginResponse {
+	res := &LoginResponse{
+		Username:      userJs.Username,
+		Role:          string(userJs.Role),
+		RecoveryCodes: userJs.RecoveryCodes,
+	}
+	if userJs.Challenge != nil {
+		res.Challenge = &Challenge{
+			Id:       userJs.Challenge.Id,
+			Enroll:   userJs.Challenge.Enroll,
+			ExpireTs: userJs.Challenge.ExpireTs.Unix(),
+		}
+		return res
 	}
-	return &res, nil
+	res.Tokens = []*Token{{
+		Access:  userJs.Tokens.AccessToken,
+		Refresh: userJs.Tokens.RefreshToken,
+	}}
+	return res
 }
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/internal/user/dto"
+	errorstatus "go-store/utils/errors"
+	httphelper "go-store/utils/http"
+)
+
+const searchDateLayout = "2006-01-02"
+
+// searchUsers shows the page of the users of the query filters, the newest first
+func (ah *UserHandler) searchUsers(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.searchUsers"})
+	staff, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var searchReq dto.UserSearchRequest
+	if err = httphelper.BindQuery(c, &searchReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindQuery")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	filter := &entity.UserFilter{
+		Email:       searchReq.Email,
+		PhoneNumber: searchReq.PhoneNumber,
+		State:       entity.State(searchReq.State),
+		Role:        entity.UserRole(searchReq.Role),
+		Limit:       searchReq.Limit,
+		Offset:      searchReq.Offset,
+	}
+	// the layout is checked by the validate tags
+	if searchReq.CreatedFrom != "" {
+		filter.CreatedFrom, _ = time.Parse(searchDateLayout, searchReq.CreatedFrom)
+	}
+	if searchReq.CreatedTo != "" {
+		createdTo, _ := time.Parse(searchDateLayout, searchReq.CreatedTo)
+		filter.CreatedTo = createdTo.AddDate(0, 0, 1)
+	}
+
+	result, err := ah.UserUsecase.SearchUsers(c, staff, filter)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.SearchUsers")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// userDetail shows the user with the count of its orders and its lifetime spend
+func (ah *UserHandler) userDetail(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.userDetail"})
+	staff, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	userId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("userId", "must be an integer"))
+		return
+	}
+	result, err := ah.UserUsecase.UserDetail(c, staff, userId)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.UserDetail")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// setUserState blocks the user or enables it again, the blocked user is signed out of every device
+func (ah *UserHandler) setUserState(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.setUserState"})
+	admin, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	userId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("userId", "must be an integer"))
+		return
+	}
+	var stateReq dto.UserStateRequest
+	if err = httphelper.BindJSON(c, &stateReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err = ah.UserUsecase.SetUserState(c, admin, userId, entity.State(stateReq.State), stateReq.Reason)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.SetUserState")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// userAuditLog shows the page of the actions of the staff on the user, the newest first
+func (ah *UserHandler) userAuditLog(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.userAuditLog"})
+	staff, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	userId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("userId", "must be an integer"))
+		return
+	}
+	pageParam, err := httphelper.PaginationParams(c)
+	if err != nil {
+		srvLog.WithError(err).Warning("httphelper.PaginationParams")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	result, err := ah.UserUsecase.UserAuditLog(c, staff, userId, pageParam.Limit, pageParam.Offset)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.UserAuditLog")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
@@ -0,0 +1,118 @@
+package http
+
+import (
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/user/dto"
+	httphelper "go-store/utils/http"
+)
+
+// verifyMfa passes the login challenge with the totp or a recovery code and returns the tokens
+func (ah *UserHandler) verifyMfa(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.verifyMfa"})
+
+	var verifyReq dto.MfaVerifyRequest
+	if err := httphelper.BindJSON(c, &verifyReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := ah.UserUsecase.VerifyMfa(c, verifyReq.Challenge, verifyReq.Code, ah.tokenConf)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.VerifyMfa")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	ah.mergeGuestCart(c, result.Id)
+	httphelper.SendResponse(c, result, nil)
+}
+
+// enrollMfaChallenge returns the totp secret of the login challenge of an enforced role
+func (ah *UserHandler) enrollMfaChallenge(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.enrollMfaChallenge"})
+
+	var enrollReq dto.MfaEnrollRequest
+	if err := httphelper.BindJSON(c, &enrollReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := ah.UserUsecase.EnrollMfaChallenge(c, enrollReq.Challenge)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.EnrollMfaChallenge")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// enrollTotp starts the opt in of the signed in user
+func (ah *UserHandler) enrollTotp(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.enrollTotp"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := ah.UserUsecase.EnrollTotp(c, user)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.EnrollTotp")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// confirmTotp enables the enrolled secret and returns the recovery codes
+func (ah *UserHandler) confirmTotp(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.confirmTotp"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var codeReq dto.TotpCodeRequest
+	if err = httphelper.BindJSON(c, &codeReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	recoveryCodes, err := ah.UserUsecase.ConfirmTotp(c, user, codeReq.Code)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.ConfirmTotp")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, gin.H{"recoveryCodes": recoveryCodes}, nil)
+}
+
+// disableTotp turns the second factor off with a current code or a recovery code
+func (ah *UserHandler) disableTotp(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.disableTotp"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var codeReq dto.TotpCodeRequest
+	if err = httphelper.BindJSON(c, &codeReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err = ah.UserUsecase.DisableTotp(c, user, codeReq.Code)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.DisableTotp")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, "success", nil)
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/internal/user/dto"
+	errorstatus "go-store/utils/errors"
+	httphelper "go-store/utils/http"
+)
+
+// oidcLogin redirects the browser to the authorization page of the provider
+func (ah *UserHandler) oidcLogin(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.oidcLogin", "provider": c.Param("provider")})
+
+	authURL, err := ah.UserUsecase.OidcAuthURL(c, c.Param("provider"))
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.OidcAuthURL")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// oidcCallback completes the login the provider redirected back and returns the tokens,
+// or the challenge of the second factor
+func (ah *UserHandler) oidcCallback(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.oidcCallback", "provider": c.Param("provider")})
+
+	var callback dto.OidcCallback
+	if err := httphelper.BindQuery(c, &callback); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindQuery")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	if callback.Error != "" {
+		srvLog.WithFields(log.Fields{"error": callback.Error}).Warning("the provider refused the login")
+		httphelper.SendResponse(c, nil, errorstatus.ErrAuth)
+		return
+	}
+
+	client := &entity.SessionClient{
+		Device:    c.Param("provider"),
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+	result, err := ah.UserUsecase.OidcLogin(c, c.Param("provider"), callback.State, callback.Code, client, ah.tokenConf)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.OidcLogin")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	if result.Challenge == nil {
+		ah.mergeGuestCart(c, result.Id)
+	}
+	httphelper.SendResponse(c, result, nil)
+}
@@ -0,0 +1,180 @@
+package http
+
+import (
+	"os"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/internal/user/dto"
+	httphelper "go-store/utils/http"
+)
+
+// getProfile shows the profile of the signed in user
+func (ah *UserHandler) getProfile(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.getProfile"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := ah.UserUsecase.GetProfile(c, user)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.GetProfile")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// updateProfile changes the fields of the request, the others are kept
+func (ah *UserHandler) updateProfile(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.updateProfile"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var profileReq dto.ProfileRequest
+	if err = httphelper.BindJSON(c, &profileReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	result, err := ah.UserUsecase.UpdateProfile(c, user, &entity.ProfileUpdate{
+		FullName:    profileReq.FullName,
+		PhoneNumber: profileReq.PhoneNumber,
+		Address:     profileReq.Address,
+		RegionId:    profileReq.RegionId,
+	})
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.UpdateProfile")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// updatePhoto stores the photo of the multipart form, the previous photos are removed once it is saved
+func (ah *UserHandler) updatePhoto(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.updatePhoto"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	photo, err := httphelper.UserPhotoForm(c, user.PublicId.String())
+	if err != nil {
+		srvLog.WithError(err).Warning("httphelper.UserPhotoForm")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	result, err := ah.UserUsecase.UpdatePhoto(c, user, photo)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.UpdatePhoto")
+		if err := os.Remove(photo); err != nil {
+			srvLog.WithError(err).Warning("os.Remove")
+		}
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.RemoveUserPhotos(user.PublicId.String(), photo)
+	httphelper.SendResponse(c, result, nil)
+}
+
+// changePassword replaces the password, the other devices are signed out
+func (ah *UserHandler) changePassword(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.changePassword"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var passwordReq dto.PasswordChangeRequest
+	if err = httphelper.BindJSON(c, &passwordReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	if err = ah.UserUsecase.ChangePassword(c, user, passwordReq.Password, passwordReq.NewPassword); err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.ChangePassword")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// requestEmailChange sends the confirmation code to the new email
+func (ah *UserHandler) requestEmailChange(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.requestEmailChange"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var emailReq dto.EmailChangeRequest
+	if err = httphelper.BindJSON(c, &emailReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	if err = ah.UserUsecase.RequestEmailChange(c, user, emailReq.Email, emailReq.Password); err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.RequestEmailChange")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// confirmEmailChange sets the new email of the code
+func (ah *UserHandler) confirmEmailChange(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.confirmEmailChange"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var confirmReq dto.EmailConfirmRequest
+	if err = httphelper.BindJSON(c, &confirmReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	if err = ah.UserUsecase.ConfirmEmailChange(c, user, confirmReq.Code); err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.ConfirmEmailChange")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// deleteAccount anonymizes the signed in user and removes its photos
+func (ah *UserHandler) deleteAccount(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.deleteAccount"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	var deleteReq dto.AccountDeleteRequest
+	if err = httphelper.BindJSON(c, &deleteReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	if err = ah.UserUsecase.DeleteAccount(c, user, deleteReq.Password); err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.DeleteAccount")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.RemoveUserPhotos(user.PublicId.String(), "")
+	httphelper.SendResponse(c, "success", nil)
+}
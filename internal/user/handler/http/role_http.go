@@ -0,0 +1,55 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	"go-store/internal/user/dto"
+	errorstatus "go-store/utils/errors"
+	httphelper "go-store/utils/http"
+)
+
+// listRoles shows the roles that can be assigned with their permissions
+func (ah *UserHandler) listRoles(c *gin.Context) {
+	result := make([]*entity.RoleJson, len(entity.Roles))
+	for idx, role := range entity.Roles {
+		result[idx] = &entity.RoleJson{
+			Role:        role,
+			Permissions: entity.RolePermissions[role],
+		}
+	}
+	httphelper.SendResponse(c, result, nil)
+}
+
+// assignRole changes the role of a user, the user is signed out of every device
+func (ah *UserHandler) assignRole(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.assignRole"})
+	admin, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	userId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("userId", "must be an integer"))
+		return
+	}
+	var roleReq dto.RoleRequest
+	if err = httphelper.BindJSON(c, &roleReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err = ah.UserUsecase.AssignRole(c, admin, userId, entity.UserRole(roleReq.Role))
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.AssignRole")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	httphelper.SendResponse(c, "success", nil)
+}
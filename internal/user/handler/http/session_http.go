@@ -0,0 +1,130 @@
+package http
+
+import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorstatus "go-store/utils/errors"
+	httphelper "go-store/utils/http"
+)
+
+// signedUser returns the user of the access token, the guests have no sessions
+func signedUser(c *gin.Context) (*entity.Users, error) {
+	userCtx, exists := c.Get("user")
+	// This shouldn't happen, as our middleware ought to throw an error.
+	if !exists {
+		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+		return nil, errorstatus.ErrInternalServer
+	}
+	user := userCtx.(*entity.Users)
+	if user.Id == 0 {
+		return nil, errorstatus.ErrAuth
+	}
+	return user, nil
+}
+
+// listSessions shows the signed in devices of the user
+func (ah *UserHandler) listSessions(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.listSessions"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	result, err := ah.UserUsecase.ListSessions(c, user)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.ListSessions")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, result, nil)
+}
+
+// revokeSession signs one device of the user out, it can be the current one
+func (ah *UserHandler) revokeSession(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.revokeSession"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err = ah.UserUsecase.RevokeSession(c, user.Id, c.Param("sessionId"))
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.RevokeSession")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// revokeSessions signs the user out of every device
+func (ah *UserHandler) revokeSessions(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.revokeSessions"})
+	user, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err = ah.UserUsecase.RevokeSessions(c, user.Id)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.RevokeSessions")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// forceLogout lets the staff sign a user out of every device
+func (ah *UserHandler) forceLogout(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.forceLogout"})
+
+	userId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("userId", "must be an integer"))
+		return
+	}
+
+	err = ah.UserUsecase.RevokeSessions(c, userId)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.RevokeSessions")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+	srvLog.WithFields(log.Fields{"userId": userId}).Info("user signed out by staff")
+
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// unlockUser lets the staff lift the lockout of a user after the failed logins
+func (ah *UserHandler) unlockUser(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.unlockUser"})
+	staff, err := signedUser(c)
+	if err != nil {
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	userId, err := strconv.Atoi(c.Param("userId"))
+	if err != nil {
+		httphelper.SendResponse(c, nil, errorstatus.Violation("userId", "must be an integer"))
+		return
+	}
+
+	err = ah.UserUsecase.UnlockUser(c, staff, userId)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.UnlockUser")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, "success", nil)
+}
@@ -1,9 +1,6 @@
 package http
 
 import (
-	"encoding/json"
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
@@ -17,6 +14,7 @@ import (
 // UserHandler  represent the httphandler for authentification
 type UserHandler struct {
 	UserUsecase entity.UserUsecase
+	CartUsecase entity.CartUsecase
 	srvLog      *logrus.Entry
 	tokenConf   *entity.TokenConf
 }
@@ -25,16 +23,51 @@ type UserHandler struct {
 func NewUserHandler(handler *gin.RouterGroup, mdw gin.HandlerFunc, uc *entity.Usecases, srvLog *logrus.Entry, tokenConf *entity.TokenConf) {
 	ah := &UserHandler{
 		UserUsecase: uc.UserUsecase,
+		CartUsecase: uc.CartUsecase,
 		srvLog:      srvLog,
 		tokenConf:   tokenConf,
 	}
 	h := handler.Group("/user")
 	{
 		h.POST("/login", ah.loginHandler)
+		h.POST("/login/mfa", ah.verifyMfa)
+		h.POST("/login/mfa/enroll", ah.enrollMfaChallenge)
+		h.GET("/oidc/:provider/login", ah.oidcLogin)
+		h.GET("/oidc/:provider/callback", ah.oidcCallback)
 		h.POST("/refresh", ah.refresh)
 		h.POST("/logout", ah.logoutHandler)
 		h.POST("/register", ah.registerHandler)
 		h.POST("/activate", ah.activateHandler)
+		h.POST("/activate/resend", ah.resendCodeHandler)
+		h.POST("/password/reset", ah.requestPasswordReset)
+		h.POST("/password/reset/confirm", ah.confirmPasswordReset)
+		h.GET("/sessions", mdw, ah.listSessions)
+		h.DELETE("/sessions", mdw, ah.revokeSessions)
+		h.DELETE("/sessions/:sessionId", mdw, ah.revokeSession)
+		h.POST("/mfa/totp", mdw, ah.enrollTotp)
+		h.POST("/mfa/totp/confirm", mdw, ah.confirmTotp)
+		h.POST("/mfa/totp/disable", mdw, ah.disableTotp)
+	}
+	me := handler.Group("/users/me", mdw, httphelper.RequirePermission(entity.PermSignedIn))
+	{
+		me.GET("", ah.getProfile)
+		me.PATCH("", ah.updateProfile)
+		me.DELETE("", ah.deleteAccount)
+		me.PUT("/photo", ah.updatePhoto)
+		me.PUT("/password", ah.changePassword)
+		me.POST("/email", ah.requestEmailChange)
+		me.POST("/email/confirm", ah.confirmEmailChange)
+	}
+	a := handler.Group("/admin")
+	{
+		a.GET("/roles", mdw, httphelper.RequirePermission(entity.PermUserRoles), ah.listRoles)
+		a.PUT("/user/:userId/role", mdw, httphelper.RequirePermission(entity.PermUserRoles), ah.assignRole)
+		a.DELETE("/user/:userId/sessions", mdw, httphelper.RequirePermission(entity.PermUserSessions), ah.forceLogout)
+		a.POST("/user/:userId/unlock", mdw, httphelper.RequirePermission(entity.PermUserUnlock), ah.unlockUser)
+		a.GET("/users", mdw, httphelper.RequirePermission(entity.PermUserRead), ah.searchUsers)
+		a.GET("/user/:userId", mdw, httphelper.RequirePermission(entity.PermUserRead), ah.userDetail)
+		a.PUT("/user/:userId/state", mdw, httphelper.RequirePermission(entity.PermUserState), ah.setUserState)
+		a.GET("/user/:userId/audit", mdw, httphelper.RequirePermission(entity.PermUserRead), ah.userAuditLog)
 	}
 }
 
@@ -43,43 +76,58 @@ func (ah *UserHandler) loginHandler(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "server.LoginHandler"})
 	// Get the JSON body and decode into credentials
 	var creds dto.Credentials
-	if err := json.NewDecoder(c.Request.Body).Decode(&creds); err != nil {
-		srvLog.WithError(err).Error("format is wrong")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+	if err := httphelper.BindJSON(c, &creds); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
 	ah.srvLog = ah.srvLog.WithFields(log.Fields{"username": creds.Username})
 	// Create jwt key if credentials are correct
-	result, err := ah.UserUsecase.Login(c, creds.Username, creds.Password, ah.tokenConf)
+	client := &entity.SessionClient{
+		Device:    creds.Device,
+		IP:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+	result, err := ah.UserUsecase.Login(c, creds.Username, creds.Password, client, ah.tokenConf)
 	if err != nil {
 		// If the structure of the body is wrong, return an HTTP error
 		ah.srvLog.WithError(err).Warning("User credentials invalid!")
 		httphelper.SendResponse(c, nil, err)
 		return
 	}
+	// the cart is merged once the tokens are issued, after the second factor if it is asked
+	if result.Challenge == nil {
+		ah.mergeGuestCart(c, result.Id)
+	}
 	httphelper.SendResponse(c, result, nil)
 }
 
+// mergeGuestCart moves the anonymous cart of the x-cart-token header into the user cart,
+// a failed merge doesn't fail the login or registration
+func (ah *UserHandler) mergeGuestCart(c *gin.Context, userId int) {
+	srvLog := log.WithFields(log.Fields{"func": "server.mergeGuestCart"})
+	cartToken := c.GetHeader("x-cart-token")
+	if cartToken == "" || userId == 0 {
+		return
+	}
+	if err := ah.CartUsecase.MergeGuestCart(c, cartToken, userId); err != nil {
+		srvLog.WithError(err).Warning("ah.CartUsecase.MergeGuestCart")
+	}
+}
+
 // Handler to Logout(Sign out)
 func (ah *UserHandler) refresh(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "server.refresh"})
 	// Get the JSON body and decode into credentials
 	tokenStr := c.Request.Header.Get("x-access-token")
-	claims, err := ah.UserUsecase.ParseToken(c, tokenStr, ah.tokenConf.RefreshSecret)
+	claims, err := ah.UserUsecase.ParseToken(c, tokenStr, ah.tokenConf.RefreshKeys)
 	if err != nil {
 		srvLog.WithError(err).Warning("ah.UserUsecase.ParseToken")
 		httphelper.SendResponse(c, nil, errorstatus.ErrAuth)
 		return
 	}
-	// Validate token
-	_, err = ah.UserUsecase.ValidateToken(c, claims, claims.UID, true)
-	if err != nil {
-		srvLog.WithError(err).Warning("refresh token not valid")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
-		return
-	}
-
+	// Refresh validates the token itself, a replayed one must reach it to revoke its session
 	user, err := ah.UserUsecase.Refresh(c, claims, ah.tokenConf)
 	if err != nil {
 		ah.srvLog.Warning(err)
@@ -95,14 +143,14 @@ func (ah *UserHandler) logoutHandler(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "server.logoutHandler"})
 	// Get the JSON body and decode into credentials
 	tokenStr := c.Request.Header.Get("x-access-token")
-	claims, err := ah.UserUsecase.ParseToken(c, tokenStr, ah.tokenConf.AccessSecret)
+	claims, err := ah.UserUsecase.ParseToken(c, tokenStr, ah.tokenConf.AccessKeys)
 	if err != nil {
 		srvLog.WithError(err).Warning("ah.UserUsecase.ParseToken")
 		httphelper.SendResponse(c, nil, errorstatus.ErrAuth)
 		return
 	}
-	// Delete tokens from cache
-	err = ah.UserUsecase.Logout(c, claims.Id)
+	// Delete the session of the token from cache
+	err = ah.UserUsecase.Logout(c, claims.Id, claims.Session)
 	if err != nil {
 		// If the cache is empty return an HTTP error
 		srvLog.WithError(err).Warning("User logout error")
@@ -119,23 +167,23 @@ func ValidateJWT(uc entity.UserUsecase, tokenConf *entity.TokenConf) gin.Handler
 	return func(c *gin.Context) {
 		tokenStr := c.Request.Header.Get("x-access-token")
 		if tokenStr != "" {
-			claims, err := uc.ParseToken(c, tokenStr, tokenConf.AccessSecret)
+			claims, err := uc.ParseToken(c, tokenStr, tokenConf.AccessKeys)
 			if err != nil {
 				srvLog.WithError(err).Warning("uc.ParseToken")
-				c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+				httphelper.AbortWithError(c, errorstatus.ErrAuth)
 				return
 			}
 			user, err := uc.ValidateToken(c, claims, claims.UID, false)
 			if err != nil {
 				srvLog.WithError(err).Warning("uc.ValidateToken")
-				c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+				httphelper.AbortWithError(c, errorstatus.ErrAuth)
 				return
 			}
 
-			err = uc.TokenExpire(c, claims.Id, tokenConf.AutoLogoffTimeout)
+			err = uc.TokenExpire(c, claims, tokenConf.AutoLogoffTimeout)
 			if err != nil {
 				srvLog.WithError(err).Warning("uc.TokenExpire")
-				c.AbortWithStatusJSON(http.StatusUnauthorized, "unauthorized")
+				httphelper.AbortWithError(c, errorstatus.ErrAuth)
 				return
 			}
 			c.Set("user", user)
@@ -149,13 +197,23 @@ func ValidateJWT(uc entity.UserUsecase, tokenConf *entity.TokenConf) gin.Handler
 	}
 }
 
+// JWKS publishes the public keys of the access tokens, the other services fetch them
+// to verify the tokens by their kid without the auth service
+func JWKS(tokenConf *entity.TokenConf) gin.HandlerFunc {
+	jwks := tokenConf.AccessKeys.JWKS()
+	return func(c *gin.Context) {
+		c.Header("Cache-Control", "public, max-age=300")
+		httphelper.SendResponse(c, jwks, nil)
+	}
+}
+
 func (ah *UserHandler) registerHandler(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "server.registerHandler"})
 
 	userForm, sendMethod, err := httphelper.UserCreateForm(c)
 	if err != nil {
 		srvLog.WithError(err).Warning("httphelper.UserCreateForm")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
@@ -165,6 +223,7 @@ func (ah *UserHandler) registerHandler(c *gin.Context) {
 		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
 		return
 	}
+	ah.mergeGuestCart(c, userForm.Id)
 
 	httphelper.SendResponse(c, "success", nil)
 }
@@ -172,13 +231,78 @@ func (ah *UserHandler) registerHandler(c *gin.Context) {
 func (ah *UserHandler) activateHandler(c *gin.Context) {
 	srvLog := log.WithFields(log.Fields{"func": "server.activateHandler"})
 
-	username := c.Param("username")
-	code := c.Param("code")
+	var activateReq dto.ActivateRequest
+	if err := httphelper.BindJSON(c, &activateReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
 
-	err := ah.UserUsecase.ActivateUser(c, username, code)
+	err := ah.UserUsecase.ActivateUser(c, activateReq.Username, activateReq.Code)
 	if err != nil {
 		srvLog.WithError(err).Warning("ah.UserUsecase.ActivateUser")
-		httphelper.SendResponse(c, nil, errorstatus.ErrBadReq)
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, "success", nil)
+}
+
+func (ah *UserHandler) resendCodeHandler(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.resendCodeHandler"})
+
+	var resendReq dto.ResendCodeRequest
+	if err := httphelper.BindJSON(c, &resendReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err := ah.UserUsecase.ResendVerification(c, resendReq.Username, resendReq.SendMethod, c.ClientIP())
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.ResendVerification")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, "success", nil)
+}
+
+// requestPasswordReset emails the reset link, the response is the same whether the account exists or not
+func (ah *UserHandler) requestPasswordReset(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.requestPasswordReset"})
+
+	var resetReq dto.PasswordResetRequest
+	if err := httphelper.BindJSON(c, &resetReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err := ah.UserUsecase.RequestPasswordReset(c, resetReq.Email, c.ClientIP())
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.RequestPasswordReset")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	httphelper.SendResponse(c, "success", nil)
+}
+
+func (ah *UserHandler) confirmPasswordReset(c *gin.Context) {
+	srvLog := log.WithFields(log.Fields{"func": "server.confirmPasswordReset"})
+
+	var confirmReq dto.PasswordResetConfirm
+	if err := httphelper.BindJSON(c, &confirmReq); err != nil {
+		srvLog.WithError(err).Warning("httphelper.BindJSON")
+		httphelper.SendResponse(c, nil, err)
+		return
+	}
+
+	err := ah.UserUsecase.ConfirmPasswordReset(c, confirmReq.Token, confirmReq.Password)
+	if err != nil {
+		srvLog.WithError(err).Warning("ah.UserUsecase.ConfirmPasswordReset")
+		httphelper.SendResponse(c, nil, err)
 		return
 	}
 
@@ -0,0 +1,1406 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/entity/user.go
+
+// Package userMock is a generated GoMock package.
+package userMock
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	entity "go-store/internal/entity"
+	jwt "go-store/utils/jwt"
+	reflect "reflect"
+	time "time"
+)
+
+// MockUserUsecase is a mock of UserUsecase interface
+type MockUserUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserUsecaseMockRecorder
+}
+
+// MockUserUsecaseMockRecorder is the mock recorder for MockUserUsecase
+type MockUserUsecaseMockRecorder struct {
+	mock *MockUserUsecase
+}
+
+// NewMockUserUsecase creates a new mock instance
+func NewMockUserUsecase(ctrl *gomock.Controller) *MockUserUsecase {
+	mock := &MockUserUsecase{ctrl: ctrl}
+	mock.recorder = &MockUserUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockUserUsecase) EXPECT() *MockUserUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method
+func (m *MockUserUsecase) Login(ctx context.Context, username, password string, client *entity.SessionClient, tokenConf *entity.TokenConf) (*entity.UserJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, username, password, client, tokenConf)
+	ret0, _ := ret[0].(*entity.UserJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login
+func (mr *MockUserUsecaseMockRecorder) Login(ctx, username, password, client, tokenConf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserUsecase)(nil).Login), ctx, username, password, client, tokenConf)
+}
+
+// Logout mocks base method
+func (m *MockUserUsecase) Logout(ctx context.Context, userId int, sessionId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, userId, sessionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout
+func (mr *MockUserUsecaseMockRecorder) Logout(ctx, userId, sessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserUsecase)(nil).Logout), ctx, userId, sessionId)
+}
+
+// Refresh mocks base method
+func (m *MockUserUsecase) Refresh(ctx context.Context, claims *entity.JwtClaims, tokenConf *entity.TokenConf) (*entity.UserJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, claims, tokenConf)
+	ret0, _ := ret[0].(*entity.UserJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh
+func (mr *MockUserUsecaseMockRecorder) Refresh(ctx, claims, tokenConf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockUserUsecase)(nil).Refresh), ctx, claims, tokenConf)
+}
+
+// ParseToken mocks base method
+func (m *MockUserUsecase) ParseToken(ctx context.Context, tokenStr string, keys *jwt.KeySet) (*entity.JwtClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ParseToken", ctx, tokenStr, keys)
+	ret0, _ := ret[0].(*entity.JwtClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ParseToken indicates an expected call of ParseToken
+func (mr *MockUserUsecaseMockRecorder) ParseToken(ctx, tokenStr, keys interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockUserUsecase)(nil).ParseToken), ctx, tokenStr, keys)
+}
+
+// ValidateToken mocks base method
+func (m *MockUserUsecase) ValidateToken(ctx context.Context, claims *entity.JwtClaims, tokenMdlw string, isRefresh bool) (*entity.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateToken", ctx, claims, tokenMdlw, isRefresh)
+	ret0, _ := ret[0].(*entity.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateToken indicates an expected call of ValidateToken
+func (mr *MockUserUsecaseMockRecorder) ValidateToken(ctx, claims, tokenMdlw, isRefresh interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateToken", reflect.TypeOf((*MockUserUsecase)(nil).ValidateToken), ctx, claims, tokenMdlw, isRefresh)
+}
+
+// TokenExpire mocks base method
+func (m *MockUserUsecase) TokenExpire(ctx context.Context, claims *entity.JwtClaims, timeExp time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TokenExpire", ctx, claims, timeExp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TokenExpire indicates an expected call of TokenExpire
+func (mr *MockUserUsecaseMockRecorder) TokenExpire(ctx, claims, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TokenExpire", reflect.TypeOf((*MockUserUsecase)(nil).TokenExpire), ctx, claims, timeExp)
+}
+
+// ListSessions mocks base method
+func (m *MockUserUsecase) ListSessions(ctx context.Context, user *entity.Users) ([]*entity.SessionJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, user)
+	ret0, _ := ret[0].([]*entity.SessionJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions
+func (mr *MockUserUsecaseMockRecorder) ListSessions(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockUserUsecase)(nil).ListSessions), ctx, user)
+}
+
+// RevokeSession mocks base method
+func (m *MockUserUsecase) RevokeSession(ctx context.Context, userId int, sessionId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, userId, sessionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession
+func (mr *MockUserUsecaseMockRecorder) RevokeSession(ctx, userId, sessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockUserUsecase)(nil).RevokeSession), ctx, userId, sessionId)
+}
+
+// RevokeSessions mocks base method
+func (m *MockUserUsecase) RevokeSessions(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSessions", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSessions indicates an expected call of RevokeSessions
+func (mr *MockUserUsecaseMockRecorder) RevokeSessions(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSessions", reflect.TypeOf((*MockUserUsecase)(nil).RevokeSessions), ctx, userId)
+}
+
+// CreateUser mocks base method
+func (m *MockUserUsecase) CreateUser(ctx context.Context, user *entity.Users, sendMethod *string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user, sendMethod)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUser indicates an expected call of CreateUser
+func (mr *MockUserUsecaseMockRecorder) CreateUser(ctx, user, sendMethod interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserUsecase)(nil).CreateUser), ctx, user, sendMethod)
+}
+
+// ActivateUser mocks base method
+func (m *MockUserUsecase) ActivateUser(ctx context.Context, username, verificationCode string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateUser", ctx, username, verificationCode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ActivateUser indicates an expected call of ActivateUser
+func (mr *MockUserUsecaseMockRecorder) ActivateUser(ctx, username, verificationCode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateUser", reflect.TypeOf((*MockUserUsecase)(nil).ActivateUser), ctx, username, verificationCode)
+}
+
+// ResendVerification mocks base method
+func (m *MockUserUsecase) ResendVerification(ctx context.Context, username, sendMethod, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResendVerification", ctx, username, sendMethod, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResendVerification indicates an expected call of ResendVerification
+func (mr *MockUserUsecaseMockRecorder) ResendVerification(ctx, username, sendMethod, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendVerification", reflect.TypeOf((*MockUserUsecase)(nil).ResendVerification), ctx, username, sendMethod, ip)
+}
+
+// RequestPasswordReset mocks base method
+func (m *MockUserUsecase) RequestPasswordReset(ctx context.Context, email, ip string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email, ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset
+func (mr *MockUserUsecaseMockRecorder) RequestPasswordReset(ctx, email, ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUserUsecase)(nil).RequestPasswordReset), ctx, email, ip)
+}
+
+// ConfirmPasswordReset mocks base method
+func (m *MockUserUsecase) ConfirmPasswordReset(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmPasswordReset", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmPasswordReset indicates an expected call of ConfirmPasswordReset
+func (mr *MockUserUsecaseMockRecorder) ConfirmPasswordReset(ctx, token, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmPasswordReset", reflect.TypeOf((*MockUserUsecase)(nil).ConfirmPasswordReset), ctx, token, password)
+}
+
+// VerifyMfa mocks base method
+func (m *MockUserUsecase) VerifyMfa(ctx context.Context, challengeId, code string, tokenConf *entity.TokenConf) (*entity.UserJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMfa", ctx, challengeId, code, tokenConf)
+	ret0, _ := ret[0].(*entity.UserJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMfa indicates an expected call of VerifyMfa
+func (mr *MockUserUsecaseMockRecorder) VerifyMfa(ctx, challengeId, code, tokenConf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMfa", reflect.TypeOf((*MockUserUsecase)(nil).VerifyMfa), ctx, challengeId, code, tokenConf)
+}
+
+// EnrollMfaChallenge mocks base method
+func (m *MockUserUsecase) EnrollMfaChallenge(ctx context.Context, challengeId string) (*entity.TotpEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollMfaChallenge", ctx, challengeId)
+	ret0, _ := ret[0].(*entity.TotpEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollMfaChallenge indicates an expected call of EnrollMfaChallenge
+func (mr *MockUserUsecaseMockRecorder) EnrollMfaChallenge(ctx, challengeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollMfaChallenge", reflect.TypeOf((*MockUserUsecase)(nil).EnrollMfaChallenge), ctx, challengeId)
+}
+
+// EnrollTotp mocks base method
+func (m *MockUserUsecase) EnrollTotp(ctx context.Context, user *entity.Users) (*entity.TotpEnrollment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnrollTotp", ctx, user)
+	ret0, _ := ret[0].(*entity.TotpEnrollment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnrollTotp indicates an expected call of EnrollTotp
+func (mr *MockUserUsecaseMockRecorder) EnrollTotp(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnrollTotp", reflect.TypeOf((*MockUserUsecase)(nil).EnrollTotp), ctx, user)
+}
+
+// ConfirmTotp mocks base method
+func (m *MockUserUsecase) ConfirmTotp(ctx context.Context, user *entity.Users, code string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmTotp", ctx, user, code)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfirmTotp indicates an expected call of ConfirmTotp
+func (mr *MockUserUsecaseMockRecorder) ConfirmTotp(ctx, user, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmTotp", reflect.TypeOf((*MockUserUsecase)(nil).ConfirmTotp), ctx, user, code)
+}
+
+// DisableTotp mocks base method
+func (m *MockUserUsecase) DisableTotp(ctx context.Context, user *entity.Users, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTotp", ctx, user, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTotp indicates an expected call of DisableTotp
+func (mr *MockUserUsecaseMockRecorder) DisableTotp(ctx, user, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTotp", reflect.TypeOf((*MockUserUsecase)(nil).DisableTotp), ctx, user, code)
+}
+
+// AssignRole mocks base method
+func (m *MockUserUsecase) AssignRole(ctx context.Context, admin *entity.Users, userId int, role entity.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignRole", ctx, admin, userId, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignRole indicates an expected call of AssignRole
+func (mr *MockUserUsecaseMockRecorder) AssignRole(ctx, admin, userId, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignRole", reflect.TypeOf((*MockUserUsecase)(nil).AssignRole), ctx, admin, userId, role)
+}
+
+// UnlockUser mocks base method
+func (m *MockUserUsecase) UnlockUser(ctx context.Context, staff *entity.Users, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnlockUser", ctx, staff, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnlockUser indicates an expected call of UnlockUser
+func (mr *MockUserUsecaseMockRecorder) UnlockUser(ctx, staff, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlockUser", reflect.TypeOf((*MockUserUsecase)(nil).UnlockUser), ctx, staff, userId)
+}
+
+// OidcAuthURL mocks base method
+func (m *MockUserUsecase) OidcAuthURL(ctx context.Context, provider string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OidcAuthURL", ctx, provider)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OidcAuthURL indicates an expected call of OidcAuthURL
+func (mr *MockUserUsecaseMockRecorder) OidcAuthURL(ctx, provider interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OidcAuthURL", reflect.TypeOf((*MockUserUsecase)(nil).OidcAuthURL), ctx, provider)
+}
+
+// OidcLogin mocks base method
+func (m *MockUserUsecase) OidcLogin(ctx context.Context, provider, state, code string, client *entity.SessionClient, tokenConf *entity.TokenConf) (*entity.UserJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OidcLogin", ctx, provider, state, code, client, tokenConf)
+	ret0, _ := ret[0].(*entity.UserJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OidcLogin indicates an expected call of OidcLogin
+func (mr *MockUserUsecaseMockRecorder) OidcLogin(ctx, provider, state, code, client, tokenConf interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OidcLogin", reflect.TypeOf((*MockUserUsecase)(nil).OidcLogin), ctx, provider, state, code, client, tokenConf)
+}
+
+// GetProfile mocks base method
+func (m *MockUserUsecase) GetProfile(ctx context.Context, user *entity.Users) (*entity.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", ctx, user)
+	ret0, _ := ret[0].(*entity.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile
+func (mr *MockUserUsecaseMockRecorder) GetProfile(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockUserUsecase)(nil).GetProfile), ctx, user)
+}
+
+// UpdateProfile mocks base method
+func (m *MockUserUsecase) UpdateProfile(ctx context.Context, user *entity.Users, update *entity.ProfileUpdate) (*entity.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, user, update)
+	ret0, _ := ret[0].(*entity.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile
+func (mr *MockUserUsecaseMockRecorder) UpdateProfile(ctx, user, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockUserUsecase)(nil).UpdateProfile), ctx, user, update)
+}
+
+// UpdatePhoto mocks base method
+func (m *MockUserUsecase) UpdatePhoto(ctx context.Context, user *entity.Users, photo string) (*entity.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoto", ctx, user, photo)
+	ret0, _ := ret[0].(*entity.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePhoto indicates an expected call of UpdatePhoto
+func (mr *MockUserUsecaseMockRecorder) UpdatePhoto(ctx, user, photo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoto", reflect.TypeOf((*MockUserUsecase)(nil).UpdatePhoto), ctx, user, photo)
+}
+
+// ChangePassword mocks base method
+func (m *MockUserUsecase) ChangePassword(ctx context.Context, user *entity.Users, password, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, user, password, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword
+func (mr *MockUserUsecaseMockRecorder) ChangePassword(ctx, user, password, newPassword interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockUserUsecase)(nil).ChangePassword), ctx, user, password, newPassword)
+}
+
+// RequestEmailChange mocks base method
+func (m *MockUserUsecase) RequestEmailChange(ctx context.Context, user *entity.Users, email, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestEmailChange", ctx, user, email, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestEmailChange indicates an expected call of RequestEmailChange
+func (mr *MockUserUsecaseMockRecorder) RequestEmailChange(ctx, user, email, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestEmailChange", reflect.TypeOf((*MockUserUsecase)(nil).RequestEmailChange), ctx, user, email, password)
+}
+
+// ConfirmEmailChange mocks base method
+func (m *MockUserUsecase) ConfirmEmailChange(ctx context.Context, user *entity.Users, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfirmEmailChange", ctx, user, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConfirmEmailChange indicates an expected call of ConfirmEmailChange
+func (mr *MockUserUsecaseMockRecorder) ConfirmEmailChange(ctx, user, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfirmEmailChange", reflect.TypeOf((*MockUserUsecase)(nil).ConfirmEmailChange), ctx, user, code)
+}
+
+// DeleteAccount mocks base method
+func (m *MockUserUsecase) DeleteAccount(ctx context.Context, user *entity.Users, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccount", ctx, user, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAccount indicates an expected call of DeleteAccount
+func (mr *MockUserUsecaseMockRecorder) DeleteAccount(ctx, user, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockUserUsecase)(nil).DeleteAccount), ctx, user, password)
+}
+
+// SearchUsers mocks base method
+func (m *MockUserUsecase) SearchUsers(ctx context.Context, staff *entity.Users, filter *entity.UserFilter) (*entity.ResultUserJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, staff, filter)
+	ret0, _ := ret[0].(*entity.ResultUserJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers
+func (mr *MockUserUsecaseMockRecorder) SearchUsers(ctx, staff, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserUsecase)(nil).SearchUsers), ctx, staff, filter)
+}
+
+// UserDetail mocks base method
+func (m *MockUserUsecase) UserDetail(ctx context.Context, staff *entity.Users, userId int) (*entity.UserDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDetail", ctx, staff, userId)
+	ret0, _ := ret[0].(*entity.UserDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDetail indicates an expected call of UserDetail
+func (mr *MockUserUsecaseMockRecorder) UserDetail(ctx, staff, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDetail", reflect.TypeOf((*MockUserUsecase)(nil).UserDetail), ctx, staff, userId)
+}
+
+// SetUserState mocks base method
+func (m *MockUserUsecase) SetUserState(ctx context.Context, admin *entity.Users, userId int, state entity.State, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserState", ctx, admin, userId, state, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserState indicates an expected call of SetUserState
+func (mr *MockUserUsecaseMockRecorder) SetUserState(ctx, admin, userId, state, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserState", reflect.TypeOf((*MockUserUsecase)(nil).SetUserState), ctx, admin, userId, state, reason)
+}
+
+// UserAuditLog mocks base method
+func (m *MockUserUsecase) UserAuditLog(ctx context.Context, staff *entity.Users, userId, limit, offset int) ([]*entity.UserAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserAuditLog", ctx, staff, userId, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserAuditLog indicates an expected call of UserAuditLog
+func (mr *MockUserUsecaseMockRecorder) UserAuditLog(ctx, staff, userId, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserAuditLog", reflect.TypeOf((*MockUserUsecase)(nil).UserAuditLog), ctx, staff, userId, limit, offset)
+}
+
+// MockAuthPgxRepository is a mock of AuthPgxRepository interface
+type MockAuthPgxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthPgxRepositoryMockRecorder
+}
+
+// MockAuthPgxRepositoryMockRecorder is the mock recorder for MockAuthPgxRepository
+type MockAuthPgxRepositoryMockRecorder struct {
+	mock *MockAuthPgxRepository
+}
+
+// NewMockAuthPgxRepository creates a new mock instance
+func NewMockAuthPgxRepository(ctrl *gomock.Controller) *MockAuthPgxRepository {
+	mock := &MockAuthPgxRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthPgxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuthPgxRepository) EXPECT() *MockAuthPgxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// UserByUsername mocks base method
+func (m *MockAuthPgxRepository) UserByUsername(ctx context.Context, username string) (*entity.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserByUsername", ctx, username)
+	ret0, _ := ret[0].(*entity.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserByUsername indicates an expected call of UserByUsername
+func (mr *MockAuthPgxRepositoryMockRecorder) UserByUsername(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByUsername", reflect.TypeOf((*MockAuthPgxRepository)(nil).UserByUsername), ctx, username)
+}
+
+// UserByEmail mocks base method
+func (m *MockAuthPgxRepository) UserByEmail(ctx context.Context, email string) (*entity.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserByEmail", ctx, email)
+	ret0, _ := ret[0].(*entity.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserByEmail indicates an expected call of UserByEmail
+func (mr *MockAuthPgxRepositoryMockRecorder) UserByEmail(ctx, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByEmail", reflect.TypeOf((*MockAuthPgxRepository)(nil).UserByEmail), ctx, email)
+}
+
+// UserById mocks base method
+func (m *MockAuthPgxRepository) UserById(ctx context.Context, userId int) (*entity.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserById", ctx, userId)
+	ret0, _ := ret[0].(*entity.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserById indicates an expected call of UserById
+func (mr *MockAuthPgxRepositoryMockRecorder) UserById(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserById", reflect.TypeOf((*MockAuthPgxRepository)(nil).UserById), ctx, userId)
+}
+
+// Create mocks base method
+func (m *MockAuthPgxRepository) Create(ctx context.Context, user *entity.Users) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create
+func (mr *MockAuthPgxRepositoryMockRecorder) Create(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthPgxRepository)(nil).Create), ctx, user)
+}
+
+// Update mocks base method
+func (m *MockAuthPgxRepository) Update(ctx context.Context, user *entity.Users) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update
+func (mr *MockAuthPgxRepositoryMockRecorder) Update(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAuthPgxRepository)(nil).Update), ctx, user)
+}
+
+// UpdatePassword mocks base method
+func (m *MockAuthPgxRepository) UpdatePassword(ctx context.Context, userId int, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userId, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword
+func (mr *MockAuthPgxRepositoryMockRecorder) UpdatePassword(ctx, userId, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockAuthPgxRepository)(nil).UpdatePassword), ctx, userId, password)
+}
+
+// UpdateState mocks base method
+func (m *MockAuthPgxRepository) UpdateState(ctx context.Context, userId int, state entity.State) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateState", ctx, userId, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateState indicates an expected call of UpdateState
+func (mr *MockAuthPgxRepositoryMockRecorder) UpdateState(ctx, userId, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateState", reflect.TypeOf((*MockAuthPgxRepository)(nil).UpdateState), ctx, userId, state)
+}
+
+// VerifyEmail mocks base method
+func (m *MockAuthPgxRepository) VerifyEmail(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail
+func (mr *MockAuthPgxRepositoryMockRecorder) VerifyEmail(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockAuthPgxRepository)(nil).VerifyEmail), ctx, userId)
+}
+
+// EnableTotp mocks base method
+func (m *MockAuthPgxRepository) EnableTotp(ctx context.Context, userId int, secret string, recoveryHashes []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTotp", ctx, userId, secret, recoveryHashes)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTotp indicates an expected call of EnableTotp
+func (mr *MockAuthPgxRepositoryMockRecorder) EnableTotp(ctx, userId, secret, recoveryHashes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTotp", reflect.TypeOf((*MockAuthPgxRepository)(nil).EnableTotp), ctx, userId, secret, recoveryHashes)
+}
+
+// DisableTotp mocks base method
+func (m *MockAuthPgxRepository) DisableTotp(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableTotp", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DisableTotp indicates an expected call of DisableTotp
+func (mr *MockAuthPgxRepositoryMockRecorder) DisableTotp(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableTotp", reflect.TypeOf((*MockAuthPgxRepository)(nil).DisableTotp), ctx, userId)
+}
+
+// UseRecoveryCode mocks base method
+func (m *MockAuthPgxRepository) UseRecoveryCode(ctx context.Context, userId int, recoveryHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRecoveryCode", ctx, userId, recoveryHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRecoveryCode indicates an expected call of UseRecoveryCode
+func (mr *MockAuthPgxRepositoryMockRecorder) UseRecoveryCode(ctx, userId, recoveryHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRecoveryCode", reflect.TypeOf((*MockAuthPgxRepository)(nil).UseRecoveryCode), ctx, userId, recoveryHash)
+}
+
+// UpdateRole mocks base method
+func (m *MockAuthPgxRepository) UpdateRole(ctx context.Context, userId int, role entity.UserRole) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, userId, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRole indicates an expected call of UpdateRole
+func (mr *MockAuthPgxRepositoryMockRecorder) UpdateRole(ctx, userId, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockAuthPgxRepository)(nil).UpdateRole), ctx, userId, role)
+}
+
+// UserByIdentity mocks base method
+func (m *MockAuthPgxRepository) UserByIdentity(ctx context.Context, provider, subject string) (*entity.Users, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserByIdentity", ctx, provider, subject)
+	ret0, _ := ret[0].(*entity.Users)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserByIdentity indicates an expected call of UserByIdentity
+func (mr *MockAuthPgxRepositoryMockRecorder) UserByIdentity(ctx, provider, subject interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserByIdentity", reflect.TypeOf((*MockAuthPgxRepository)(nil).UserByIdentity), ctx, provider, subject)
+}
+
+// CreateIdentity mocks base method
+func (m *MockAuthPgxRepository) CreateIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdentity", ctx, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateIdentity indicates an expected call of CreateIdentity
+func (mr *MockAuthPgxRepositoryMockRecorder) CreateIdentity(ctx, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdentity", reflect.TypeOf((*MockAuthPgxRepository)(nil).CreateIdentity), ctx, identity)
+}
+
+// CreateWithIdentity mocks base method
+func (m *MockAuthPgxRepository) CreateWithIdentity(ctx context.Context, user *entity.Users, identity *entity.UserIdentity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithIdentity", ctx, user, identity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithIdentity indicates an expected call of CreateWithIdentity
+func (mr *MockAuthPgxRepositoryMockRecorder) CreateWithIdentity(ctx, user, identity interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithIdentity", reflect.TypeOf((*MockAuthPgxRepository)(nil).CreateWithIdentity), ctx, user, identity)
+}
+
+// UserProfile mocks base method
+func (m *MockAuthPgxRepository) UserProfile(ctx context.Context, userId int) (*entity.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserProfile", ctx, userId)
+	ret0, _ := ret[0].(*entity.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserProfile indicates an expected call of UserProfile
+func (mr *MockAuthPgxRepositoryMockRecorder) UserProfile(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserProfile", reflect.TypeOf((*MockAuthPgxRepository)(nil).UserProfile), ctx, userId)
+}
+
+// UpdateProfile mocks base method
+func (m *MockAuthPgxRepository) UpdateProfile(ctx context.Context, userId int, update *entity.ProfileUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProfile", ctx, userId, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateProfile indicates an expected call of UpdateProfile
+func (mr *MockAuthPgxRepositoryMockRecorder) UpdateProfile(ctx, userId, update interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProfile", reflect.TypeOf((*MockAuthPgxRepository)(nil).UpdateProfile), ctx, userId, update)
+}
+
+// UpdatePhoto mocks base method
+func (m *MockAuthPgxRepository) UpdatePhoto(ctx context.Context, userId int, photo string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhoto", ctx, userId, photo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePhoto indicates an expected call of UpdatePhoto
+func (mr *MockAuthPgxRepositoryMockRecorder) UpdatePhoto(ctx, userId, photo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhoto", reflect.TypeOf((*MockAuthPgxRepository)(nil).UpdatePhoto), ctx, userId, photo)
+}
+
+// UpdateEmail mocks base method
+func (m *MockAuthPgxRepository) UpdateEmail(ctx context.Context, userId int, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEmail", ctx, userId, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEmail indicates an expected call of UpdateEmail
+func (mr *MockAuthPgxRepositoryMockRecorder) UpdateEmail(ctx, userId, email interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEmail", reflect.TypeOf((*MockAuthPgxRepository)(nil).UpdateEmail), ctx, userId, email)
+}
+
+// AnonymizeUser mocks base method
+func (m *MockAuthPgxRepository) AnonymizeUser(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeUser", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AnonymizeUser indicates an expected call of AnonymizeUser
+func (mr *MockAuthPgxRepositoryMockRecorder) AnonymizeUser(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeUser", reflect.TypeOf((*MockAuthPgxRepository)(nil).AnonymizeUser), ctx, userId)
+}
+
+// SearchUsers mocks base method
+func (m *MockAuthPgxRepository) SearchUsers(ctx context.Context, filter *entity.UserFilter) (*entity.ResultUserJson, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", ctx, filter)
+	ret0, _ := ret[0].(*entity.ResultUserJson)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers
+func (mr *MockAuthPgxRepositoryMockRecorder) SearchUsers(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockAuthPgxRepository)(nil).SearchUsers), ctx, filter)
+}
+
+// UserDetail mocks base method
+func (m *MockAuthPgxRepository) UserDetail(ctx context.Context, userId int) (*entity.UserDetail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserDetail", ctx, userId)
+	ret0, _ := ret[0].(*entity.UserDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserDetail indicates an expected call of UserDetail
+func (mr *MockAuthPgxRepositoryMockRecorder) UserDetail(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserDetail", reflect.TypeOf((*MockAuthPgxRepository)(nil).UserDetail), ctx, userId)
+}
+
+// CreateAudit mocks base method
+func (m *MockAuthPgxRepository) CreateAudit(ctx context.Context, audit *entity.UserAudit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAudit", ctx, audit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAudit indicates an expected call of CreateAudit
+func (mr *MockAuthPgxRepositoryMockRecorder) CreateAudit(ctx, audit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAudit", reflect.TypeOf((*MockAuthPgxRepository)(nil).CreateAudit), ctx, audit)
+}
+
+// AuditLog mocks base method
+func (m *MockAuthPgxRepository) AuditLog(ctx context.Context, userId, limit, offset int) ([]*entity.UserAudit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuditLog", ctx, userId, limit, offset)
+	ret0, _ := ret[0].([]*entity.UserAudit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuditLog indicates an expected call of AuditLog
+func (mr *MockAuthPgxRepositoryMockRecorder) AuditLog(ctx, userId, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuditLog", reflect.TypeOf((*MockAuthPgxRepository)(nil).AuditLog), ctx, userId, limit, offset)
+}
+
+// MockAuthRedisRepository is a mock of AuthRedisRepository interface
+type MockAuthRedisRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthRedisRepositoryMockRecorder
+}
+
+// MockAuthRedisRepositoryMockRecorder is the mock recorder for MockAuthRedisRepository
+type MockAuthRedisRepositoryMockRecorder struct {
+	mock *MockAuthRedisRepository
+}
+
+// NewMockAuthRedisRepository creates a new mock instance
+func NewMockAuthRedisRepository(ctrl *gomock.Controller) *MockAuthRedisRepository {
+	mock := &MockAuthRedisRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthRedisRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuthRedisRepository) EXPECT() *MockAuthRedisRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetUser mocks base method
+func (m *MockAuthRedisRepository) GetUser(ctx context.Context, username string) (*entity.Claims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, username)
+	ret0, _ := ret[0].(*entity.Claims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser
+func (mr *MockAuthRedisRepositoryMockRecorder) GetUser(ctx, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockAuthRedisRepository)(nil).GetUser), ctx, username)
+}
+
+// SetUserCtx mocks base method
+func (m *MockAuthRedisRepository) SetUserCtx(ctx context.Context, username string, seconds int, creds *entity.Claims) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserCtx", ctx, username, seconds, creds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserCtx indicates an expected call of SetUserCtx
+func (mr *MockAuthRedisRepositoryMockRecorder) SetUserCtx(ctx, username, seconds, creds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserCtx", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetUserCtx), ctx, username, seconds, creds)
+}
+
+// GetSession mocks base method
+func (m *MockAuthRedisRepository) GetSession(ctx context.Context, sessionId string) (*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionId)
+	ret0, _ := ret[0].(*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession
+func (mr *MockAuthRedisRepositoryMockRecorder) GetSession(ctx, sessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockAuthRedisRepository)(nil).GetSession), ctx, sessionId)
+}
+
+// SetSession mocks base method
+func (m *MockAuthRedisRepository) SetSession(ctx context.Context, session *entity.Session, timeExp time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSession", ctx, session, timeExp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSession indicates an expected call of SetSession
+func (mr *MockAuthRedisRepositoryMockRecorder) SetSession(ctx, session, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSession", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetSession), ctx, session, timeExp)
+}
+
+// TouchSession mocks base method
+func (m *MockAuthRedisRepository) TouchSession(ctx context.Context, userId int, sessionId string, lastSeenTs time.Time, timeExp time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchSession", ctx, userId, sessionId, lastSeenTs, timeExp)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchSession indicates an expected call of TouchSession
+func (mr *MockAuthRedisRepositoryMockRecorder) TouchSession(ctx, userId, sessionId, lastSeenTs, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchSession", reflect.TypeOf((*MockAuthRedisRepository)(nil).TouchSession), ctx, userId, sessionId, lastSeenTs, timeExp)
+}
+
+// ListSessions mocks base method
+func (m *MockAuthRedisRepository) ListSessions(ctx context.Context, userId int) ([]*entity.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx, userId)
+	ret0, _ := ret[0].([]*entity.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions
+func (mr *MockAuthRedisRepositoryMockRecorder) ListSessions(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockAuthRedisRepository)(nil).ListSessions), ctx, userId)
+}
+
+// DeleteSession mocks base method
+func (m *MockAuthRedisRepository) DeleteSession(ctx context.Context, userId int, sessionId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, userId, sessionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteSession(ctx, userId, sessionId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteSession), ctx, userId, sessionId)
+}
+
+// DeleteUserSessions mocks base method
+func (m *MockAuthRedisRepository) DeleteUserSessions(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteUserSessions(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteUserSessions), ctx, userId)
+}
+
+// UseRefreshToken mocks base method
+func (m *MockAuthRedisRepository) UseRefreshToken(ctx context.Context, tokenId, sessionId string, timeExp time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseRefreshToken", ctx, tokenId, sessionId, timeExp)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseRefreshToken indicates an expected call of UseRefreshToken
+func (mr *MockAuthRedisRepositoryMockRecorder) UseRefreshToken(ctx, tokenId, sessionId, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseRefreshToken", reflect.TypeOf((*MockAuthRedisRepository)(nil).UseRefreshToken), ctx, tokenId, sessionId, timeExp)
+}
+
+// SetResetToken mocks base method
+func (m *MockAuthRedisRepository) SetResetToken(ctx context.Context, userId int, tokenId string, timeExp time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetResetToken", ctx, userId, tokenId, timeExp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetResetToken indicates an expected call of SetResetToken
+func (mr *MockAuthRedisRepositoryMockRecorder) SetResetToken(ctx, userId, tokenId, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetResetToken", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetResetToken), ctx, userId, tokenId, timeExp)
+}
+
+// TakeResetToken mocks base method
+func (m *MockAuthRedisRepository) TakeResetToken(ctx context.Context, userId int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeResetToken", ctx, userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeResetToken indicates an expected call of TakeResetToken
+func (mr *MockAuthRedisRepositoryMockRecorder) TakeResetToken(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeResetToken", reflect.TypeOf((*MockAuthRedisRepository)(nil).TakeResetToken), ctx, userId)
+}
+
+// IncrRate mocks base method
+func (m *MockAuthRedisRepository) IncrRate(ctx context.Context, key string, window time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IncrRate", ctx, key, window)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IncrRate indicates an expected call of IncrRate
+func (mr *MockAuthRedisRepositoryMockRecorder) IncrRate(ctx, key, window interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IncrRate", reflect.TypeOf((*MockAuthRedisRepository)(nil).IncrRate), ctx, key, window)
+}
+
+// ResetRate mocks base method
+func (m *MockAuthRedisRepository) ResetRate(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetRate", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetRate indicates an expected call of ResetRate
+func (mr *MockAuthRedisRepositoryMockRecorder) ResetRate(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetRate", reflect.TypeOf((*MockAuthRedisRepository)(nil).ResetRate), ctx, key)
+}
+
+// SetLock mocks base method
+func (m *MockAuthRedisRepository) SetLock(ctx context.Context, key string, timeExp time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLock", ctx, key, timeExp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLock indicates an expected call of SetLock
+func (mr *MockAuthRedisRepositoryMockRecorder) SetLock(ctx, key, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLock", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetLock), ctx, key, timeExp)
+}
+
+// LockTTL mocks base method
+func (m *MockAuthRedisRepository) LockTTL(ctx context.Context, key string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockTTL", ctx, key)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockTTL indicates an expected call of LockTTL
+func (mr *MockAuthRedisRepositoryMockRecorder) LockTTL(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockTTL", reflect.TypeOf((*MockAuthRedisRepository)(nil).LockTTL), ctx, key)
+}
+
+// DeleteLock mocks base method
+func (m *MockAuthRedisRepository) DeleteLock(ctx context.Context, key string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLock", ctx, key)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLock indicates an expected call of DeleteLock
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteLock(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLock", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteLock), ctx, key)
+}
+
+// SetVerification mocks base method
+func (m *MockAuthRedisRepository) SetVerification(ctx context.Context, userId int, verification *entity.Verification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetVerification", ctx, userId, verification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetVerification indicates an expected call of SetVerification
+func (mr *MockAuthRedisRepositoryMockRecorder) SetVerification(ctx, userId, verification interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVerification", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetVerification), ctx, userId, verification)
+}
+
+// GetVerification mocks base method
+func (m *MockAuthRedisRepository) GetVerification(ctx context.Context, userId int) (*entity.Verification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVerification", ctx, userId)
+	ret0, _ := ret[0].(*entity.Verification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVerification indicates an expected call of GetVerification
+func (mr *MockAuthRedisRepositoryMockRecorder) GetVerification(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVerification", reflect.TypeOf((*MockAuthRedisRepository)(nil).GetVerification), ctx, userId)
+}
+
+// DeleteVerification mocks base method
+func (m *MockAuthRedisRepository) DeleteVerification(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVerification", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVerification indicates an expected call of DeleteVerification
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteVerification(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVerification", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteVerification), ctx, userId)
+}
+
+// SetMfaChallenge mocks base method
+func (m *MockAuthRedisRepository) SetMfaChallenge(ctx context.Context, challenge *entity.MfaChallenge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMfaChallenge", ctx, challenge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetMfaChallenge indicates an expected call of SetMfaChallenge
+func (mr *MockAuthRedisRepositoryMockRecorder) SetMfaChallenge(ctx, challenge interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMfaChallenge", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetMfaChallenge), ctx, challenge)
+}
+
+// GetMfaChallenge mocks base method
+func (m *MockAuthRedisRepository) GetMfaChallenge(ctx context.Context, challengeId string) (*entity.MfaChallenge, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMfaChallenge", ctx, challengeId)
+	ret0, _ := ret[0].(*entity.MfaChallenge)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMfaChallenge indicates an expected call of GetMfaChallenge
+func (mr *MockAuthRedisRepositoryMockRecorder) GetMfaChallenge(ctx, challengeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMfaChallenge", reflect.TypeOf((*MockAuthRedisRepository)(nil).GetMfaChallenge), ctx, challengeId)
+}
+
+// DeleteMfaChallenge mocks base method
+func (m *MockAuthRedisRepository) DeleteMfaChallenge(ctx context.Context, challengeId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMfaChallenge", ctx, challengeId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMfaChallenge indicates an expected call of DeleteMfaChallenge
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteMfaChallenge(ctx, challengeId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMfaChallenge", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteMfaChallenge), ctx, challengeId)
+}
+
+// SetTotpPending mocks base method
+func (m *MockAuthRedisRepository) SetTotpPending(ctx context.Context, userId int, secret string, timeExp time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTotpPending", ctx, userId, secret, timeExp)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTotpPending indicates an expected call of SetTotpPending
+func (mr *MockAuthRedisRepositoryMockRecorder) SetTotpPending(ctx, userId, secret, timeExp interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTotpPending", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetTotpPending), ctx, userId, secret, timeExp)
+}
+
+// GetTotpPending mocks base method
+func (m *MockAuthRedisRepository) GetTotpPending(ctx context.Context, userId int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotpPending", ctx, userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotpPending indicates an expected call of GetTotpPending
+func (mr *MockAuthRedisRepositoryMockRecorder) GetTotpPending(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotpPending", reflect.TypeOf((*MockAuthRedisRepository)(nil).GetTotpPending), ctx, userId)
+}
+
+// DeleteTotpPending mocks base method
+func (m *MockAuthRedisRepository) DeleteTotpPending(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTotpPending", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTotpPending indicates an expected call of DeleteTotpPending
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteTotpPending(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTotpPending", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteTotpPending), ctx, userId)
+}
+
+// SetOidcState mocks base method
+func (m *MockAuthRedisRepository) SetOidcState(ctx context.Context, state string, oidcState *entity.OidcState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOidcState", ctx, state, oidcState)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOidcState indicates an expected call of SetOidcState
+func (mr *MockAuthRedisRepositoryMockRecorder) SetOidcState(ctx, state, oidcState interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOidcState", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetOidcState), ctx, state, oidcState)
+}
+
+// TakeOidcState mocks base method
+func (m *MockAuthRedisRepository) TakeOidcState(ctx context.Context, state string) (*entity.OidcState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TakeOidcState", ctx, state)
+	ret0, _ := ret[0].(*entity.OidcState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeOidcState indicates an expected call of TakeOidcState
+func (mr *MockAuthRedisRepositoryMockRecorder) TakeOidcState(ctx, state interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeOidcState", reflect.TypeOf((*MockAuthRedisRepository)(nil).TakeOidcState), ctx, state)
+}
+
+// SetEmailChange mocks base method
+func (m *MockAuthRedisRepository) SetEmailChange(ctx context.Context, userId int, change *entity.EmailChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEmailChange", ctx, userId, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEmailChange indicates an expected call of SetEmailChange
+func (mr *MockAuthRedisRepositoryMockRecorder) SetEmailChange(ctx, userId, change interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEmailChange", reflect.TypeOf((*MockAuthRedisRepository)(nil).SetEmailChange), ctx, userId, change)
+}
+
+// GetEmailChange mocks base method
+func (m *MockAuthRedisRepository) GetEmailChange(ctx context.Context, userId int) (*entity.EmailChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailChange", ctx, userId)
+	ret0, _ := ret[0].(*entity.EmailChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailChange indicates an expected call of GetEmailChange
+func (mr *MockAuthRedisRepositoryMockRecorder) GetEmailChange(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailChange", reflect.TypeOf((*MockAuthRedisRepository)(nil).GetEmailChange), ctx, userId)
+}
+
+// DeleteEmailChange mocks base method
+func (m *MockAuthRedisRepository) DeleteEmailChange(ctx context.Context, userId int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailChange", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmailChange indicates an expected call of DeleteEmailChange
+func (mr *MockAuthRedisRepositoryMockRecorder) DeleteEmailChange(ctx, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailChange", reflect.TypeOf((*MockAuthRedisRepository)(nil).DeleteEmailChange), ctx, userId)
+}
+
+// MockAuthBroker is a mock of AuthBroker interface
+type MockAuthBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthBrokerMockRecorder
+}
+
+// MockAuthBrokerMockRecorder is the mock recorder for MockAuthBroker
+type MockAuthBrokerMockRecorder struct {
+	mock *MockAuthBroker
+}
+
+// NewMockAuthBroker creates a new mock instance
+func NewMockAuthBroker(ctrl *gomock.Controller) *MockAuthBroker {
+	mock := &MockAuthBroker{ctrl: ctrl}
+	mock.recorder = &MockAuthBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockAuthBroker) EXPECT() *MockAuthBrokerMockRecorder {
+	return m.recorder
+}
+
+// SendEmail mocks base method
+func (m *MockAuthBroker) SendEmail(ctx context.Context, to, subject string, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmail", ctx, to, subject, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmail indicates an expected call of SendEmail
+func (mr *MockAuthBrokerMockRecorder) SendEmail(ctx, to, subject, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockAuthBroker)(nil).SendEmail), ctx, to, subject, message)
+}
+
+// SendSms mocks base method
+func (m *MockAuthBroker) SendSms(ctx context.Context, to string, message []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendSms", ctx, to, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendSms indicates an expected call of SendSms
+func (mr *MockAuthBrokerMockRecorder) SendSms(ctx, to, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendSms", reflect.TypeOf((*MockAuthBroker)(nil).SendSms), ctx, to, message)
+}
@@ -12,31 +12,63 @@ import (
 )
 
 type KafkaConn struct {
-	*kafka.Conn
+	emailConn *kafka.Conn
+	smsConn   *kafka.Conn
 }
 
-func NewUserBroker(conn *kafka.Conn) entity.AuthBroker {
-	return &KafkaConn{conn}
+// NewUserBroker will create an object that represent the entity.AuthBroker interface,
+// the connections of the email and sms topics are shared by the following messages
+func NewUserBroker(emailConn *kafka.Conn, smsConn *kafka.Conn) entity.AuthBroker {
+	return &KafkaConn{emailConn: emailConn, smsConn: smsConn}
 }
 
-func (b *KafkaConn) SendEmail(ctx context.Context, dest string, message []byte) error {
+// SendEmail writes the email body to the email topic, the recipient and subject go to the message headers
+func (b *KafkaConn) SendEmail(ctx context.Context, dest string, subject string, message []byte) error {
 	bLog := log.WithFields(log.Fields{"func": "broker.SendEmail"})
-	err := b.Conn.SetWriteDeadline(time.Now().Add(10 * time.Second))
-	timeNowUnix := time.Now().Unix()
-	timeNowStr := strconv.FormatUint(uint64(timeNowUnix), 10)
-	_, err = b.Conn.WriteMessages(
+	err := b.emailConn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	if err != nil {
+		bLog.Warning("failed to set write deadline:", err)
+		return err
+	}
+	timeNowStr := strconv.FormatInt(time.Now().Unix(), 10)
+	_, err = b.emailConn.WriteMessages(
 		kafka.Message{
 			Key:   []byte("email-" + timeNowStr),
 			Value: message,
+			Headers: []kafka.Header{
+				{Key: "to", Value: []byte(dest)},
+				{Key: "subject", Value: []byte(subject)},
+			},
 		},
 	)
 	if err != nil {
 		bLog.Warning("failed to write messages:", err)
+		return err
 	}
+	return nil
+}
 
-	if err := b.Conn.Close(); err != nil {
-		bLog.Warning("failed to close writer:", err)
+// SendSms writes the text to the sms topic, the phone number goes to the message headers
+func (b *KafkaConn) SendSms(ctx context.Context, dest string, message []byte) error {
+	bLog := log.WithFields(log.Fields{"func": "broker.SendSms"})
+	err := b.smsConn.SetWriteDeadline(time.Now().Add(10 * time.Second))
+	if err != nil {
+		bLog.Warning("failed to set write deadline:", err)
+		return err
+	}
+	timeNowStr := strconv.FormatInt(time.Now().Unix(), 10)
+	_, err = b.smsConn.WriteMessages(
+		kafka.Message{
+			Key:   []byte("sms-" + timeNowStr),
+			Value: message,
+			Headers: []kafka.Header{
+				{Key: "to", Value: []byte(dest)},
+			},
+		},
+	)
+	if err != nil {
+		bLog.Warning("failed to write messages:", err)
+		return err
 	}
-
 	return nil
 }
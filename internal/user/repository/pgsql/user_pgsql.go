@@ -2,8 +2,11 @@ package postgsql_repo
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	log "github.com/sirupsen/logrus"
 
@@ -28,7 +31,12 @@ func (d *PgxAccess) UserByUsername(ctx context.Context, username string) (result
 			"users.public_id",
 			"users.username",
 			"users.password",
-			"users.role").
+			"users.role",
+			"COALESCE(users.region_id, 0)",
+			"COALESCE(users.email, '')",
+			"COALESCE(users.phone_number, '')",
+			"users.state",
+			"COALESCE(users.totp_secret, '')").
 		From("users").
 		Where("users.username = $1", username).
 		ToSql()
@@ -36,7 +44,11 @@ func (d *PgxAccess) UserByUsername(ctx context.Context, username string) (result
 		dbLog.WithError(err).Errorf("SourceRepo - GetById - r.Builder")
 		return nil, err
 	}
-	if err := d.Pool.QueryRow(context.Background(), query, args...).Scan(&user.Id, &user.PublicId, &user.Username, &user.Password, &user.Role); err != nil {
+	err = d.Pool.QueryRow(context.Background(), query, args...).Scan(&user.Id, &user.PublicId, &user.Username, &user.Password, &user.Role, &user.RegionId, &user.Email, &user.PhoneNumber, &user.State, &user.TotpSecret)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
 		dbLog.WithFields(log.Fields{"user_id": user.Id}).Warning(err)
 		return nil, err
 	}
@@ -44,6 +56,65 @@ func (d *PgxAccess) UserByUsername(ctx context.Context, username string) (result
 
 }
 
+func (d *PgxAccess) UserByEmail(ctx context.Context, email string) (result *entity.Users, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.UserByEmail"})
+	user := &entity.Users{}
+	query, args, err := d.Builder.
+		Select("users.id",
+			"users.public_id",
+			"users.username",
+			"users.role",
+			"users.email",
+			"users.state",
+			"COALESCE(users.totp_secret, '')",
+			"users.email_verified").
+		From("users").
+		Where("lower(users.email) = lower($1)", email).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UserByEmail - r.Builder")
+		return nil, err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&user.Id, &user.PublicId, &user.Username, &user.Role, &user.Email, &user.State, &user.TotpSecret, &user.EmailVerified)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// UserById returns the account of the id, without the password
+func (d *PgxAccess) UserById(ctx context.Context, userId int) (result *entity.Users, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.UserById"})
+	user := &entity.Users{}
+	query, args, err := d.Builder.
+		Select("users.id",
+			"users.public_id",
+			"users.username",
+			"users.role",
+			"COALESCE(users.email, '')",
+			"users.state").
+		From("users").
+		Where(squirrel.Eq{"users.id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UserById - r.Builder")
+		return nil, err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&user.Id, &user.PublicId, &user.Username, &user.Role, &user.Email, &user.State)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return user, nil
+}
+
 func (d *PgxAccess) Create(ctx context.Context, user *entity.Users) (err error) {
 	dbLog := log.WithFields(log.Fields{"func": "db.GetLoginUser"})
 	query, args, err := d.Builder.
@@ -62,8 +133,7 @@ func (d *PgxAccess) Create(ctx context.Context, user *entity.Users) (err error)
 			"update_ts",
 			"state",
 			"version",
-			"fullname",
-			"verification_code").
+			"fullname").
 		Values(user.PublicId,
 			user.Username,
 			user.Password,
@@ -78,14 +148,14 @@ func (d *PgxAccess) Create(ctx context.Context, user *entity.Users) (err error)
 			user.UpdateTs,
 			user.State,
 			user.Version,
-			user.FullName,
-			user.VerificationCode).
+			user.FullName).
+		Suffix("RETURNING \"id\"").
 		ToSql()
 	if err != nil {
 		dbLog.WithError(err).Errorf("SourceRepo - GetById - r.Builder")
 		return err
 	}
-	_, err = d.Pool.Exec(context.Background(), query, args...)
+	err = d.Pool.QueryRow(context.Background(), query, args...).Scan(&user.Id)
 
 	if err != nil {
 		dbLog.WithFields(log.Fields{"user_id": user.Username}).Warning(err)
@@ -111,7 +181,6 @@ func (d *PgxAccess) Update(ctx context.Context, user *entity.Users) error {
 			"user_role":         user.Role,
 			"region_id":         user.RegionId,
 			"parent":            user.Parent,
-			"verification_code": user.VerificationCode,
 			"updateTs":          user.UpdateTs,
 			"state":             user.State}).
 		Set("version", squirrel.Expr("version+1")).
@@ -132,3 +201,606 @@ func (d *PgxAccess) Update(ctx context.Context, user *entity.Users) error {
 	}
 	return nil
 }
+
+// UpdatePassword stores the new password hash of the user
+func (d *PgxAccess) UpdatePassword(ctx context.Context, userId int, password string) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdatePassword"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("password", password).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UpdatePassword - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateState sets the state of the user, like the activation of a registered one
+func (d *PgxAccess) UpdateState(ctx context.Context, userId int, state entity.State) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateState"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("state", state).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UpdateState - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// VerifyEmail marks the email of the user as proven by its owner
+func (d *PgxAccess) VerifyEmail(ctx context.Context, userId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.VerifyEmail"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("email_verified", true).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - VerifyEmail - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateRole assigns the role to the user
+func (d *PgxAccess) UpdateRole(ctx context.Context, userId int, role entity.UserRole) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateRole"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("role", string(role)).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UpdateRole - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// EnableTotp stores the totp secret of the user with the hashes of its recovery codes,
+// the previous recovery codes are replaced
+func (d *PgxAccess) EnableTotp(ctx context.Context, userId int, secret string, recoveryHashes []string) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.EnableTotp"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("totp_secret", secret).
+		Set("recovery_codes", recoveryHashes).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - EnableTotp - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// DisableTotp drops the totp secret and the recovery codes of the user
+func (d *PgxAccess) DisableTotp(ctx context.Context, userId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.DisableTotp"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("totp_secret", nil).
+		Set("recovery_codes", nil).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - DisableTotp - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// UseRecoveryCode removes the recovery code of the hash, it is false when the user has no such code.
+// The check and the removal are one statement so a code is used once
+func (d *PgxAccess) UseRecoveryCode(ctx context.Context, userId int, recoveryHash string) (bool, error) {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UseRecoveryCode"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("recovery_codes", squirrel.Expr("array_remove(recovery_codes, ?)", recoveryHash)).
+		Where(squirrel.Eq{"id": userId}).
+		Where(squirrel.Expr("? = ANY(recovery_codes)", recoveryHash)).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UseRecoveryCode - r.Builder - query")
+		return false, err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// UserByIdentity returns the user linked to the account of the provider, without the password
+func (d *PgxAccess) UserByIdentity(ctx context.Context, provider string, subject string) (result *entity.Users, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.UserByIdentity", "provider": provider})
+	user := &entity.Users{}
+	query, args, err := d.Builder.
+		Select("users.id",
+			"users.public_id",
+			"users.username",
+			"users.role",
+			"COALESCE(users.email, '')",
+			"users.state",
+			"COALESCE(users.totp_secret, '')").
+		From("user_identity").
+		Join("users ON users.id = user_identity.user_id").
+		Where(squirrel.Eq{"user_identity.provider": provider, "user_identity.subject": subject}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UserByIdentity - r.Builder")
+		return nil, err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&user.Id, &user.PublicId, &user.Username, &user.Role, &user.Email, &user.State, &user.TotpSecret)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateIdentity links the account of the provider to an existing user
+func (d *PgxAccess) CreateIdentity(ctx context.Context, identity *entity.UserIdentity) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateIdentity", "provider": identity.Provider})
+	query, args, err := d.Builder.
+		Insert("user_identity").
+		Columns("provider", "subject", "user_id", "email", "create_ts").
+		Values(identity.Provider, identity.Subject, identity.UserId, identity.Email, identity.CreateTs).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - CreateIdentity - r.Builder - query")
+		return err
+	}
+	if _, err = d.Pool.Exec(ctx, query, args...); err != nil {
+		dbLog.Warning(err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return errorStatus.ErrDuplicate
+		}
+		return err
+	}
+	return nil
+}
+
+// createWithIdentity inserts the user and its identity in one statement, the empty optional
+// columns are stored as nulls so they don't collide on their unique constraints. The email
+// is verified by the provider
+const createWithIdentity = `WITH new_user AS (
+	INSERT INTO users (public_id, username, password, email, phone_number, address, photo, role, region_id, parent, create_ts, update_ts, state, version, email_verified)
+	VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), NULLIF($6, ''), NULLIF($7, ''), $8, NULLIF($9, 0), $10, $11, $12, $13, $14, true)
+	RETURNING id
+)
+INSERT INTO user_identity (provider, subject, user_id, email, create_ts)
+SELECT $15, $16, new_user.id, $17, $11 FROM new_user
+RETURNING user_id`
+
+// CreateWithIdentity creates the user signed up by the provider, it is ErrDuplicate when the username is taken
+func (d *PgxAccess) CreateWithIdentity(ctx context.Context, user *entity.Users, identity *entity.UserIdentity) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateWithIdentity", "provider": identity.Provider})
+	err := d.Pool.QueryRow(ctx, createWithIdentity,
+		user.PublicId,
+		user.Username,
+		user.Password,
+		user.Email,
+		user.PhoneNumber,
+		user.Address,
+		user.Photo,
+		user.Role,
+		user.RegionId,
+		user.Parent,
+		user.CreateTs,
+		user.UpdateTs,
+		user.State,
+		user.Version,
+		identity.Provider,
+		identity.Subject,
+		identity.Email).Scan(&user.Id)
+	if err != nil {
+		dbLog.WithFields(log.Fields{"username": user.Username}).Warning(err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "users_username_key" {
+			return errorStatus.ErrDuplicate
+		}
+		return err
+	}
+	identity.UserId = user.Id
+	return nil
+}
+
+// UserProfile returns the profile of the user
+func (d *PgxAccess) UserProfile(ctx context.Context, userId int) (result *entity.UserProfile, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.UserProfile"})
+	profile := &entity.UserProfile{}
+	query, args, err := d.Builder.
+		Select("users.public_id",
+			"users.username",
+			"COALESCE(users.fullname, '')",
+			"COALESCE(users.email, '')",
+			"COALESCE(users.phone_number, '')",
+			"COALESCE(users.address, '')",
+			"COALESCE(users.photo, '')",
+			"COALESCE(users.region_id, 0)",
+			"users.role",
+			"users.totp_secret IS NOT NULL",
+			"users.create_ts").
+		From("users").
+		Where(squirrel.Eq{"users.id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UserProfile - r.Builder")
+		return nil, err
+	}
+	err = d.Pool.QueryRow(ctx, query, args...).Scan(&profile.PublicId, &profile.Username, &profile.FullName, &profile.Email,
+		&profile.PhoneNumber, &profile.Address, &profile.Photo, &profile.RegionId, &profile.Role, &profile.TotpEnabled, &profile.CreateTs)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return profile, nil
+}
+
+// UpdateProfile sets the fields of the update that are not nil, the empty phone number and address are
+// stored as nulls. It is ErrDuplicate when the phone number is of another user
+func (d *PgxAccess) UpdateProfile(ctx context.Context, userId int, update *entity.ProfileUpdate) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateProfile"})
+	builder := d.Builder.
+		Update("users").
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId})
+	if update.FullName != nil {
+		builder = builder.Set("fullname", *update.FullName)
+	}
+	if update.PhoneNumber != nil {
+		builder = builder.Set("phone_number", squirrel.Expr("NULLIF(?, '')", *update.PhoneNumber))
+	}
+	if update.Address != nil {
+		builder = builder.Set("address", squirrel.Expr("NULLIF(?, '')", *update.Address))
+	}
+	if update.RegionId != nil {
+		builder = builder.Set("region_id", *update.RegionId)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UpdateProfile - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return errorStatus.ErrDuplicate
+		}
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// UpdatePhoto stores the path of the uploaded photo of the user
+func (d *PgxAccess) UpdatePhoto(ctx context.Context, userId int, photo string) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdatePhoto"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("photo", photo).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UpdatePhoto - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateEmail stores the confirmed email of the user, it is ErrDuplicate when the email is of another user
+func (d *PgxAccess) UpdateEmail(ctx context.Context, userId int, email string) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.UpdateEmail"})
+	query, args, err := d.Builder.
+		Update("users").
+		Set("email", email).
+		Set("email_verified", true).
+		Set("update_ts", squirrel.Expr("now()")).
+		Set("version", squirrel.Expr("version+1")).
+		Where(squirrel.Eq{"id": userId}).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - UpdateEmail - r.Builder - query")
+		return err
+	}
+	tag, err := d.Pool.Exec(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return errorStatus.ErrDuplicate
+		}
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return errorStatus.ErrNotFound
+	}
+	return nil
+}
+
+// anonymizeUser are the statements of AnonymizeUser, in their order. The orders keep their items, totals
+// and the region, country, city and postcode of their taxes, the contact and the street are dropped
+var anonymizeUser = []string{
+	`UPDATE users SET username = 'deleted-' || id, fullname = NULL, password = '', email = NULL, email_verified = false, phone_number = NULL,
+	address = NULL, photo = NULL, totp_secret = NULL, recovery_codes = NULL, role = 'USER', state = 'deleted',
+	update_ts = now(), version = version + 1
+	WHERE id = $1`,
+	`DELETE FROM user_identity WHERE user_id = $1`,
+	`DELETE FROM address WHERE user_id = $1`,
+	`DELETE FROM cart WHERE user_id = $1`,
+	`DELETE FROM cart_reminder WHERE user_id = $1`,
+	`UPDATE orders SET email = NULL, phone = NULL, recipient = NULL, address = NULL, street = NULL,
+	latitude = NULL, longitude = NULL, comment = NULL, update_ts = now()
+	WHERE user_id = $1`,
+}
+
+// AnonymizeUser removes the personal data of the user in one transaction, the row is kept
+// for the orders that reference it and can't log in
+func (d *PgxAccess) AnonymizeUser(ctx context.Context, userId int) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.AnonymizeUser", "userId": userId})
+	err := d.Pool.BeginFunc(ctx, func(tx pgx.Tx) error {
+		for idx, statement := range anonymizeUser {
+			tag, err := tx.Exec(ctx, statement, userId)
+			if err != nil {
+				return err
+			}
+			if idx == 0 && tag.RowsAffected() == 0 {
+				return errorStatus.ErrNotFound
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+// likePattern matches the value anywhere, its wildcards are matched literally
+func likePattern(value string) string {
+	return "%" + strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value) + "%"
+}
+
+// SearchUsers returns the page of the users of the filter, the newest first, with the count of all of them
+func (d *PgxAccess) SearchUsers(ctx context.Context, filter *entity.UserFilter) (result *entity.ResultUserJson, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.SearchUsers"})
+	builder := d.Builder.
+		Select("users.id",
+			"users.public_id",
+			"users.username",
+			"COALESCE(users.fullname, '')",
+			"COALESCE(users.email, '')",
+			"COALESCE(users.phone_number, '')",
+			"users.role",
+			"users.state",
+			"users.totp_secret IS NOT NULL",
+			"users.create_ts",
+			"count(*) OVER() AS total_count").
+		From("users")
+	if filter.Email != "" {
+		builder = builder.Where("users.email ILIKE ?", likePattern(filter.Email))
+	}
+	if filter.PhoneNumber != "" {
+		builder = builder.Where("users.phone_number LIKE ?", likePattern(filter.PhoneNumber))
+	}
+	if filter.State != "" {
+		builder = builder.Where(squirrel.Eq{"users.state": filter.State})
+	}
+	if filter.Role != "" {
+		builder = builder.Where(squirrel.Eq{"users.role": filter.Role})
+	}
+	if !filter.CreatedFrom.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{"users.create_ts": filter.CreatedFrom})
+	}
+	if !filter.CreatedTo.IsZero() {
+		builder = builder.Where(squirrel.Lt{"users.create_ts": filter.CreatedTo})
+	}
+	query, args, err := builder.
+		OrderBy("users.create_ts DESC", "users.id DESC").
+		Limit(uint64(filter.Limit)).
+		Offset(uint64(filter.Offset)).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - SearchUsers - r.Builder")
+		return nil, err
+	}
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result = &entity.ResultUserJson{Users: []*entity.UserSummary{}}
+	for rows.Next() {
+		user := &entity.UserSummary{}
+		err = rows.Scan(&user.Id, &user.PublicId, &user.Username, &user.FullName, &user.Email, &user.PhoneNumber,
+			&user.Role, &user.State, &user.TotpEnabled, &user.CreateTs, &result.Total)
+		if err != nil {
+			dbLog.Warning(err)
+			return nil, err
+		}
+		result.Users = append(result.Users, user)
+	}
+	if err = rows.Err(); err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
+
+// userDetail counts the orders of the user that are not deleted, the cancelled and refunded ones are not spent
+const userDetail = `SELECT u.id, u.public_id, u.username, COALESCE(u.fullname, ''), COALESCE(u.email, ''),
+	COALESCE(u.phone_number, ''), u.role, u.state, u.totp_secret IS NOT NULL, u.create_ts,
+	COALESCE(u.address, ''), COALESCE(u.region_id, 0), u.update_ts,
+	ARRAY(SELECT i.provider FROM user_identity i WHERE i.user_id = u.id ORDER BY i.provider),
+	(SELECT count(*) FROM orders o WHERE o.user_id = u.id AND o.state != 'deleted'),
+	(SELECT COALESCE(sum(o.total), 0) FROM orders o
+		WHERE o.user_id = u.id AND o.state != 'deleted' AND o.status NOT IN ('cancelled', 'refunded'))
+FROM users u
+WHERE u.id = $1`
+
+// UserDetail returns the user with the count and the spend of its orders
+func (d *PgxAccess) UserDetail(ctx context.Context, userId int) (result *entity.UserDetail, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.UserDetail"})
+	user := &entity.UserDetail{}
+	err = d.Pool.QueryRow(ctx, userDetail, userId).Scan(&user.Id, &user.PublicId, &user.Username, &user.FullName, &user.Email,
+		&user.PhoneNumber, &user.Role, &user.State, &user.TotpEnabled, &user.CreateTs,
+		&user.Address, &user.RegionId, &user.UpdateTs, &user.Providers, &user.OrderCount, &user.LifetimeSpend)
+	if err == pgx.ErrNoRows {
+		return nil, errorStatus.ErrNotFound
+	}
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return user, nil
+}
+
+// CreateAudit stores the action of the staff on the user
+func (d *PgxAccess) CreateAudit(ctx context.Context, audit *entity.UserAudit) error {
+	dbLog := log.WithFields(log.Fields{"func": "pg.CreateAudit"})
+	query, args, err := d.Builder.
+		Insert("user_audit").
+		Columns("user_id", "actor_id", "action", "detail", "reason", "create_ts").
+		Values(audit.UserId, audit.ActorId, audit.Action, audit.Detail, audit.Reason, audit.CreateTs).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - CreateAudit - r.Builder - query")
+		return err
+	}
+	if err = d.Pool.QueryRow(ctx, query, args...).Scan(&audit.Id); err != nil {
+		dbLog.Warning(err)
+		return err
+	}
+	return nil
+}
+
+// AuditLog returns the actions of the staff on the user, the newest first
+func (d *PgxAccess) AuditLog(ctx context.Context, userId int, limit int, offset int) (result []*entity.UserAudit, err error) {
+	dbLog := log.WithFields(log.Fields{"func": "db.AuditLog"})
+	query, args, err := d.Builder.
+		Select("id", "user_id", "actor_id", "action", "COALESCE(detail, '')", "COALESCE(reason, '')", "create_ts").
+		From("user_audit").
+		Where(squirrel.Eq{"user_id": userId}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64(offset)).
+		ToSql()
+	if err != nil {
+		dbLog.WithError(err).Errorf("UserLogRepo - AuditLog - r.Builder")
+		return nil, err
+	}
+	rows, err := d.Pool.Query(ctx, query, args...)
+	if err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result = []*entity.UserAudit{}
+	for rows.Next() {
+		audit := &entity.UserAudit{}
+		if err = rows.Scan(&audit.Id, &audit.UserId, &audit.ActorId, &audit.Action, &audit.Detail, &audit.Reason, &audit.CreateTs); err != nil {
+			dbLog.Warning(err)
+			return nil, err
+		}
+		result = append(result, audit)
+	}
+	if err = rows.Err(); err != nil {
+		dbLog.Warning(err)
+		return nil, err
+	}
+	return result, nil
+}
@@ -58,63 +58,547 @@ func (a *authRedisRepo) SetUserCtx(ctx context.Context, username string, seconds
 	return nil
 }
 
-// Get token by UserId
-func (a *authRedisRepo) GetUserToken(ctx context.Context, userId int) (*entity.Signatures, error) {
-	redLog := log.WithFields(log.Fields{"func": "redis.GetUser"})
-	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.GetUserToken")
+func sessionKey(sessionId string) string {
+	return "session-" + sessionId
+}
+
+func userSessionsKey(userId int) string {
+	return fmt.Sprintf("sessions-%d", userId)
+}
+
+// the session is a hash, the last seen time is a field of its own so a request can touch it
+// without writing back the signatures a concurrent refresh has rotated
+const (
+	sessionField  = "session"
+	lastSeenField = "lastSeenTs"
+)
+
+// touchSessionScript extends a session that still exists, a revoked one isn't brought back
+var touchSessionScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	return 0
+end
+redis.call("HSET", KEYS[1], "lastSeenTs", ARGV[1])
+redis.call("PEXPIRE", KEYS[1], ARGV[2])
+redis.call("PEXPIRE", KEYS[2], ARGV[2])
+return 1
+`)
+
+// parseSession reads the fields of the session hash, it is nil when the session is gone
+func parseSession(values []interface{}) (*entity.Session, error) {
+	sessionStr, ok := values[0].(string)
+	if !ok {
+		return nil, nil
+	}
+	session := &entity.Session{}
+	if err := json.Unmarshal([]byte(sessionStr), session); err != nil {
+		return nil, err
+	}
+	if lastSeen, ok := values[1].(string); ok {
+		lastSeenTs, err := time.Parse(time.RFC3339Nano, lastSeen)
+		if err != nil {
+			return nil, err
+		}
+		session.LastSeenTs = lastSeenTs
+	}
+	return session, nil
+}
+
+// Get the session by its id
+func (a *authRedisRepo) GetSession(ctx context.Context, sessionId string) (*entity.Session, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.GetSession"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.GetSession")
+	defer span.Finish()
+
+	values, err := a.redisClient.HMGet(ctx, sessionKey(sessionId), sessionField, lastSeenField).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"sessionId": sessionId}).Warning(err)
+		return nil, err
+	}
+	session, err := parseSession(values)
+	if err != nil {
+		redLog.Warning(err)
+		return nil, err
+	}
+	if session == nil {
+		redLog.WithFields(log.Fields{"sessionId": sessionId}).Warning(redis.Nil)
+		return nil, redis.Nil
+	}
+	return session, nil
+}
+
+// Cache the session with its expire time, the session id is added to the sessions of the user
+// which live as long as the last touched session
+func (a *authRedisRepo) SetSession(ctx context.Context, session *entity.Session, timeExp time.Duration) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetSession"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetSession")
+	defer span.Finish()
+
+	sessionBytes, err := json.Marshal(session)
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": session.UserId}).Warning(err)
+		return err
+	}
+	_, err = a.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, sessionKey(session.Id), sessionField, sessionBytes, lastSeenField, session.LastSeenTs.Format(time.RFC3339Nano))
+		pipe.Expire(ctx, sessionKey(session.Id), timeExp)
+		pipe.SAdd(ctx, userSessionsKey(session.UserId), session.Id)
+		pipe.Expire(ctx, userSessionsKey(session.UserId), timeExp)
+		return nil
+	})
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": session.UserId}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// List the sessions of the user, the ids of the expired ones are dropped
+func (a *authRedisRepo) ListSessions(ctx context.Context, userId int) ([]*entity.Session, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.ListSessions"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.ListSessions")
+	defer span.Finish()
+
+	ids, err := a.redisClient.SMembers(ctx, userSessionsKey(userId)).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	cmds := make([]*redis.SliceCmd, len(ids))
+	_, err = a.redisClient.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for idx, id := range ids {
+			cmds[idx] = pipe.HMGet(ctx, sessionKey(id), sessionField, lastSeenField)
+		}
+		return nil
+	})
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return nil, err
+	}
+
+	sessions := make([]*entity.Session, 0, len(cmds))
+	var expired []interface{}
+	for idx, cmd := range cmds {
+		session, err := parseSession(cmd.Val())
+		if err != nil {
+			redLog.Warning(err)
+			return nil, err
+		}
+		if session == nil {
+			expired = append(expired, ids[idx])
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	if len(expired) > 0 {
+		if err = a.redisClient.SRem(ctx, userSessionsKey(userId), expired...).Err(); err != nil {
+			redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		}
+	}
+	return sessions, nil
+}
+
+// Set the last seen time of the session and extend it with the sessions of the user,
+// it is false when the session is gone. The signatures of the session are left as they are
+func (a *authRedisRepo) TouchSession(ctx context.Context, userId int, sessionId string, lastSeenTs time.Time, timeExp time.Duration) (bool, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.TouchSession"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.TouchSession")
+	defer span.Finish()
+
+	keys := []string{sessionKey(sessionId), userSessionsKey(userId)}
+	touched, err := touchSessionScript.Run(ctx, a.redisClient, keys, lastSeenTs.Format(time.RFC3339Nano), timeExp.Milliseconds()).Int()
+	if err != nil {
+		redLog.WithFields(log.Fields{"sessionId": sessionId}).Warning(err)
+		return false, err
+	}
+	return touched == 1, nil
+}
+
+// Delete the session of the user
+func (a *authRedisRepo) DeleteSession(ctx context.Context, userId int, sessionId string) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteSession"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteSession")
+	defer span.Finish()
+
+	_, err := a.redisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionKey(sessionId))
+		pipe.SRem(ctx, userSessionsKey(userId), sessionId)
+		return nil
+	})
+	if err != nil {
+		redLog.WithError(err).Warning()
+		return err
+	}
+	return nil
+}
+
+// Delete all the sessions of the user
+func (a *authRedisRepo) DeleteUserSessions(ctx context.Context, userId int) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteUserSessions"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteUserSessions")
+	defer span.Finish()
+
+	ids, err := a.redisClient.SMembers(ctx, userSessionsKey(userId)).Result()
+	if err != nil {
+		redLog.WithError(err).Warning()
+		return err
+	}
+	keys := []string{userSessionsKey(userId)}
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+	}
+	if err = a.redisClient.Del(ctx, keys...).Err(); err != nil {
+		redLog.WithError(err).Warning()
+		return err
+	}
+	return nil
+}
+
+// Mark the refresh token as used, it is false when the token was used before.
+// The mark lives as long as the token so a replayed one is still recognized
+func (a *authRedisRepo) UseRefreshToken(ctx context.Context, tokenId string, sessionId string, timeExp time.Duration) (bool, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.UseRefreshToken"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.UseRefreshToken")
+	defer span.Finish()
+
+	firstUse, err := a.redisClient.SetNX(ctx, "refresh-used-"+tokenId, sessionId, timeExp).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"sessionId": sessionId}).Warning(err)
+		return false, err
+	}
+	return firstUse, nil
+}
+
+// Cache the id of the password reset token, a new reset request replaces the previous token
+func (a *authRedisRepo) SetResetToken(ctx context.Context, userId int, tokenId string, timeExp time.Duration) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetResetToken"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetResetToken")
+	defer span.Finish()
+
+	if err := a.redisClient.Set(ctx, fmt.Sprintf("reset-%d", userId), tokenId, timeExp).Err(); err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// Take the id of the password reset token, it is deleted on read so the token is used once
+func (a *authRedisRepo) TakeResetToken(ctx context.Context, userId int) (string, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.TakeResetToken"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.TakeResetToken")
+	defer span.Finish()
+
+	tokenId, err := a.redisClient.GetDel(ctx, fmt.Sprintf("reset-%d", userId)).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return "", err
+	}
+	return tokenId, nil
+}
+
+// Count the calls of the key in the window, the window starts with the first call
+func (a *authRedisRepo) IncrRate(ctx context.Context, key string, window time.Duration) (int64, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.IncrRate"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.IncrRate")
+	defer span.Finish()
+
+	rateKey := "rate-" + key
+	count, err := a.redisClient.Incr(ctx, rateKey).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"key": key}).Warning(err)
+		return 0, err
+	}
+	if count == 1 {
+		if err = a.redisClient.Expire(ctx, rateKey, window).Err(); err != nil {
+			redLog.WithFields(log.Fields{"key": key}).Warning(err)
+			return 0, err
+		}
+	}
+	return count, nil
+}
+
+// Reset the count of the key, the next call starts a new window
+func (a *authRedisRepo) ResetRate(ctx context.Context, key string) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.ResetRate"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.ResetRate")
+	defer span.Finish()
+
+	if err := a.redisClient.Del(ctx, "rate-"+key).Err(); err != nil {
+		redLog.WithFields(log.Fields{"key": key}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// Set the lock of the key for the duration, a running lock is extended
+func (a *authRedisRepo) SetLock(ctx context.Context, key string, timeExp time.Duration) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetLock"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetLock")
 	defer span.Finish()
 
-	signBytes, err := a.redisClient.Get(ctx, fmt.Sprintf("token-%d", userId)).Result()
+	if err := a.redisClient.Set(ctx, "lock-"+key, time.Now().Add(timeExp).Unix(), timeExp).Err(); err != nil {
+		redLog.WithFields(log.Fields{"key": key}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// Get the time left of the lock of the key, it is zero when the key isn't locked
+func (a *authRedisRepo) LockTTL(ctx context.Context, key string) (time.Duration, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.LockTTL"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.LockTTL")
+	defer span.Finish()
+
+	ttl, err := a.redisClient.PTTL(ctx, "lock-"+key).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"key": key}).Warning(err)
+		return 0, err
+	}
+	// the negative ttls stand for the missing key and the key without expiry
+	if ttl < 0 {
+		return 0, nil
+	}
+	return ttl, nil
+}
+
+// Delete the lock of the key, it is true when the key was locked
+func (a *authRedisRepo) DeleteLock(ctx context.Context, key string) (bool, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteLock"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteLock")
+	defer span.Finish()
+
+	deleted, err := a.redisClient.Del(ctx, "lock-"+key).Result()
+	if err != nil {
+		redLog.WithFields(log.Fields{"key": key}).Warning(err)
+		return false, err
+	}
+	return deleted > 0, nil
+}
+
+// Cache the pending verification of the user until it expires
+func (a *authRedisRepo) SetVerification(ctx context.Context, userId int, verification *entity.Verification) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetVerification"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetVerification")
+	defer span.Finish()
+
+	verifyBytes, err := json.Marshal(verification)
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return err
+	}
+	if err = a.redisClient.Set(ctx, fmt.Sprintf("verify-%d", userId), verifyBytes, time.Until(verification.ExpireTs)).Err(); err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// Get the pending verification of the user
+func (a *authRedisRepo) GetVerification(ctx context.Context, userId int) (*entity.Verification, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.GetVerification"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.GetVerification")
+	defer span.Finish()
+
+	verifyBytes, err := a.redisClient.Get(ctx, fmt.Sprintf("verify-%d", userId)).Bytes()
 	if err != nil {
 		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
 		return nil, err
 	}
-	signs := &entity.Signatures{}
-	if err = json.Unmarshal([]byte(signBytes), signs); err != nil {
+	verification := &entity.Verification{}
+	if err = json.Unmarshal(verifyBytes, verification); err != nil {
+		redLog.Warning(err)
+		return nil, err
+	}
+	return verification, nil
+}
+
+// Delete the pending verification of the user
+func (a *authRedisRepo) DeleteVerification(ctx context.Context, userId int) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteVerification"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteVerification")
+	defer span.Finish()
+
+	if err := a.redisClient.Del(ctx, fmt.Sprintf("verify-%d", userId)).Err(); err != nil {
+		redLog.WithError(err).Warning()
+		return err
+	}
+	return nil
+}
+
+// Cache the login challenge until it expires
+func (a *authRedisRepo) SetMfaChallenge(ctx context.Context, challenge *entity.MfaChallenge) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetMfaChallenge"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetMfaChallenge")
+	defer span.Finish()
+
+	challengeBytes, err := json.Marshal(challenge)
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": challenge.UserId}).Warning(err)
+		return err
+	}
+	if err = a.redisClient.Set(ctx, "mfa-"+challenge.Id, challengeBytes, time.Until(challenge.ExpireTs)).Err(); err != nil {
+		redLog.WithFields(log.Fields{"userId": challenge.UserId}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// Get the login challenge by its id
+func (a *authRedisRepo) GetMfaChallenge(ctx context.Context, challengeId string) (*entity.MfaChallenge, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.GetMfaChallenge"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.GetMfaChallenge")
+	defer span.Finish()
+
+	challengeBytes, err := a.redisClient.Get(ctx, "mfa-"+challengeId).Bytes()
+	if err != nil {
+		redLog.WithFields(log.Fields{"challengeId": challengeId}).Warning(err)
+		return nil, err
+	}
+	challenge := &entity.MfaChallenge{}
+	if err = json.Unmarshal(challengeBytes, challenge); err != nil {
 		redLog.Warning(err)
 		return nil, err
 	}
-	return signs, nil
+	return challenge, nil
 }
 
-// Cache user token with duration in minutes
-func (a *authRedisRepo) SetUserToken(ctx context.Context, tokens *entity.Signatures, userId int, timeExp time.Duration) error {
-	redLog := log.WithFields(log.Fields{"func": "redis.SetUserToken"})
-	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetUserToken")
+// Delete the passed or failed login challenge
+func (a *authRedisRepo) DeleteMfaChallenge(ctx context.Context, challengeId string) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteMfaChallenge"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteMfaChallenge")
 	defer span.Finish()
 
-	tokenBytes, err := json.Marshal(tokens)
+	if err := a.redisClient.Del(ctx, "mfa-"+challengeId).Err(); err != nil {
+		redLog.WithError(err).Warning()
+		return err
+	}
+	return nil
+}
+
+// Cache the totp secret the user enrolls until it is confirmed by a code
+func (a *authRedisRepo) SetTotpPending(ctx context.Context, userId int, secret string, timeExp time.Duration) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetTotpPending"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetTotpPending")
+	defer span.Finish()
+
+	if err := a.redisClient.Set(ctx, fmt.Sprintf("totp-pending-%d", userId), secret, timeExp).Err(); err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return err
+	}
+	return nil
+}
+
+// Get the totp secret the user enrolls
+func (a *authRedisRepo) GetTotpPending(ctx context.Context, userId int) (string, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.GetTotpPending"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.GetTotpPending")
+	defer span.Finish()
+
+	secret, err := a.redisClient.Get(ctx, fmt.Sprintf("totp-pending-%d", userId)).Result()
 	if err != nil {
 		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return "", err
+	}
+	return secret, nil
+}
+
+// Delete the confirmed totp secret
+func (a *authRedisRepo) DeleteTotpPending(ctx context.Context, userId int) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteTotpPending"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteTotpPending")
+	defer span.Finish()
+
+	if err := a.redisClient.Del(ctx, fmt.Sprintf("totp-pending-%d", userId)).Err(); err != nil {
+		redLog.WithError(err).Warning()
 		return err
 	}
+	return nil
+}
 
-	if err = a.redisClient.Set(ctx, fmt.Sprintf("token-%d", userId), tokenBytes, timeExp).Err(); err != nil {
+// Cache the pending authorization of the OpenID provider until it expires
+func (a *authRedisRepo) SetOidcState(ctx context.Context, state string, oidcState *entity.OidcState) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetOidcState"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetOidcState")
+	defer span.Finish()
+
+	stateBytes, err := json.Marshal(oidcState)
+	if err != nil {
+		redLog.WithFields(log.Fields{"provider": oidcState.Provider}).Warning(err)
+		return err
+	}
+	if err = a.redisClient.Set(ctx, "oidc-"+state, stateBytes, time.Until(oidcState.ExpireTs)).Err(); err != nil {
+		redLog.WithFields(log.Fields{"provider": oidcState.Provider}).Warning(err)
 		return err
 	}
 	return nil
 }
 
-// Cache user token with duration in minutes
-func (a *authRedisRepo) ExpireUserToken(ctx context.Context, userId int, timeExp time.Duration) error {
-	redLog := log.WithFields(log.Fields{"func": "redis.SetUserToken"})
-	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetUserToken")
+// Get and delete the pending authorization, so a callback is completed once
+func (a *authRedisRepo) TakeOidcState(ctx context.Context, state string) (*entity.OidcState, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.TakeOidcState"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.TakeOidcState")
 	defer span.Finish()
 
-	if err := a.redisClient.Expire(ctx, fmt.Sprintf("token-%d", userId), timeExp).Err(); err != nil {
-		redLog.WithError(err)
+	stateBytes, err := a.redisClient.GetDel(ctx, "oidc-"+state).Bytes()
+	if err != nil {
+		redLog.Warning(err)
+		return nil, err
+	}
+	oidcState := &entity.OidcState{}
+	if err = json.Unmarshal(stateBytes, oidcState); err != nil {
+		redLog.Warning(err)
+		return nil, err
+	}
+	return oidcState, nil
+}
+
+// Cache the pending email change of the user until its code expires, replacing the previous one
+func (a *authRedisRepo) SetEmailChange(ctx context.Context, userId int, change *entity.EmailChange) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.SetEmailChange"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.SetEmailChange")
+	defer span.Finish()
+
+	changeBytes, err := json.Marshal(change)
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return err
+	}
+	if err = a.redisClient.Set(ctx, fmt.Sprintf("email-change-%d", userId), changeBytes, time.Until(change.ExpireTs)).Err(); err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
 		return err
 	}
 	return nil
 }
 
-// Delte cached user token
-func (a *authRedisRepo) DeleteUserToken(ctx context.Context, userId int) error {
-	redLog := log.WithFields(log.Fields{"func": "redis.DeleteUserToken"})
-	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteUserToken")
+// Get the pending email change of the user
+func (a *authRedisRepo) GetEmailChange(ctx context.Context, userId int) (*entity.EmailChange, error) {
+	redLog := log.WithFields(log.Fields{"func": "redis.GetEmailChange"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.GetEmailChange")
+	defer span.Finish()
+
+	changeBytes, err := a.redisClient.Get(ctx, fmt.Sprintf("email-change-%d", userId)).Bytes()
+	if err != nil {
+		redLog.WithFields(log.Fields{"userId": userId}).Warning(err)
+		return nil, err
+	}
+	change := &entity.EmailChange{}
+	if err = json.Unmarshal(changeBytes, change); err != nil {
+		redLog.Warning(err)
+		return nil, err
+	}
+	return change, nil
+}
+
+// Delete the confirmed or dropped email change
+func (a *authRedisRepo) DeleteEmailChange(ctx context.Context, userId int) error {
+	redLog := log.WithFields(log.Fields{"func": "redis.DeleteEmailChange"})
+	span, ctx := opentracing.StartSpanFromContext(ctx, "authRedisRepo.DeleteEmailChange")
 	defer span.Finish()
 
-	if err := a.redisClient.Del(ctx, fmt.Sprintf("token-%d", userId)).Err(); err != nil {
+	if err := a.redisClient.Del(ctx, fmt.Sprintf("email-change-%d", userId)).Err(); err != nil {
 		redLog.WithError(err).Warning()
 		return err
 	}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+var (
+	errOwnState       = errorStatus.New(errorStatus.CodeFailedPrecondition, "the own account can't be blocked")
+	errDeletedAccount = errorStatus.New(errorStatus.CodeFailedPrecondition, "the deleted account can't be changed")
+	errAccountBlocked = errorStatus.New(errorStatus.CodePermissionDenied, "the account is blocked, contact the support")
+)
+
+// SearchUsers returns the page of the users of the filter, the newest first
+func (a *UserUsecase) SearchUsers(ctx context.Context, staff *entity.Users, filter *entity.UserFilter) (result *entity.ResultUserJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.SearchUsers", "staffId": staff.Id})
+
+	result, err = a.authRepo.SearchUsers(ctx, filter)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.SearchUsers")
+		return nil, errorStatus.ErrInternalServer
+	}
+	return result, nil
+}
+
+// UserDetail returns the user with the count of its orders and its lifetime spend
+func (a *UserUsecase) UserDetail(ctx context.Context, staff *entity.Users, userId int) (detail *entity.UserDetail, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.UserDetail", "staffId": staff.Id, "userId": userId})
+
+	detail, err = a.authRepo.UserDetail(ctx, userId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserDetail")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return nil, errorStatus.ErrNotFound
+		}
+		return nil, errorStatus.ErrInternalServer
+	}
+	return detail, nil
+}
+
+// SetUserState blocks the user or enables it again, the blocked user is signed out of every device.
+// Enabling the account that waits for its verification verifies it
+func (a *UserUsecase) SetUserState(ctx context.Context, admin *entity.Users, userId int, state entity.State, reason string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.SetUserState", "adminId": admin.Id, "userId": userId})
+
+	if state != entity.Enabled && state != entity.Blocked {
+		return errorStatus.Violation("state", "must be enabled or blocked")
+	}
+	if admin.Id == userId {
+		return errOwnState
+	}
+
+	user, err := a.authRepo.UserById(ctx, userId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserById")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return errorStatus.ErrNotFound
+		}
+		return errorStatus.ErrInternalServer
+	}
+	// the personal data of the deleted account is gone, it isn't brought back
+	if user.State == entity.Deleted {
+		return errDeletedAccount
+	}
+	if user.State == state {
+		return nil
+	}
+
+	if err = a.authRepo.UpdateState(ctx, userId, state); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdateState")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return errorStatus.ErrNotFound
+		}
+		return errorStatus.ErrInternalServer
+	}
+	if state == entity.Blocked {
+		if err = a.redisRepo.DeleteUserSessions(ctx, userId); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.DeleteUserSessions")
+			return errorStatus.ErrInternalServer
+		}
+	}
+	a.audit(ctx, admin.Id, userId, entity.AuditStateChanged, string(state), reason)
+	return nil
+}
+
+// UserAuditLog returns the actions of the staff on the user, the newest first
+func (a *UserUsecase) UserAuditLog(ctx context.Context, staff *entity.Users, userId int, limit int, offset int) (audits []*entity.UserAudit, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.UserAuditLog", "staffId": staff.Id, "userId": userId})
+
+	audits, err = a.authRepo.AuditLog(ctx, userId, limit, offset)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.AuditLog")
+		return nil, errorStatus.ErrInternalServer
+	}
+	return audits, nil
+}
+
+// audit records the action of the staff on the user. The action is already done when it is recorded,
+// so a failed record is logged with all of its fields instead of failing the request
+func (a *UserUsecase) audit(ctx context.Context, actorId int, userId int, action string, detail string, reason string) {
+	ctLog := log.WithFields(log.Fields{
+		"func":    "UserUsecase.audit",
+		"event":   action,
+		"actorId": actorId,
+		"userId":  userId,
+		"detail":  detail,
+		"reason":  reason,
+	})
+
+	err := a.authRepo.CreateAudit(ctx, &entity.UserAudit{
+		UserId:   userId,
+		ActorId:  actorId,
+		Action:   action,
+		Detail:   detail,
+		Reason:   reason,
+		CreateTs: time.Now(),
+	})
+	if err != nil {
+		ctLog.WithError(err).Error("a.authRepo.CreateAudit")
+		return
+	}
+	ctLog.Info("staff action on the user")
+}
+
+// accountBlocked tells whether the user may not sign in
+func accountBlocked(user *entity.Users) bool {
+	return user.State == entity.Blocked || user.State == entity.Deleted
+}
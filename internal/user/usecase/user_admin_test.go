@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+func TestUserAdmin(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+	admin := &entity.Users{Id: 1, Role: entity.UserRoleAdmin}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+
+	t.Run("blocked user is signed out and audited", func(t *testing.T) {
+		pgMock.EXPECT().UserById(ctx, 7).Return(&entity.Users{Id: 7, Username: "john", State: entity.Enabled}, nil).Times(1)
+		pgMock.EXPECT().UpdateState(ctx, 7, entity.Blocked).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteUserSessions(ctx, 7).Return(nil).Times(1)
+		pgMock.EXPECT().CreateAudit(ctx, any).DoAndReturn(func(_ context.Context, audit *entity.UserAudit) error {
+			req.Equal(entity.AuditStateChanged, audit.Action)
+			req.Equal(string(entity.Blocked), audit.Detail)
+			req.Equal("chargeback fraud", audit.Reason)
+			req.Equal(1, audit.ActorId)
+			return nil
+		}).Times(1)
+
+		err := userUsc.SetUserState(ctx, admin, 7, entity.Blocked, "chargeback fraud")
+		req.NoError(err)
+	})
+
+	t.Run("blocked user can't sign in", func(t *testing.T) {
+		john := &entity.Users{Id: 7, Username: "john", Password: string(hash), Role: entity.UserRoleUser, State: entity.Blocked}
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+
+		_, err := userUsc.Login(ctx, "john", "qwerty1234", &entity.SessionClient{IP: "10.0.0.1"}, &entity.TokenConf{})
+		req.ErrorIs(err, errAccountBlocked)
+	})
+
+	t.Run("a failed audit doesn't fail the change", func(t *testing.T) {
+		pgMock.EXPECT().UserById(ctx, 7).Return(&entity.Users{Id: 7, State: entity.Blocked}, nil).Times(1)
+		pgMock.EXPECT().UpdateState(ctx, 7, entity.Enabled).Return(nil).Times(1)
+		pgMock.EXPECT().CreateAudit(ctx, any).Return(errorStatus.ErrInternalServer).Times(1)
+
+		err := userUsc.SetUserState(ctx, admin, 7, entity.Enabled, "")
+		req.NoError(err)
+	})
+
+	t.Run("deleted account stays deleted", func(t *testing.T) {
+		pgMock.EXPECT().UserById(ctx, 8).Return(&entity.Users{Id: 8, State: entity.Deleted}, nil).Times(1)
+
+		err := userUsc.SetUserState(ctx, admin, 8, entity.Enabled, "")
+		req.ErrorIs(err, errDeletedAccount)
+	})
+
+	t.Run("own account", func(t *testing.T) {
+		err := userUsc.SetUserState(ctx, admin, 1, entity.Blocked, "")
+		req.ErrorIs(err, errOwnState)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		pgMock.EXPECT().UserDetail(ctx, 9).Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		_, err := userUsc.UserDetail(ctx, admin, 9)
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
+}
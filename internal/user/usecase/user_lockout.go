@@ -0,0 +1,220 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+var (
+	errInvalidCredentials = errorStatus.New(errorStatus.CodeUnauthenticated, "invalid username or password")
+	errLoginBackoff       = errorStatus.New(errorStatus.CodeResourceExhausted, "too many failed logins, wait before the next attempt")
+	errLoginIPBlocked     = errorStatus.New(errorStatus.CodeResourceExhausted, "too many failed logins from the address")
+	errAccountLocked      = errorStatus.New(errorStatus.CodePermissionDenied, "the account is locked after too many failed logins")
+	errNotLocked          = errorStatus.New(errorStatus.CodeFailedPrecondition, "the account is not locked")
+)
+
+const (
+	lockSubject   = "Your account is locked"
+	unlockSubject = "Your account is unlocked"
+)
+
+// the lock keys of a username, the failures are counted under the same names
+func accountLockKey(username string) string {
+	return "login-account-" + username
+}
+
+func backoffLockKey(username string) string {
+	return "login-backoff-" + username
+}
+
+func ipLockKey(ip string) string {
+	return "login-ip-" + ip
+}
+
+// checkLogin refuses the attempt while the address is blocked, the account locked
+// or the backoff of the previous failure is running, before the password is compared
+func (a *UserUsecase) checkLogin(ctx context.Context, username string, ip string) error {
+	if ip != "" && a.lockoutConf.IPMaxAttempts > 0 {
+		if err := a.checkLock(ctx, ipLockKey(ip), errLoginIPBlocked); err != nil {
+			return err
+		}
+	}
+	if a.lockoutConf.MaxAttempts > 0 {
+		if err := a.checkLock(ctx, accountLockKey(username), errAccountLocked); err != nil {
+			return err
+		}
+	}
+	if a.lockoutConf.Backoff > 0 {
+		if err := a.checkLock(ctx, backoffLockKey(username), errLoginBackoff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *UserUsecase) checkLock(ctx context.Context, key string, lockErr *errorStatus.Error) error {
+	ttl, err := a.redisRepo.LockTTL(ctx, key)
+	if err != nil {
+		log.WithFields(log.Fields{"func": "UserUsecase.checkLock"}).WithError(err).Warning("a.redisRepo.LockTTL")
+		return errorStatus.ErrInternalServer
+	}
+	if ttl > 0 {
+		return lockErr.WithRetry(ttl)
+	}
+	return nil
+}
+
+// failedLogin counts the failure of the address and of the username, the user is nil for an unknown username
+// so the responses don't tell which accounts exist. It returns the error of the attempt
+func (a *UserUsecase) failedLogin(ctx context.Context, username string, ip string, user *entity.Users) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.failedLogin", "username": username, "ip": ip})
+	conf := a.lockoutConf
+
+	if ip != "" && conf.IPMaxAttempts > 0 {
+		count, err := a.redisRepo.IncrRate(ctx, ipLockKey(ip), conf.Window)
+		if err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+			return errorStatus.ErrInternalServer
+		}
+		if count >= int64(conf.IPMaxAttempts) {
+			if err = a.redisRepo.SetLock(ctx, ipLockKey(ip), conf.Window); err != nil {
+				ctLog.WithError(err).Warning("a.redisRepo.SetLock")
+				return errorStatus.ErrInternalServer
+			}
+			ctLog.WithFields(log.Fields{"event": "login_ip_blocked"}).Warning("address blocked")
+		}
+	}
+	if conf.MaxAttempts <= 0 && conf.Backoff <= 0 {
+		return errInvalidCredentials
+	}
+
+	count, err := a.redisRepo.IncrRate(ctx, accountLockKey(username), conf.Window)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+		return errorStatus.ErrInternalServer
+	}
+	if conf.MaxAttempts > 0 && count >= int64(conf.MaxAttempts) {
+		if err = a.redisRepo.SetLock(ctx, accountLockKey(username), conf.LockDuration); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.SetLock")
+			return errorStatus.ErrInternalServer
+		}
+		// the failures after the lock start over
+		if err = a.redisRepo.ResetRate(ctx, accountLockKey(username)); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.ResetRate")
+		}
+		ctLog.WithFields(log.Fields{"event": "account_locked"}).Warning("account locked")
+		a.sendLockEmail(ctx, user, true, time.Now().Add(conf.LockDuration))
+		return errAccountLocked.WithRetry(conf.LockDuration)
+	}
+	if conf.Backoff > 0 {
+		backoff := loginBackoff(conf, count)
+		if err = a.redisRepo.SetLock(ctx, backoffLockKey(username), backoff); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.SetLock")
+			return errorStatus.ErrInternalServer
+		}
+	}
+	return errInvalidCredentials
+}
+
+// loginBackoff is the wait after the count failures of the window, it doubles with every failure
+// up to the max backoff and stops before the duration overflows
+func loginBackoff(conf *entity.LockoutConf, count int64) time.Duration {
+	backoff := conf.Backoff
+	for failure := int64(1); failure < count; failure++ {
+		if conf.MaxBackoff > 0 && backoff >= conf.MaxBackoff || backoff > math.MaxInt64/2 {
+			break
+		}
+		backoff *= 2
+	}
+	if conf.MaxBackoff > 0 && backoff > conf.MaxBackoff {
+		return conf.MaxBackoff
+	}
+	return backoff
+}
+
+// clearLoginFailures forgets the failures of the username once its password and second factor are verified
+func (a *UserUsecase) clearLoginFailures(ctx context.Context, username string) {
+	if a.lockoutConf.MaxAttempts <= 0 && a.lockoutConf.Backoff <= 0 {
+		return
+	}
+	if err := a.redisRepo.ResetRate(ctx, accountLockKey(username)); err != nil {
+		log.WithFields(log.Fields{"func": "UserUsecase.clearLoginFailures"}).WithError(err).Warning("a.redisRepo.ResetRate")
+	}
+}
+
+// UnlockUser lifts the lockout and the backoff of the user before they expire
+func (a *UserUsecase) UnlockUser(ctx context.Context, staff *entity.Users, userId int) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.UnlockUser", "staffId": staff.Id, "userId": userId})
+
+	user, err := a.authRepo.UserById(ctx, userId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserById")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return errorStatus.ErrNotFound
+		}
+		return errorStatus.ErrInternalServer
+	}
+
+	locked, err := a.redisRepo.DeleteLock(ctx, accountLockKey(user.Username))
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteLock")
+		return errorStatus.ErrInternalServer
+	}
+	backoff, err := a.redisRepo.DeleteLock(ctx, backoffLockKey(user.Username))
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteLock")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.redisRepo.ResetRate(ctx, accountLockKey(user.Username)); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.ResetRate")
+		return errorStatus.ErrInternalServer
+	}
+	if !locked && !backoff {
+		return errNotLocked
+	}
+
+	a.audit(ctx, staff.Id, userId, entity.AuditUnlocked, "", "")
+	if locked {
+		a.sendLockEmail(ctx, user, false, time.Time{})
+	}
+	return nil
+}
+
+// sendLockEmail tells the user the account is locked until the time or is unlocked,
+// the email is skipped without the broker and a failed delivery is only logged
+func (a *UserUsecase) sendLockEmail(ctx context.Context, user *entity.Users, locked bool, until time.Time) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.sendLockEmail"})
+	if a.brokerRepo == nil || user == nil || user.Email == "" {
+		return
+	}
+
+	buff := new(bytes.Buffer)
+	err := a.lockTmpl.Execute(buff, struct {
+		Username string
+		Locked   bool
+		Until    string
+	}{
+		Username: user.Username,
+		Locked:   locked,
+		Until:    until.Format("Jan 2, 15:04 MST"),
+	})
+	if err != nil {
+		ctLog.WithError(err).Warning("a.lockTmpl.Execute")
+		return
+	}
+	subject := unlockSubject
+	if locked {
+		subject = lockSubject
+	}
+	if err = a.brokerRepo.SendEmail(ctx, user.Email, subject, buff.Bytes()); err != nil {
+		ctLog.WithError(err).Warning("a.brokerRepo.SendEmail")
+	}
+}
@@ -0,0 +1,146 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+func TestLoginLockout(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	conf := &entity.LockoutConf{
+		MaxAttempts:   3,
+		IPMaxAttempts: 10,
+		Window:        15 * time.Minute,
+		Backoff:       time.Second,
+		LockDuration:  15 * time.Minute,
+	}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, &entity.MfaConf{}, conf, &entity.OidcConf{})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+	john := &entity.Users{Id: 7, Username: "john", Email: "john@example.com", Password: string(hash), Role: entity.UserRoleUser}
+	client := &entity.SessionClient{Device: "laptop", IP: "10.0.0.1"}
+	tokenConf := &entity.TokenConf{}
+
+	unlocked := func() {
+		redisMock.EXPECT().LockTTL(ctx, "login-ip-10.0.0.1").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-backoff-john").Return(time.Duration(0), nil).Times(1)
+	}
+
+	t.Run("wrong password doubles the backoff", func(t *testing.T) {
+		unlocked()
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-ip-10.0.0.1", 15*time.Minute).Return(int64(2), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-account-john", 15*time.Minute).Return(int64(2), nil).Times(1)
+		redisMock.EXPECT().SetLock(ctx, "login-backoff-john", 2*time.Second).Return(nil).Times(1)
+
+		_, err := userUsc.Login(ctx, "john", "wrong", client, tokenConf)
+		req.ErrorIs(err, errInvalidCredentials)
+	})
+
+	t.Run("unknown username fails the same way", func(t *testing.T) {
+		redisMock.EXPECT().LockTTL(ctx, "login-ip-10.0.0.1").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-jane").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-backoff-jane").Return(time.Duration(0), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "jane").Return(nil, errorStatus.ErrNotFound).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-ip-10.0.0.1", 15*time.Minute).Return(int64(3), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-account-jane", 15*time.Minute).Return(int64(1), nil).Times(1)
+		redisMock.EXPECT().SetLock(ctx, "login-backoff-jane", time.Second).Return(nil).Times(1)
+
+		_, err := userUsc.Login(ctx, "jane", "qwerty1234", client, tokenConf)
+		req.ErrorIs(err, errInvalidCredentials)
+	})
+
+	t.Run("last attempt locks the account", func(t *testing.T) {
+		unlocked()
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-ip-10.0.0.1", 15*time.Minute).Return(int64(4), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-account-john", 15*time.Minute).Return(int64(3), nil).Times(1)
+		redisMock.EXPECT().SetLock(ctx, "login-account-john", 15*time.Minute).Return(nil).Times(1)
+		redisMock.EXPECT().ResetRate(ctx, "login-account-john").Return(nil).Times(1)
+		brokerMock.EXPECT().SendEmail(ctx, "john@example.com", lockSubject, any).Return(nil).Times(1)
+
+		_, err := userUsc.Login(ctx, "john", "wrong", client, tokenConf)
+		req.ErrorIs(err, errAccountLocked)
+		req.Equal(15*time.Minute, errorStatus.AsError(err).RetryAfter)
+	})
+
+	t.Run("locked account is refused before the password", func(t *testing.T) {
+		redisMock.EXPECT().LockTTL(ctx, "login-ip-10.0.0.1").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(10*time.Minute, nil).Times(1)
+
+		_, err := userUsc.Login(ctx, "john", "qwerty1234", client, tokenConf)
+		req.ErrorIs(err, errAccountLocked)
+	})
+
+	t.Run("blocked address", func(t *testing.T) {
+		redisMock.EXPECT().LockTTL(ctx, "login-ip-10.0.0.1").Return(5*time.Minute, nil).Times(1)
+
+		_, err := userUsc.Login(ctx, "john", "qwerty1234", client, tokenConf)
+		req.ErrorIs(err, errLoginIPBlocked)
+	})
+
+	t.Run("unlock emails the user", func(t *testing.T) {
+		staff := &entity.Users{Id: 2, Role: entity.UserRoleSupportAgent}
+		pgMock.EXPECT().UserById(ctx, 7).Return(john, nil).Times(2)
+		redisMock.EXPECT().DeleteLock(ctx, "login-account-john").Return(true, nil).Times(1)
+		redisMock.EXPECT().DeleteLock(ctx, "login-backoff-john").Return(false, nil).Times(1)
+		redisMock.EXPECT().ResetRate(ctx, "login-account-john").Return(nil).Times(2)
+		brokerMock.EXPECT().SendEmail(ctx, "john@example.com", unlockSubject, any).Return(nil).Times(1)
+		pgMock.EXPECT().CreateAudit(ctx, any).DoAndReturn(func(_ context.Context, audit *entity.UserAudit) error {
+			req.Equal(entity.AuditUnlocked, audit.Action)
+			req.Equal(2, audit.ActorId)
+			return nil
+		}).Times(1)
+
+		err := userUsc.UnlockUser(ctx, staff, 7)
+		req.NoError(err)
+
+		redisMock.EXPECT().DeleteLock(ctx, any).Return(false, nil).Times(2)
+		err = userUsc.UnlockUser(ctx, staff, 7)
+		req.ErrorIs(err, errNotLocked)
+	})
+}
+
+func TestLoginBackoff(t *testing.T) {
+	req := require.New(t)
+	conf := &entity.LockoutConf{Backoff: time.Second, MaxBackoff: time.Minute}
+
+	for _, tc := range []struct {
+		count   int64
+		backoff time.Duration
+	}{
+		{1, time.Second},
+		{2, 2 * time.Second},
+		{6, 32 * time.Second},
+		{7, time.Minute},
+		{64, time.Minute},
+		{1 << 40, time.Minute},
+	} {
+		req.Equal(tc.backoff, loginBackoff(conf, tc.count), tc.count)
+	}
+
+	t.Run("without the max the backoff doesn't overflow", func(t *testing.T) {
+		backoff := loginBackoff(&entity.LockoutConf{Backoff: time.Second}, 200)
+		req.Positive(backoff)
+	})
+}
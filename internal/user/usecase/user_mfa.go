@@ -0,0 +1,351 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	generator "go-store/utils/generator"
+	"go-store/utils/totp"
+)
+
+// totpSkew accepts the codes of the neighbour time steps for the drift of the phone clocks
+const totpSkew = 1
+
+var (
+	errMfaExpired     = errorStatus.New(errorStatus.CodeUnauthenticated, "two factor challenge expired, log in again")
+	errMfaAttempts    = errorStatus.New(errorStatus.CodeUnauthenticated, "too many attempts, log in again")
+	errMfaEnrollFirst = errorStatus.New(errorStatus.CodeFailedPrecondition, "enroll the authenticator app of the challenge first")
+	errMfaEnrolled    = errorStatus.New(errorStatus.CodeFailedPrecondition, "two factor authentication is already enabled")
+	errMfaNotEnrolled = errorStatus.New(errorStatus.CodeFailedPrecondition, "two factor authentication is not enabled")
+	errMfaEnforced    = errorStatus.New(errorStatus.CodePermissionDenied, "two factor authentication is required for the role")
+	errMfaPending     = errorStatus.New(errorStatus.CodeFailedPrecondition, "the enrollment expired, start it again")
+)
+
+// mfaRequired is true for the users with the totp and the users of the enforced roles
+func (a *UserUsecase) mfaRequired(user *entity.Users) bool {
+	return user.TotpSecret != "" || a.mfaEnforced(user.Role)
+}
+
+func (a *UserUsecase) mfaEnforced(role entity.UserRole) bool {
+	for _, enforced := range a.mfaConf.EnforcedRoles {
+		if enforced == role {
+			return true
+		}
+	}
+	return false
+}
+
+// startMfa answers the checked password with the challenge of the second step,
+// the user of an enforced role without the totp enrolls it within the challenge
+func (a *UserUsecase) startMfa(ctx context.Context, user *entity.Users, client *entity.SessionClient) (*entity.UserJson, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.startMfa"})
+
+	challenge := &entity.MfaChallenge{
+		Id:       uuid.New().String(),
+		UserId:   user.Id,
+		Username: user.Username,
+		Client:   *client,
+		Enroll:   user.TotpSecret == "",
+		ExpireTs: time.Now().Add(a.mfaConf.ChallengeTTL),
+	}
+	if err := a.redisRepo.SetMfaChallenge(ctx, challenge); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetMfaChallenge")
+		return nil, errorStatus.ErrInternalServer
+	}
+
+	return &entity.UserJson{
+		Id:       user.Id,
+		PublicId: user.PublicId,
+		Username: user.Username,
+		Role:     user.Role,
+		Challenge: &entity.MfaChallengeJson{
+			Id:       challenge.Id,
+			Enroll:   challenge.Enroll,
+			ExpireTs: challenge.ExpireTs,
+		},
+	}, nil
+}
+
+// EnrollMfaChallenge returns the totp secret the user of the enroll challenge adds to the app,
+// the code of the app then passes the challenge
+func (a *UserUsecase) EnrollMfaChallenge(ctx context.Context, challengeId string) (*entity.TotpEnrollment, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.EnrollMfaChallenge"})
+
+	challenge, err := a.redisRepo.GetMfaChallenge(ctx, challengeId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.GetMfaChallenge")
+		return nil, errMfaExpired
+	}
+	if !challenge.Enroll {
+		return nil, errMfaEnrolled
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		ctLog.WithError(err).Warning("totp.NewSecret")
+		return nil, errorStatus.ErrInternalServer
+	}
+	challenge.Secret = secret
+	if err = a.redisRepo.SetMfaChallenge(ctx, challenge); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetMfaChallenge")
+		return nil, errorStatus.ErrInternalServer
+	}
+	return a.enrollment(challenge.Username, secret), nil
+}
+
+// VerifyMfa passes the challenge with the totp or a recovery code and issues the tokens,
+// the challenge is dropped after the allowed number of failed codes. The failed codes count
+// as failed logins of the user, so new challenges don't give new attempts
+func (a *UserUsecase) VerifyMfa(ctx context.Context, challengeId string, code string, tokenConf *entity.TokenConf) (*entity.UserJson, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.VerifyMfa"})
+
+	challenge, err := a.redisRepo.GetMfaChallenge(ctx, challengeId)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.GetMfaChallenge")
+		return nil, errMfaExpired
+	}
+	ttl := time.Until(challenge.ExpireTs)
+	if ttl <= 0 {
+		return nil, errMfaExpired
+	}
+	if err = a.checkLogin(ctx, challenge.Username, challenge.Client.IP); err != nil {
+		return nil, err
+	}
+	attempts, err := a.redisRepo.IncrRate(ctx, "mfa-attempts-"+challenge.Id, ttl)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+		return nil, errorStatus.ErrInternalServer
+	}
+	if attempts > int64(a.mfaConf.MaxAttempts) {
+		if err = a.redisRepo.DeleteMfaChallenge(ctx, challenge.Id); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.DeleteMfaChallenge")
+		}
+		return nil, errMfaAttempts
+	}
+
+	user, err := a.authRepo.UserByUsername(ctx, challenge.Username)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
+		return nil, errMfaExpired
+	}
+
+	var recoveryCodes []string
+	if challenge.Enroll {
+		if challenge.Secret == "" {
+			return nil, errMfaEnrollFirst
+		}
+		if err = a.checkTotp(ctx, user.Id, challenge.Secret, code); err != nil {
+			return nil, a.failedMfa(ctx, challenge, user, err)
+		}
+		if recoveryCodes, err = a.enableTotp(ctx, user.Id, challenge.Secret); err != nil {
+			return nil, err
+		}
+	} else if err = a.checkSecondFactor(ctx, user, code); err != nil {
+		return nil, a.failedMfa(ctx, challenge, user, err)
+	}
+
+	if err = a.redisRepo.DeleteMfaChallenge(ctx, challenge.Id); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteMfaChallenge")
+		return nil, errorStatus.ErrInternalServer
+	}
+	a.clearLoginFailures(ctx, user.Username)
+	result, err := a.openSession(ctx, user, &challenge.Client, tokenConf)
+	if err != nil {
+		return nil, err
+	}
+	result.RecoveryCodes = recoveryCodes
+	return result, nil
+}
+
+// EnrollTotp starts the opt in of the signed in user, the secret is enabled by ConfirmTotp
+func (a *UserUsecase) EnrollTotp(ctx context.Context, user *entity.Users) (*entity.TotpEnrollment, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.EnrollTotp"})
+
+	userDb, err := a.authRepo.UserByUsername(ctx, user.Username)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
+		return nil, errorStatus.ErrNotFound
+	}
+	if userDb.TotpSecret != "" {
+		return nil, errMfaEnrolled
+	}
+
+	secret, err := totp.NewSecret()
+	if err != nil {
+		ctLog.WithError(err).Warning("totp.NewSecret")
+		return nil, errorStatus.ErrInternalServer
+	}
+	if err = a.redisRepo.SetTotpPending(ctx, userDb.Id, secret, a.mfaConf.ChallengeTTL); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetTotpPending")
+		return nil, errorStatus.ErrInternalServer
+	}
+	return a.enrollment(userDb.Username, secret), nil
+}
+
+// ConfirmTotp enables the enrolled secret with a code of the app, the recovery codes are
+// returned once and only their hashes are stored
+func (a *UserUsecase) ConfirmTotp(ctx context.Context, user *entity.Users, code string) ([]string, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ConfirmTotp"})
+
+	if err := a.checkUserAttempts(ctx, user.Id); err != nil {
+		return nil, err
+	}
+	secret, err := a.redisRepo.GetTotpPending(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.GetTotpPending")
+		return nil, errMfaPending
+	}
+	if err = a.checkTotp(ctx, user.Id, secret, code); err != nil {
+		return nil, err
+	}
+	recoveryCodes, err := a.enableTotp(ctx, user.Id, secret)
+	if err != nil {
+		return nil, err
+	}
+	if err = a.redisRepo.DeleteTotpPending(ctx, user.Id); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteTotpPending")
+	}
+	return recoveryCodes, nil
+}
+
+// DisableTotp turns the second factor off with a current code, the users of the enforced
+// roles keep it
+func (a *UserUsecase) DisableTotp(ctx context.Context, user *entity.Users, code string) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.DisableTotp"})
+
+	if a.mfaEnforced(user.Role) {
+		return errMfaEnforced
+	}
+	if err := a.checkUserAttempts(ctx, user.Id); err != nil {
+		return err
+	}
+	userDb, err := a.authRepo.UserByUsername(ctx, user.Username)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
+		return errorStatus.ErrNotFound
+	}
+	if userDb.TotpSecret == "" {
+		return errMfaNotEnrolled
+	}
+	if err = a.checkSecondFactor(ctx, userDb, code); err != nil {
+		return err
+	}
+	if err = a.authRepo.DisableTotp(ctx, userDb.Id); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.DisableTotp")
+		return errorStatus.ErrInternalServer
+	}
+	return nil
+}
+
+func (a *UserUsecase) enrollment(username string, secret string) *entity.TotpEnrollment {
+	return &entity.TotpEnrollment{
+		Secret: secret,
+		URI:    totp.URI(a.mfaConf.Issuer, username, secret),
+	}
+}
+
+// checkSecondFactor accepts the totp of the user or one of its recovery codes
+func (a *UserUsecase) checkSecondFactor(ctx context.Context, user *entity.Users, code string) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.checkSecondFactor"})
+
+	if len(code) == totp.Digits {
+		return a.checkTotp(ctx, user.Id, user.TotpSecret, code)
+	}
+	used, err := a.authRepo.UseRecoveryCode(ctx, user.Id, recoveryHash(code))
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UseRecoveryCode")
+		return errorStatus.ErrInternalServer
+	}
+	if !used {
+		return errorStatus.Violation("code", "is invalid")
+	}
+	ctLog.WithFields(log.Fields{"userId": user.Id}).Info("recovery code used")
+	return nil
+}
+
+// checkTotp validates the code, a code is accepted once so an observed one can't be replayed
+func (a *UserUsecase) checkTotp(ctx context.Context, userId int, secret string, code string) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.checkTotp"})
+
+	counter, ok := totp.Validate(secret, code, time.Now(), totpSkew)
+	if !ok {
+		return errorStatus.Violation("code", "is invalid")
+	}
+	window := time.Duration(2*totpSkew+1) * totp.Period * time.Second
+	uses, err := a.redisRepo.IncrRate(ctx, fmt.Sprintf("totp-used-%d-%d", userId, counter), window)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+		return errorStatus.ErrInternalServer
+	}
+	if uses > 1 {
+		return errorStatus.Violation("code", "is already used, wait for the next one")
+	}
+	return nil
+}
+
+// failedMfa counts the rejected code of the challenge as a failed login of the user, the challenge
+// is dropped once the account is locked. It returns the error of the attempt
+func (a *UserUsecase) failedMfa(ctx context.Context, challenge *entity.MfaChallenge, user *entity.Users, codeErr error) error {
+	if !errors.Is(codeErr, errorStatus.ErrBadReq) {
+		return codeErr
+	}
+	err := a.failedLogin(ctx, challenge.Username, challenge.Client.IP, user)
+	if errors.Is(err, errInvalidCredentials) {
+		return codeErr
+	}
+	if errors.Is(err, errAccountLocked) {
+		if err := a.redisRepo.DeleteMfaChallenge(ctx, challenge.Id); err != nil {
+			log.WithFields(log.Fields{"func": "UserUsecase.failedMfa"}).WithError(err).Warning("a.redisRepo.DeleteMfaChallenge")
+		}
+	}
+	return err
+}
+
+// checkUserAttempts limits the codes a signed in user can try within the challenge ttl
+func (a *UserUsecase) checkUserAttempts(ctx context.Context, userId int) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.checkUserAttempts"})
+
+	attempts, err := a.redisRepo.IncrRate(ctx, fmt.Sprintf("mfa-attempts-user-%d", userId), a.mfaConf.ChallengeTTL)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+		return errorStatus.ErrInternalServer
+	}
+	if attempts > int64(a.mfaConf.MaxAttempts) {
+		return errorStatus.ErrTooManyRequests.WithRetry(a.mfaConf.ChallengeTTL)
+	}
+	return nil
+}
+
+// enableTotp stores the secret with new recovery codes, the codes are returned in plain text once
+func (a *UserUsecase) enableTotp(ctx context.Context, userId int, secret string) ([]string, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.enableTotp"})
+
+	recoveryCodes := make([]string, a.mfaConf.RecoveryCodes)
+	hashes := make([]string, len(recoveryCodes))
+	for idx := range recoveryCodes {
+		recoveryCodes[idx] = generator.RandReadable(5) + "-" + generator.RandReadable(5)
+		hashes[idx] = recoveryHash(recoveryCodes[idx])
+	}
+	if err := a.authRepo.EnableTotp(ctx, userId, secret, hashes); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.EnableTotp")
+		return nil, errorStatus.ErrInternalServer
+	}
+	return recoveryCodes, nil
+}
+
+// recoveryHash is a plain sha256, the random codes are long enough to not need a slow hash
+// and the stored hash can be looked up
+func recoveryHash(code string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(strings.TrimSpace(code))))
+	return hex.EncodeToString(sum[:])
+}
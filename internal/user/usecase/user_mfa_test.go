@@ -0,0 +1,242 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+	"go-store/utils/jwt"
+	"go-store/utils/totp"
+)
+
+func TestMfa(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	accessKey, err := jwt.GenerateKey("2024-01")
+	req.NoError(err)
+	accessKeys, err := jwt.NewKeySet(accessKey)
+	req.NoError(err)
+	tokenConf := &entity.TokenConf{
+		AccesTokenTimeout:   15 * time.Minute,
+		RefreshTokenTimeout: time.Hour,
+		AutoLogoffTimeout:   30 * time.Minute,
+		AccessKeys:          accessKeys,
+		RefreshKeys:         jwt.NewHMACKeySet([]byte("refresh-secret")),
+	}
+	conf := &entity.MfaConf{
+		Issuer:        "go-store",
+		EnforcedRoles: []entity.UserRole{entity.UserRoleAdmin},
+		ChallengeTTL:  5 * time.Minute,
+		MaxAttempts:   3,
+		RecoveryCodes: 4,
+	}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, conf, &entity.LockoutConf{}, &entity.OidcConf{})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+	client := &entity.SessionClient{Device: "laptop", IP: "10.0.0.1"}
+
+	t.Run("enforced role enrolls at login", func(t *testing.T) {
+		admin := &entity.Users{Id: 1, Username: "admin", Password: string(hash), Role: entity.UserRoleAdmin}
+		pgMock.EXPECT().UserByUsername(ctx, "admin").Return(admin, nil).Times(2)
+		var challenge *entity.MfaChallenge
+		redisMock.EXPECT().SetMfaChallenge(ctx, any).DoAndReturn(func(_ context.Context, c *entity.MfaChallenge) error {
+			challenge = c
+			return nil
+		}).Times(2)
+
+		result, err := userUsc.Login(ctx, "admin", "qwerty1234", client, tokenConf)
+		req.NoError(err)
+		req.Empty(result.Tokens.AccessToken)
+		req.True(result.Challenge.Enroll)
+
+		redisMock.EXPECT().GetMfaChallenge(ctx, challenge.Id).DoAndReturn(func(_ context.Context, _ string) (*entity.MfaChallenge, error) {
+			return challenge, nil
+		}).Times(2)
+		enrollment, err := userUsc.EnrollMfaChallenge(ctx, result.Challenge.Id)
+		req.NoError(err)
+		req.Equal(challenge.Secret, enrollment.Secret)
+		req.Contains(enrollment.URI, "otpauth://totp/go-store:admin?")
+
+		code, err := totp.Code(enrollment.Secret, totp.Counter(time.Now()))
+		req.NoError(err)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-"+challenge.Id, any).Return(int64(1), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, any, 90*time.Second).Return(int64(1), nil).Times(1)
+		var hashes []string
+		pgMock.EXPECT().EnableTotp(ctx, 1, enrollment.Secret, any).DoAndReturn(func(_ context.Context, _ int, _ string, h []string) error {
+			hashes = h
+			return nil
+		}).Times(1)
+		redisMock.EXPECT().DeleteMfaChallenge(ctx, challenge.Id).Return(nil).Times(1)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).Return(nil).Times(1)
+
+		result, err = userUsc.VerifyMfa(ctx, challenge.Id, code, tokenConf)
+		req.NoError(err)
+		req.NotEmpty(result.Tokens.AccessToken)
+		req.Len(result.RecoveryCodes, 4)
+		req.Equal(recoveryHash(result.RecoveryCodes[0]), hashes[0])
+	})
+
+	secret, err := totp.NewSecret()
+	req.NoError(err)
+	john := &entity.Users{Id: 7, Username: "john", Password: string(hash), Role: entity.UserRoleUser, TotpSecret: secret}
+	challenge := &entity.MfaChallenge{Id: "c7", UserId: 7, Username: "john", Client: *client, ExpireTs: time.Now().Add(5 * time.Minute)}
+
+	t.Run("login asks the totp", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		redisMock.EXPECT().SetMfaChallenge(ctx, any).Return(nil).Times(1)
+
+		result, err := userUsc.Login(ctx, "john", "qwerty1234", client, tokenConf)
+		req.NoError(err)
+		req.Empty(result.Tokens.AccessToken)
+		req.False(result.Challenge.Enroll)
+	})
+
+	t.Run("replayed code", func(t *testing.T) {
+		code, err := totp.Code(secret, totp.Counter(time.Now()))
+		req.NoError(err)
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c7").Return(challenge, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-c7", any).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, any, 90*time.Second).Return(int64(2), nil).Times(1)
+
+		_, err = userUsc.VerifyMfa(ctx, "c7", code, tokenConf)
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+
+	t.Run("recovery code", func(t *testing.T) {
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c7").Return(challenge, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-c7", any).Return(int64(2), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UseRecoveryCode(ctx, 7, recoveryHash("abcde-fghjk")).Return(true, nil).Times(1)
+		redisMock.EXPECT().DeleteMfaChallenge(ctx, "c7").Return(nil).Times(1)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).Return(nil).Times(1)
+
+		result, err := userUsc.VerifyMfa(ctx, "c7", " ABCDE-FGHJK", tokenConf)
+		req.NoError(err)
+		req.NotEmpty(result.Tokens.AccessToken)
+	})
+
+	t.Run("too many attempts", func(t *testing.T) {
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c7").Return(challenge, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-c7", any).Return(int64(4), nil).Times(1)
+		redisMock.EXPECT().DeleteMfaChallenge(ctx, "c7").Return(nil).Times(1)
+
+		_, err := userUsc.VerifyMfa(ctx, "c7", "123456", tokenConf)
+		req.ErrorIs(err, errMfaAttempts)
+	})
+
+	t.Run("enforced role can't disable", func(t *testing.T) {
+		err := userUsc.DisableTotp(ctx, &entity.Users{Id: 1, Username: "admin", Role: entity.UserRoleAdmin}, "123456")
+		req.ErrorIs(err, errMfaEnforced)
+	})
+}
+
+func TestMfaLockout(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	tokenConf := &entity.TokenConf{
+		AccesTokenTimeout:   15 * time.Minute,
+		RefreshTokenTimeout: time.Hour,
+		AutoLogoffTimeout:   30 * time.Minute,
+		AccessKeys:          jwt.NewHMACKeySet([]byte("access-secret")),
+		RefreshKeys:         jwt.NewHMACKeySet([]byte("refresh-secret")),
+	}
+	mfaConf := &entity.MfaConf{ChallengeTTL: 5 * time.Minute, MaxAttempts: 5}
+	lockoutConf := &entity.LockoutConf{MaxAttempts: 3, Window: 15 * time.Minute, LockDuration: 15 * time.Minute}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, mfaConf, lockoutConf, &entity.OidcConf{})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+	secret, err := totp.NewSecret()
+	req.NoError(err)
+	john := &entity.Users{Id: 7, Username: "john", Email: "john@example.com", Password: string(hash), Role: entity.UserRoleUser, TotpSecret: secret}
+	client := &entity.SessionClient{Device: "laptop", IP: "10.0.0.1"}
+	challenge := func(id string) *entity.MfaChallenge {
+		return &entity.MfaChallenge{Id: id, UserId: 7, Username: "john", Client: *client, ExpireTs: time.Now().Add(5 * time.Minute)}
+	}
+
+	t.Run("right password keeps the failures until the second factor", func(t *testing.T) {
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(time.Duration(0), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		redisMock.EXPECT().SetMfaChallenge(ctx, any).Return(nil).Times(1)
+
+		result, err := userUsc.Login(ctx, "john", "qwerty1234", client, tokenConf)
+		req.NoError(err)
+		req.NotNil(result.Challenge)
+	})
+
+	t.Run("failed code counts for the user", func(t *testing.T) {
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c1").Return(challenge("c1"), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-c1", any).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UseRecoveryCode(ctx, 7, recoveryHash("abcde-fghjk")).Return(false, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-account-john", 15*time.Minute).Return(int64(2), nil).Times(1)
+
+		_, err := userUsc.VerifyMfa(ctx, "c1", "abcde-fghjk", tokenConf)
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+
+	t.Run("failures of new challenges lock the account", func(t *testing.T) {
+		// the first failed code of a new challenge
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c2").Return(challenge("c2"), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-c2", any).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UseRecoveryCode(ctx, 7, recoveryHash("abcde-fghjk")).Return(false, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "login-account-john", 15*time.Minute).Return(int64(3), nil).Times(1)
+		redisMock.EXPECT().SetLock(ctx, "login-account-john", 15*time.Minute).Return(nil).Times(1)
+		redisMock.EXPECT().ResetRate(ctx, "login-account-john").Return(nil).Times(1)
+		brokerMock.EXPECT().SendEmail(ctx, "john@example.com", lockSubject, any).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteMfaChallenge(ctx, "c2").Return(nil).Times(1)
+
+		_, err := userUsc.VerifyMfa(ctx, "c2", "abcde-fghjk", tokenConf)
+		req.ErrorIs(err, errAccountLocked)
+
+		// the other open challenges are refused before the code is compared
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c1").Return(challenge("c1"), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(10*time.Minute, nil).Times(1)
+
+		_, err = userUsc.VerifyMfa(ctx, "c1", "abcde-fghjk", tokenConf)
+		req.ErrorIs(err, errAccountLocked)
+	})
+
+	t.Run("passed second factor clears the failures", func(t *testing.T) {
+		redisMock.EXPECT().GetMfaChallenge(ctx, "c3").Return(challenge("c3"), nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "login-account-john").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "mfa-attempts-c3", any).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UseRecoveryCode(ctx, 7, recoveryHash("abcde-fghjk")).Return(true, nil).Times(1)
+		redisMock.EXPECT().DeleteMfaChallenge(ctx, "c3").Return(nil).Times(1)
+		redisMock.EXPECT().ResetRate(ctx, "login-account-john").Return(nil).Times(1)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).Return(nil).Times(1)
+
+		result, err := userUsc.VerifyMfa(ctx, "c3", "abcde-fghjk", tokenConf)
+		req.NoError(err)
+		req.NotEmpty(result.Tokens.AccessToken)
+	})
+}
@@ -0,0 +1,286 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	"go-store/utils/oidc"
+)
+
+var (
+	errOidcProvider = errorStatus.New(errorStatus.CodeNotFound, "unknown login provider")
+	errOidcState    = errorStatus.New(errorStatus.CodeUnauthenticated, "the login with the provider expired, start it again")
+	errOidcLogin    = errorStatus.New(errorStatus.CodeUnauthenticated, "the provider didn't confirm the login")
+	errOidcEmail    = errorStatus.New(errorStatus.CodeFailedPrecondition, "the provider has no verified email of the account")
+	errOidcLink     = errorStatus.New(errorStatus.CodeFailedPrecondition, "the email of the account is not verified, log in with the password or reset it to verify the email first")
+)
+
+const (
+	// the users column is 20 characters, the suffix of a taken name is 5 of them
+	oidcUsernameLength = 15
+	oidcUsernameTries  = 5
+)
+
+// OidcAuthURL starts the authorization code flow of the provider, the state keeps the nonce
+// and the PKCE verifier until the callback
+func (a *UserUsecase) OidcAuthURL(ctx context.Context, providerName string) (authURL string, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.OidcAuthURL", "provider": providerName})
+
+	provider, ok := a.oidcConf.Providers[providerName]
+	if !ok {
+		return "", errOidcProvider
+	}
+	state, err := oidc.NewState()
+	if err != nil {
+		ctLog.WithError(err).Warning("oidc.NewState")
+		return "", errorStatus.ErrInternalServer
+	}
+	nonce, err := oidc.NewState()
+	if err != nil {
+		ctLog.WithError(err).Warning("oidc.NewState")
+		return "", errorStatus.ErrInternalServer
+	}
+	verifier, err := oidc.NewVerifier()
+	if err != nil {
+		ctLog.WithError(err).Warning("oidc.NewVerifier")
+		return "", errorStatus.ErrInternalServer
+	}
+
+	authURL, err = provider.AuthCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		ctLog.WithError(err).Warning("provider.AuthCodeURL")
+		return "", errorStatus.ErrUnavailable
+	}
+	err = a.redisRepo.SetOidcState(ctx, state, &entity.OidcState{
+		Provider: providerName,
+		Nonce:    nonce,
+		Verifier: verifier,
+		ExpireTs: time.Now().Add(a.oidcConf.StateTTL),
+	})
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetOidcState")
+		return "", errorStatus.ErrInternalServer
+	}
+	return authURL, nil
+}
+
+// OidcLogin completes the authorization of the callback. The account of the provider signs in the user
+// it is linked to, else it is linked to the user of its verified email or signs a new user up.
+// The tokens are issued as by Login, after the second factor when it is required
+func (a *UserUsecase) OidcLogin(ctx context.Context, providerName string, state string, code string, client *entity.SessionClient, tokenConf *entity.TokenConf) (result *entity.UserJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.OidcLogin", "provider": providerName})
+
+	provider, ok := a.oidcConf.Providers[providerName]
+	if !ok {
+		return nil, errOidcProvider
+	}
+	oidcState, err := a.redisRepo.TakeOidcState(ctx, state)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.TakeOidcState")
+		return nil, errOidcState
+	}
+	// the state of one provider doesn't complete the login of another
+	if oidcState.Provider != providerName || time.Now().After(oidcState.ExpireTs) {
+		return nil, errOidcState
+	}
+
+	rawToken, err := provider.Exchange(ctx, code, oidcState.Verifier)
+	if err != nil {
+		ctLog.WithError(err).Warning("provider.Exchange")
+		return nil, errOidcLogin
+	}
+	idToken, err := provider.VerifyIDToken(ctx, rawToken, oidcState.Nonce)
+	if err != nil {
+		ctLog.WithError(err).Warning("provider.VerifyIDToken")
+		return nil, errOidcLogin
+	}
+
+	userDb, err := a.oidcUser(ctx, providerName, idToken)
+	if err != nil {
+		return nil, err
+	}
+	ctLog.WithFields(log.Fields{"event": "oidc_login", "userId": userDb.Id}).Info("signed in by the provider")
+	if a.mfaRequired(userDb) {
+		return a.startMfa(ctx, userDb, client)
+	}
+	return a.openSession(ctx, userDb, client, tokenConf)
+}
+
+// oidcUser returns the user of the id token, linking or creating it on the first login
+func (a *UserUsecase) oidcUser(ctx context.Context, providerName string, idToken *oidc.IDToken) (*entity.Users, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.oidcUser", "provider": providerName})
+
+	userDb, err := a.authRepo.UserByIdentity(ctx, providerName, idToken.Subject)
+	if err == nil {
+		if accountBlocked(userDb) {
+			return nil, errAccountBlocked
+		}
+		return userDb, nil
+	}
+	if !errors.Is(err, errorStatus.ErrNotFound) {
+		ctLog.WithError(err).Warning("a.authRepo.UserByIdentity")
+		return nil, errorStatus.ErrInternalServer
+	}
+	// an unverified email could be anyone's, it neither links nor signs up
+	if idToken.Email == "" || !idToken.EmailVerified {
+		return nil, errOidcEmail
+	}
+
+	identity := &entity.UserIdentity{
+		Provider: providerName,
+		Subject:  idToken.Subject,
+		Email:    idToken.Email,
+		CreateTs: time.Now(),
+	}
+	userDb, err = a.authRepo.UserByEmail(ctx, idToken.Email)
+	if errors.Is(err, errorStatus.ErrNotFound) {
+		return a.oidcSignUp(ctx, idToken, identity)
+	}
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByEmail")
+		return nil, errorStatus.ErrInternalServer
+	}
+	if accountBlocked(userDb) {
+		return nil, errAccountBlocked
+	}
+
+	// the account that never verified its email may be registered by someone else with it,
+	// the provider proved the owner so the password of the registration is replaced.
+	// An account enabled without proving its email, by the sms code, isn't linked
+	switch {
+	case userDb.State == entity.Disabled:
+		if err = a.claimAccount(ctx, userDb); err != nil {
+			return nil, err
+		}
+	case !userDb.EmailVerified:
+		ctLog.WithFields(log.Fields{"userId": userDb.Id}).Warning("the email of the account is not verified")
+		return nil, errOidcLink
+	}
+	identity.UserId = userDb.Id
+	if err = a.authRepo.CreateIdentity(ctx, identity); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.CreateIdentity")
+		return nil, errorStatus.ErrInternalServer
+	}
+	ctLog.WithFields(log.Fields{"event": "oidc_linked", "userId": userDb.Id}).Info("identity linked by the email")
+	return userDb, nil
+}
+
+// claimAccount enables the unverified account of the email with a random password, the email is verified by the provider
+func (a *UserUsecase) claimAccount(ctx context.Context, userDb *entity.Users) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.claimAccount", "userId": userDb.Id})
+
+	hash, err := randomPassword()
+	if err != nil {
+		ctLog.WithError(err).Warning("randomPassword")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.authRepo.UpdatePassword(ctx, userDb.Id, hash); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdatePassword")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.authRepo.UpdateState(ctx, userDb.Id, entity.Enabled); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdateState")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.authRepo.VerifyEmail(ctx, userDb.Id); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.VerifyEmail")
+		return errorStatus.ErrInternalServer
+	}
+	userDb.State = entity.Enabled
+	userDb.EmailVerified = true
+	return nil
+}
+
+// oidcSignUp creates the user of the id token, a taken username gets a random suffix.
+// The user has a random password until it resets one
+func (a *UserUsecase) oidcSignUp(ctx context.Context, idToken *oidc.IDToken, identity *entity.UserIdentity) (*entity.Users, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.oidcSignUp", "provider": identity.Provider})
+
+	hash, err := randomPassword()
+	if err != nil {
+		ctLog.WithError(err).Warning("randomPassword")
+		return nil, errorStatus.ErrInternalServer
+	}
+	now := time.Now()
+	user := &entity.Users{
+		PublicId: uuid.New(),
+		FullName: idToken.Name,
+		Password: hash,
+		Email:    idToken.Email,
+		Role:     entity.UserRoleUser,
+		State:    entity.Enabled,
+		CreateTs: now,
+		UpdateTs: now,
+	}
+	base := oidcUsername(idToken)
+	for try := 0; try < oidcUsernameTries; try++ {
+		user.Username = base
+		if try > 0 {
+			suffix, err := rand.Int(rand.Reader, big.NewInt(10000))
+			if err != nil {
+				ctLog.WithError(err).Warning("rand.Int")
+				return nil, errorStatus.ErrInternalServer
+			}
+			user.Username = fmt.Sprintf("%s-%04d", base, suffix.Int64())
+		}
+		err = a.authRepo.CreateWithIdentity(ctx, user, identity)
+		if errors.Is(err, errorStatus.ErrDuplicate) {
+			continue
+		}
+		if err != nil {
+			ctLog.WithError(err).Warning("a.authRepo.CreateWithIdentity")
+			return nil, errorStatus.ErrInternalServer
+		}
+		ctLog.WithFields(log.Fields{"event": "oidc_signup", "userId": user.Id}).Info("user signed up by the provider")
+		return user, nil
+	}
+	ctLog.WithFields(log.Fields{"username": base}).Warning("no free username")
+	return nil, errorStatus.ErrInternalServer
+}
+
+// oidcUsername derives the username of a new user from the preferred username of the provider
+// or the local part of the email
+func oidcUsername(idToken *oidc.IDToken) string {
+	name := idToken.PreferredUsername
+	if name == "" {
+		name, _, _ = strings.Cut(idToken.Email, "@")
+	}
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '.' || r == '_' || r == '-' {
+			b.WriteRune(r)
+		}
+		if b.Len() == oidcUsernameLength {
+			break
+		}
+	}
+	if b.Len() < 3 {
+		return "user"
+	}
+	return b.String()
+}
+
+// randomPassword returns the hash of a password nobody knows
+func randomPassword() (string, error) {
+	password := make([]byte, 32)
+	if _, err := rand.Read(password); err != nil {
+		return "", err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(base64.RawStdEncoding.EncodeToString(password)), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
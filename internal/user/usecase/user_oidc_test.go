@@ -0,0 +1,164 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+	"go-store/utils/jwt"
+	"go-store/utils/oidc"
+	"go-store/utils/oidc/oidctest"
+)
+
+func TestOidcLogin(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	idp, err := oidctest.NewServer("go-store", "client-secret")
+	req.NoError(err)
+	defer idp.Close()
+	conf := &entity.OidcConf{
+		Providers: map[string]*oidc.Provider{"fake": idp.Provider("fake", "http://localhost:8080/user/oidc/fake/callback")},
+		StateTTL:  10 * time.Minute,
+	}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, &entity.MfaConf{}, &entity.LockoutConf{}, conf)
+
+	accessKey, err := jwt.GenerateKey("2024-01")
+	req.NoError(err)
+	accessKeys, err := jwt.NewKeySet(accessKey)
+	req.NoError(err)
+	tokenConf := &entity.TokenConf{
+		AccesTokenTimeout:   15 * time.Minute,
+		RefreshTokenTimeout: time.Hour,
+		AutoLogoffTimeout:   30 * time.Minute,
+		AccessKeys:          accessKeys,
+		RefreshKeys:         jwt.NewHMACKeySet([]byte("refresh-secret")),
+	}
+	client := &entity.SessionClient{Device: "fake", IP: "10.0.0.1"}
+
+	// authorize runs the redirect to the provider and back, it returns the state and the code of the callback
+	authorize := func(user oidctest.User) (*entity.OidcState, string, string) {
+		var oidcState *entity.OidcState
+		redisMock.EXPECT().SetOidcState(ctx, any, any).DoAndReturn(func(_ context.Context, _ string, s *entity.OidcState) error {
+			oidcState = s
+			return nil
+		}).Times(1)
+		authURL, err := userUsc.OidcAuthURL(ctx, "fake")
+		req.NoError(err)
+		req.Contains(authURL, "code_challenge_method=S256")
+
+		code, state, err := idp.Authorize(authURL, user)
+		req.NoError(err)
+		return oidcState, state, code
+	}
+
+	t.Run("signs up a new user", func(t *testing.T) {
+		oidcState, state, code := authorize(oidctest.User{Subject: "s-1", Email: "Jane@example.com", EmailVerified: true, Username: "Jane Doe"})
+		redisMock.EXPECT().TakeOidcState(ctx, state).Return(oidcState, nil).Times(1)
+		pgMock.EXPECT().UserByIdentity(ctx, "fake", "s-1").Return(nil, errorStatus.ErrNotFound).Times(1)
+		pgMock.EXPECT().UserByEmail(ctx, "Jane@example.com").Return(nil, errorStatus.ErrNotFound).Times(1)
+		var usernames []string
+		pgMock.EXPECT().CreateWithIdentity(ctx, any, any).DoAndReturn(func(_ context.Context, user *entity.Users, identity *entity.UserIdentity) error {
+			usernames = append(usernames, user.Username)
+			req.Equal(entity.UserRoleUser, user.Role)
+			req.Equal(entity.Enabled, user.State)
+			req.Equal("s-1", identity.Subject)
+			if len(usernames) == 1 {
+				return errorStatus.ErrDuplicate
+			}
+			user.Id = 9
+			return nil
+		}).Times(2)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).Return(nil).Times(1)
+
+		result, err := userUsc.OidcLogin(ctx, "fake", state, code, client, tokenConf)
+		req.NoError(err)
+		req.NotEmpty(result.Tokens.AccessToken)
+		req.Equal("janedoe", usernames[0])
+		req.Regexp(`^janedoe-\d{4}$`, usernames[1])
+	})
+
+	t.Run("links the unverified account of the email", func(t *testing.T) {
+		oidcState, state, code := authorize(oidctest.User{Subject: "s-2", Email: "john@example.com", EmailVerified: true})
+		redisMock.EXPECT().TakeOidcState(ctx, state).Return(oidcState, nil).Times(1)
+		pgMock.EXPECT().UserByIdentity(ctx, "fake", "s-2").Return(nil, errorStatus.ErrNotFound).Times(1)
+		john := &entity.Users{Id: 7, Username: "john", Email: "john@example.com", Role: entity.UserRoleUser, State: entity.Disabled}
+		pgMock.EXPECT().UserByEmail(ctx, "john@example.com").Return(john, nil).Times(1)
+		pgMock.EXPECT().UpdatePassword(ctx, 7, any).Return(nil).Times(1)
+		pgMock.EXPECT().UpdateState(ctx, 7, entity.Enabled).Return(nil).Times(1)
+		pgMock.EXPECT().VerifyEmail(ctx, 7).Return(nil).Times(1)
+		pgMock.EXPECT().CreateIdentity(ctx, any).DoAndReturn(func(_ context.Context, identity *entity.UserIdentity) error {
+			req.Equal(7, identity.UserId)
+			return nil
+		}).Times(1)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).Return(nil).Times(1)
+
+		result, err := userUsc.OidcLogin(ctx, "fake", state, code, client, tokenConf)
+		req.NoError(err)
+		req.Equal("john", result.Username)
+	})
+
+	t.Run("links the account of the verified email", func(t *testing.T) {
+		oidcState, state, code := authorize(oidctest.User{Subject: "s-4", Email: "ann@example.com", EmailVerified: true})
+		redisMock.EXPECT().TakeOidcState(ctx, state).Return(oidcState, nil).Times(1)
+		pgMock.EXPECT().UserByIdentity(ctx, "fake", "s-4").Return(nil, errorStatus.ErrNotFound).Times(1)
+		ann := &entity.Users{Id: 8, Username: "ann", Email: "ann@example.com", Role: entity.UserRoleUser, State: entity.Enabled, EmailVerified: true}
+		pgMock.EXPECT().UserByEmail(ctx, "ann@example.com").Return(ann, nil).Times(1)
+		pgMock.EXPECT().CreateIdentity(ctx, any).Return(nil).Times(1)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).Return(nil).Times(1)
+
+		result, err := userUsc.OidcLogin(ctx, "fake", state, code, client, tokenConf)
+		req.NoError(err)
+		req.Equal("ann", result.Username)
+	})
+
+	t.Run("account enabled by sms isn't linked", func(t *testing.T) {
+		oidcState, state, code := authorize(oidctest.User{Subject: "s-5", Email: "bob@example.com", EmailVerified: true})
+		redisMock.EXPECT().TakeOidcState(ctx, state).Return(oidcState, nil).Times(1)
+		pgMock.EXPECT().UserByIdentity(ctx, "fake", "s-5").Return(nil, errorStatus.ErrNotFound).Times(1)
+		// registered with the email of another and verified by the phone of the registrant
+		bob := &entity.Users{Id: 10, Username: "mallory", Email: "bob@example.com", Role: entity.UserRoleUser, State: entity.Enabled}
+		pgMock.EXPECT().UserByEmail(ctx, "bob@example.com").Return(bob, nil).Times(1)
+
+		_, err := userUsc.OidcLogin(ctx, "fake", state, code, client, tokenConf)
+		req.ErrorIs(err, errOidcLink)
+	})
+
+	t.Run("unverified email", func(t *testing.T) {
+		oidcState, state, code := authorize(oidctest.User{Subject: "s-3", Email: "eve@example.com"})
+		redisMock.EXPECT().TakeOidcState(ctx, state).Return(oidcState, nil).Times(1)
+		pgMock.EXPECT().UserByIdentity(ctx, "fake", "s-3").Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		_, err := userUsc.OidcLogin(ctx, "fake", state, code, client, tokenConf)
+		req.ErrorIs(err, errOidcEmail)
+	})
+
+	t.Run("verifier of another authorization", func(t *testing.T) {
+		oidcState, state, code := authorize(oidctest.User{Subject: "s-1", Email: "jane@example.com", EmailVerified: true})
+		oidcState.Verifier = "not-the-verifier-of-the-challenge-0123456789"
+		redisMock.EXPECT().TakeOidcState(ctx, state).Return(oidcState, nil).Times(1)
+
+		_, err := userUsc.OidcLogin(ctx, "fake", state, code, client, tokenConf)
+		req.ErrorIs(err, errOidcLogin)
+	})
+
+	t.Run("used state", func(t *testing.T) {
+		redisMock.EXPECT().TakeOidcState(ctx, "used").Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		_, err := userUsc.OidcLogin(ctx, "fake", "used", "code", client, tokenConf)
+		req.ErrorIs(err, errOidcState)
+	})
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	"go-store/utils/jwt"
+)
+
+const resetSubject = "Reset your password"
+
+// RequestPasswordReset emails a single use reset link to the account of the email.
+// The account is looked up and the email sent after the call returns, an unknown email
+// succeeds in the same time, so the response doesn't tell which accounts exist
+func (a *UserUsecase) RequestPasswordReset(ctx context.Context, email string, ip string) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.RequestPasswordReset"})
+
+	if a.brokerRepo == nil {
+		ctLog.Warning("broker is not configured, the reset emails are not sent")
+		return errorStatus.ErrUnavailable
+	}
+	email = strings.ToLower(strings.TrimSpace(email))
+	if ip != "" {
+		if err := a.checkRate(ctx, "reset-ip-"+ip, a.resetConf.IPLimit, a.resetConf.RateWindow); err != nil {
+			return err
+		}
+	}
+	if err := a.checkRate(ctx, "reset-account-"+email, a.resetConf.AccountLimit, a.resetConf.RateWindow); err != nil {
+		return err
+	}
+
+	// the job outlives the request, its context keeps the values of the request only
+	jobCtx := context.WithoutCancel(ctx)
+	a.jobs.Add(1)
+	go func() {
+		defer a.jobs.Done()
+		a.sendPasswordReset(jobCtx, email)
+	}()
+	return nil
+}
+
+// sendPasswordReset emails the reset link to the account of the email. The link of an unknown
+// email is made and discarded, so the load of the instance doesn't tell the accounts either
+func (a *UserUsecase) sendPasswordReset(ctx context.Context, email string) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.sendPasswordReset"})
+
+	user, err := a.authRepo.UserByEmail(ctx, email)
+	known := err == nil
+	if errors.Is(err, errorStatus.ErrNotFound) {
+		ctLog.Info("reset of an unknown email")
+		user = &entity.Users{Email: email}
+	} else if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByEmail")
+		return
+	}
+
+	tokenId := uuid.New().String()
+	expire := time.Now().Add(a.resetConf.TokenTimeout)
+	token, err := a.resetKeys.Sign(&jwt.RegisteredClaims{
+		ID:        tokenId,
+		Subject:   strconv.Itoa(user.Id),
+		ExpiresAt: jwt.NewNumericDate(expire),
+	})
+	if err != nil {
+		ctLog.WithError(err).Warning("a.resetKeys.Sign")
+		return
+	}
+
+	buff := new(bytes.Buffer)
+	err = a.resetTmpl.Execute(buff, struct {
+		Username  string
+		ResetLink string
+		Expire    string
+	}{
+		Username:  user.Username,
+		ResetLink: a.resetConf.ResetURL + "?token=" + url.QueryEscape(token),
+		Expire:    expire.Format("Jan 2, 15:04 MST"),
+	})
+	if err != nil {
+		ctLog.WithError(err).Warning("a.resetTmpl.Execute")
+		return
+	}
+	if !known {
+		return
+	}
+
+	// the cached id makes the token single use, a new request replaces the previous token
+	if err = a.redisRepo.SetResetToken(ctx, user.Id, tokenId, a.resetConf.TokenTimeout); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetResetToken")
+		return
+	}
+	if err = a.brokerRepo.SendEmail(ctx, user.Email, resetSubject, buff.Bytes()); err != nil {
+		ctLog.WithError(err).Warning("a.brokerRepo.SendEmail")
+	}
+}
+
+// ConfirmPasswordReset sets the new password of the reset token account,
+// the token is used up and the sessions of the account are revoked
+func (a *UserUsecase) ConfirmPasswordReset(ctx context.Context, token string, password string) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ConfirmPasswordReset"})
+
+	claims := &jwt.RegisteredClaims{}
+	err := a.resetKeys.Parse(token, claims)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.resetKeys.Parse")
+		return errorStatus.ErrToken
+	}
+	userId, err := strconv.Atoi(claims.Subject)
+	if err != nil {
+		ctLog.WithError(err).Warning("strconv.Atoi")
+		return errorStatus.ErrToken
+	}
+
+	tokenId, err := a.redisRepo.TakeResetToken(ctx, userId)
+	if err != nil || tokenId != claims.ID {
+		ctLog.WithError(err).Warning("reset token is used or replaced")
+		return errorStatus.ErrToken
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		ctLog.WithError(err).Warning("bcrypt.GenerateFromPassword")
+		return errorStatus.Violation("password", "can't be used as a password")
+	}
+	if err = a.authRepo.UpdatePassword(ctx, userId, string(hash)); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdatePassword")
+		return errorStatus.ErrInternalServer
+	}
+	// the link was sent to the email of the account
+	if err = a.authRepo.VerifyEmail(ctx, userId); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.VerifyEmail")
+	}
+
+	if err = a.redisRepo.DeleteUserSessions(ctx, userId); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteUserSessions")
+		return errorStatus.ErrInternalServer
+	}
+	return nil
+}
+
+// checkRate counts the call of the key, the calls over the limit within the window are rejected
+func (a *UserUsecase) checkRate(ctx context.Context, key string, limit int, window time.Duration) error {
+	if limit <= 0 {
+		return nil
+	}
+	count, err := a.redisRepo.IncrRate(ctx, key, window)
+	if err != nil {
+		log.WithFields(log.Fields{"func": "UserUsecase.checkRate"}).WithError(err).Warning("a.redisRepo.IncrRate")
+		return errorStatus.ErrInternalServer
+	}
+	if count > int64(limit) {
+		return errorStatus.ErrTooManyRequests.WithRetry(window)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+var resetLinkRe = regexp.MustCompile(`\?token=([^"&]+)`)
+
+func TestPasswordReset(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	conf := &entity.PasswordResetConf{
+		Secret:       []byte("reset-secret"),
+		TokenTimeout: 30 * time.Minute,
+		ResetURL:     "https://shop.test/password/reset",
+		RateWindow:   time.Hour,
+		AccountLimit: 3,
+		IPLimit:      10,
+	}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, conf, &entity.VerificationConf{}, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+	jobs := &userUsc.(*UserUsecase).jobs
+
+	t.Run("reset and confirm", func(t *testing.T) {
+		redisMock.EXPECT().IncrRate(ctx, "reset-ip-10.0.0.1", time.Hour).Return(int64(1), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "reset-account-john@example.com", time.Hour).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UserByEmail(any, "john@example.com").
+			Return(&entity.Users{Id: 7, Username: "john", Email: "john@example.com"}, nil).Times(1)
+		var tokenId string
+		redisMock.EXPECT().SetResetToken(any, 7, any, 30*time.Minute).DoAndReturn(func(_ context.Context, _ int, id string, _ time.Duration) error {
+			tokenId = id
+			return nil
+		}).Times(1)
+		var email []byte
+		brokerMock.EXPECT().SendEmail(any, "john@example.com", resetSubject, any).DoAndReturn(func(_ context.Context, _ string, _ string, message []byte) error {
+			email = message
+			return nil
+		}).Times(1)
+
+		err := userUsc.RequestPasswordReset(ctx, " John@Example.com", "10.0.0.1")
+		req.NoError(err)
+		jobs.Wait()
+		req.Contains(string(email), "https://shop.test/password/reset?token=")
+		match := resetLinkRe.FindSubmatch(email)
+		req.NotNil(match)
+		token := string(match[1])
+
+		redisMock.EXPECT().TakeResetToken(ctx, 7).Return(tokenId, nil).Times(1)
+		var hash string
+		pgMock.EXPECT().UpdatePassword(ctx, 7, any).DoAndReturn(func(_ context.Context, _ int, password string) error {
+			hash = password
+			return nil
+		}).Times(1)
+		pgMock.EXPECT().VerifyEmail(ctx, 7).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteUserSessions(ctx, 7).Return(nil).Times(1)
+
+		err = userUsc.ConfirmPasswordReset(ctx, token, "new-password")
+		req.NoError(err)
+		req.NoError(bcrypt.CompareHashAndPassword([]byte(hash), []byte("new-password")))
+
+		// the token is single use
+		redisMock.EXPECT().TakeResetToken(ctx, 7).Return("", errors.New("redis: nil")).Times(1)
+		err = userUsc.ConfirmPasswordReset(ctx, token, "other-password")
+		req.ErrorIs(err, errorStatus.ErrToken)
+	})
+
+	t.Run("returns before the delivery", func(t *testing.T) {
+		reqCtx, cancel := context.WithCancel(ctx)
+		redisMock.EXPECT().IncrRate(reqCtx, any, time.Hour).Return(int64(1), nil).Times(2)
+		pgMock.EXPECT().UserByEmail(any, "john@example.com").Return(&entity.Users{Id: 7, Email: "john@example.com"}, nil).Times(1)
+		redisMock.EXPECT().SetResetToken(any, 7, any, 30*time.Minute).Return(nil).Times(1)
+		delivered := make(chan struct{})
+		brokerMock.EXPECT().SendEmail(any, "john@example.com", resetSubject, any).DoAndReturn(func(context.Context, string, string, []byte) error {
+			<-delivered
+			return nil
+		}).Times(1)
+
+		err := userUsc.RequestPasswordReset(reqCtx, "john@example.com", "10.0.0.1")
+		cancel()
+		req.NoError(err)
+		close(delivered)
+		jobs.Wait()
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		redisMock.EXPECT().IncrRate(ctx, any, time.Hour).Return(int64(1), nil).Times(2)
+		pgMock.EXPECT().UserByEmail(any, "nobody@example.com").Return(nil, errorStatus.ErrNotFound).Times(1)
+
+		err := userUsc.RequestPasswordReset(ctx, "nobody@example.com", "10.0.0.1")
+		req.NoError(err)
+		jobs.Wait()
+	})
+
+	t.Run("failed lookup is not returned", func(t *testing.T) {
+		redisMock.EXPECT().IncrRate(ctx, any, time.Hour).Return(int64(1), nil).Times(2)
+		pgMock.EXPECT().UserByEmail(any, "john@example.com").Return(nil, errors.New("conn closed")).Times(1)
+
+		err := userUsc.RequestPasswordReset(ctx, "john@example.com", "10.0.0.1")
+		req.NoError(err)
+		jobs.Wait()
+	})
+
+	t.Run("account rate limit", func(t *testing.T) {
+		redisMock.EXPECT().IncrRate(ctx, "reset-ip-10.0.0.2", time.Hour).Return(int64(1), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "reset-account-john@example.com", time.Hour).Return(int64(4), nil).Times(1)
+
+		err := userUsc.RequestPasswordReset(ctx, "john@example.com", "10.0.0.2")
+		req.ErrorIs(err, errorStatus.ErrTooManyRequests)
+		retryAfter, ok := errorStatus.AsError(err).RetryAfterHeader()
+		req.True(ok)
+		req.Equal("3600", retryAfter)
+	})
+
+	t.Run("forged token", func(t *testing.T) {
+		err := userUsc.ConfirmPasswordReset(ctx, "not-a-token", "new-password")
+		req.ErrorIs(err, errorStatus.ErrToken)
+	})
+}
@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	generator "go-store/utils/generator"
+)
+
+const emailChangeSubject = "Confirm your new email"
+
+var (
+	errWrongPassword = errorStatus.Violation("password", "doesn't match")
+	errSamePassword  = errorStatus.Violation("newPassword", "must differ from the current password")
+	errNoEmailChange = errorStatus.New(errorStatus.CodeFailedPrecondition, "no email change is pending, request a new code")
+)
+
+// GetProfile returns the profile of the signed in user with the email waiting for its code
+func (a *UserUsecase) GetProfile(ctx context.Context, user *entity.Users) (profile *entity.UserProfile, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.GetProfile", "userId": user.Id})
+
+	profile, err = a.authRepo.UserProfile(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserProfile")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return nil, errorStatus.ErrNotFound
+		}
+		return nil, errorStatus.ErrInternalServer
+	}
+	// the missing change is the usual case, not an error
+	if change, err := a.redisRepo.GetEmailChange(ctx, user.Id); err == nil && time.Now().Before(change.ExpireTs) {
+		profile.PendingEmail = change.Email
+	}
+	return profile, nil
+}
+
+// UpdateProfile changes the contact fields of the profile, the email and the password
+// have their own confirmations
+func (a *UserUsecase) UpdateProfile(ctx context.Context, user *entity.Users, update *entity.ProfileUpdate) (profile *entity.UserProfile, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.UpdateProfile", "userId": user.Id})
+
+	if update.FullName == nil && update.PhoneNumber == nil && update.Address == nil && update.RegionId == nil {
+		return a.GetProfile(ctx, user)
+	}
+	if err = a.authRepo.UpdateProfile(ctx, user.Id, update); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdateProfile")
+		if errors.Is(err, errorStatus.ErrDuplicate) {
+			return nil, errorStatus.Violation("phoneNumber", "is used by another account")
+		}
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return nil, errorStatus.ErrNotFound
+		}
+		return nil, errorStatus.ErrInternalServer
+	}
+	return a.GetProfile(ctx, user)
+}
+
+// UpdatePhoto stores the path of the uploaded photo
+func (a *UserUsecase) UpdatePhoto(ctx context.Context, user *entity.Users, photo string) (profile *entity.UserProfile, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.UpdatePhoto", "userId": user.Id})
+
+	if err = a.authRepo.UpdatePhoto(ctx, user.Id, photo); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdatePhoto")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return nil, errorStatus.ErrNotFound
+		}
+		return nil, errorStatus.ErrInternalServer
+	}
+	return a.GetProfile(ctx, user)
+}
+
+// ChangePassword replaces the password after the current one is verified,
+// the other devices of the user are signed out
+func (a *UserUsecase) ChangePassword(ctx context.Context, user *entity.Users, password string, newPassword string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ChangePassword", "userId": user.Id})
+
+	if _, err = a.checkPassword(ctx, user, password); err != nil {
+		return err
+	}
+	if password == newPassword {
+		return errSamePassword
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		ctLog.WithError(err).Warning("bcrypt.GenerateFromPassword")
+		return errorStatus.Violation("newPassword", "can't be used as a password")
+	}
+	if err = a.authRepo.UpdatePassword(ctx, user.Id, string(hash)); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdatePassword")
+		return errorStatus.ErrInternalServer
+	}
+
+	sessions, err := a.redisRepo.ListSessions(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.ListSessions")
+		return errorStatus.ErrInternalServer
+	}
+	for _, session := range sessions {
+		if session.Id == user.SessionId {
+			continue
+		}
+		if err = a.redisRepo.DeleteSession(ctx, user.Id, session.Id); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.DeleteSession")
+			return errorStatus.ErrInternalServer
+		}
+	}
+	ctLog.WithFields(log.Fields{"event": "password_changed"}).Info("password changed")
+	return nil
+}
+
+// RequestEmailChange sends a code to the new email after the password is verified, the email
+// is changed once the code is confirmed. A new code is sent at most once per resend cooldown
+func (a *UserUsecase) RequestEmailChange(ctx context.Context, user *entity.Users, email string, password string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.RequestEmailChange", "userId": user.Id})
+
+	userDb, err := a.checkPassword(ctx, user, password)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(userDb.Email, email) {
+		return errorStatus.Violation("email", "is the current email")
+	}
+	_, err = a.authRepo.UserByEmail(ctx, email)
+	if err == nil {
+		return errorStatus.Violation("email", "is used by another account")
+	}
+	if !errors.Is(err, errorStatus.ErrNotFound) {
+		ctLog.WithError(err).Warning("a.authRepo.UserByEmail")
+		return errorStatus.ErrInternalServer
+	}
+	if previous, err := a.redisRepo.GetEmailChange(ctx, user.Id); err == nil {
+		if wait := a.verifyConf.ResendCooldown - time.Since(previous.SentTs); wait > 0 {
+			return errorStatus.ErrTooManyRequests.WithRetry(wait)
+		}
+	}
+
+	now := time.Now()
+	change := &entity.EmailChange{
+		Id:       uuid.New().String(),
+		Email:    email,
+		Code:     generator.RandDigits(a.verifyConf.CodeLength),
+		SentTs:   now,
+		ExpireTs: now.Add(a.verifyConf.CodeTTL),
+	}
+	if err = a.redisRepo.SetEmailChange(ctx, user.Id, change); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetEmailChange")
+		return errorStatus.ErrInternalServer
+	}
+
+	if a.brokerRepo == nil {
+		ctLog.Warning("broker is not configured, the email change code is not sent")
+		return nil
+	}
+	buff := new(bytes.Buffer)
+	err = a.verifyTmpl.Execute(buff, struct {
+		Code   string
+		Expire string
+	}{
+		Code:   change.Code,
+		Expire: change.ExpireTs.Format("Jan 2, 15:04 MST"),
+	})
+	if err != nil {
+		ctLog.WithError(err).Warning("a.verifyTmpl.Execute")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.brokerRepo.SendEmail(ctx, email, emailChangeSubject, buff.Bytes()); err != nil {
+		ctLog.WithError(err).Warning("a.brokerRepo.SendEmail")
+		return errorStatus.ErrUnavailable
+	}
+	return nil
+}
+
+// ConfirmEmailChange sets the pending email of the code, the change is dropped
+// after the allowed number of failed attempts
+func (a *UserUsecase) ConfirmEmailChange(ctx context.Context, user *entity.Users, code string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ConfirmEmailChange", "userId": user.Id})
+
+	change, err := a.redisRepo.GetEmailChange(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.GetEmailChange")
+		return errNoEmailChange
+	}
+	ttl := time.Until(change.ExpireTs)
+	if ttl <= 0 {
+		return errNoEmailChange
+	}
+
+	attempts, err := a.redisRepo.IncrRate(ctx, "email-attempts-"+change.Id, ttl)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+		return errorStatus.ErrInternalServer
+	}
+	if attempts > int64(a.verifyConf.MaxAttempts) {
+		if err = a.redisRepo.DeleteEmailChange(ctx, user.Id); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.DeleteEmailChange")
+		}
+		return errTooManyAttempts
+	}
+	if subtle.ConstantTimeCompare([]byte(change.Code), []byte(code)) != 1 {
+		return errorStatus.Violation("code", "doesn't match")
+	}
+
+	if err = a.authRepo.UpdateEmail(ctx, user.Id, change.Email); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdateEmail")
+		if errors.Is(err, errorStatus.ErrDuplicate) {
+			return errorStatus.Violation("email", "is used by another account")
+		}
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.redisRepo.DeleteEmailChange(ctx, user.Id); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteEmailChange")
+	}
+	ctLog.WithFields(log.Fields{"event": "email_changed"}).Info("email changed")
+	return nil
+}
+
+// DeleteAccount anonymizes the user after the password is verified and signs it out of every device,
+// the orders are kept without the personal data
+func (a *UserUsecase) DeleteAccount(ctx context.Context, user *entity.Users, password string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.DeleteAccount", "userId": user.Id})
+
+	if _, err = a.checkPassword(ctx, user, password); err != nil {
+		return err
+	}
+	if err = a.authRepo.AnonymizeUser(ctx, user.Id); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.AnonymizeUser")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.redisRepo.DeleteUserSessions(ctx, user.Id); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteUserSessions")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.redisRepo.DeleteEmailChange(ctx, user.Id); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteEmailChange")
+	}
+	ctLog.WithFields(log.Fields{"event": "account_deleted"}).Info("account deleted")
+	return nil
+}
+
+// checkPassword returns the account of the signed in user once its password is verified
+func (a *UserUsecase) checkPassword(ctx context.Context, user *entity.Users, password string) (*entity.Users, error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.checkPassword", "userId": user.Id})
+
+	userDb, err := a.authRepo.UserByUsername(ctx, user.Username)
+	if err != nil || userDb.Id != user.Id {
+		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
+		return nil, errorStatus.ErrInternalServer
+	}
+	if err = bcrypt.CompareHashAndPassword([]byte(userDb.Password), []byte(password)); err != nil {
+		return nil, errWrongPassword
+	}
+	return userDb, nil
+}
@@ -0,0 +1,111 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+func TestProfile(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	verifyConf := &entity.VerificationConf{CodeLength: 6, CodeTTL: 15 * time.Minute, MaxAttempts: 3, ResendCooldown: time.Minute}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, verifyConf, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+	john := &entity.Users{Id: 7, Username: "john", Email: "john@example.com", Password: string(hash), Role: entity.UserRoleUser}
+	signed := &entity.Users{Id: 7, Username: "john", Role: entity.UserRoleUser, SessionId: "s1"}
+
+	t.Run("pending email is shown", func(t *testing.T) {
+		pgMock.EXPECT().UserProfile(ctx, 7).Return(&entity.UserProfile{Username: "john", Email: "john@example.com"}, nil).Times(1)
+		redisMock.EXPECT().GetEmailChange(ctx, 7).Return(&entity.EmailChange{Email: "new@example.com", ExpireTs: time.Now().Add(time.Minute)}, nil).Times(1)
+
+		profile, err := userUsc.GetProfile(ctx, signed)
+		req.NoError(err)
+		req.Equal("new@example.com", profile.PendingEmail)
+	})
+
+	t.Run("phone number of another account", func(t *testing.T) {
+		phone := "+99365777777"
+		pgMock.EXPECT().UpdateProfile(ctx, 7, &entity.ProfileUpdate{PhoneNumber: &phone}).Return(errorStatus.ErrDuplicate).Times(1)
+
+		_, err := userUsc.UpdateProfile(ctx, signed, &entity.ProfileUpdate{PhoneNumber: &phone})
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+
+	t.Run("password change signs out the other devices", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UpdatePassword(ctx, 7, any).Return(nil).Times(1)
+		redisMock.EXPECT().ListSessions(ctx, 7).Return([]*entity.Session{{Id: "s1"}, {Id: "s2"}}, nil).Times(1)
+		redisMock.EXPECT().DeleteSession(ctx, 7, "s2").Return(nil).Times(1)
+
+		err := userUsc.ChangePassword(ctx, signed, "qwerty1234", "new-password")
+		req.NoError(err)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+
+		err := userUsc.ChangePassword(ctx, signed, "wrong", "new-password")
+		req.ErrorIs(err, errWrongPassword)
+	})
+
+	t.Run("email change is confirmed by the code", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UserByEmail(ctx, "new@example.com").Return(nil, errorStatus.ErrNotFound).Times(1)
+		redisMock.EXPECT().GetEmailChange(ctx, 7).Return(nil, errorStatus.ErrNotFound).Times(1)
+		var change *entity.EmailChange
+		redisMock.EXPECT().SetEmailChange(ctx, 7, any).DoAndReturn(func(_ context.Context, _ int, c *entity.EmailChange) error {
+			change = c
+			return nil
+		}).Times(1)
+		brokerMock.EXPECT().SendEmail(ctx, "new@example.com", emailChangeSubject, any).Return(nil).Times(1)
+
+		err := userUsc.RequestEmailChange(ctx, signed, "new@example.com", "qwerty1234")
+		req.NoError(err)
+		req.Len(change.Code, 6)
+
+		redisMock.EXPECT().GetEmailChange(ctx, 7).Return(change, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "email-attempts-"+change.Id, any).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UpdateEmail(ctx, 7, "new@example.com").Return(nil).Times(1)
+		redisMock.EXPECT().DeleteEmailChange(ctx, 7).Return(nil).Times(1)
+
+		err = userUsc.ConfirmEmailChange(ctx, signed, change.Code)
+		req.NoError(err)
+	})
+
+	t.Run("email of another account", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().UserByEmail(ctx, "jane@example.com").Return(&entity.Users{Id: 8}, nil).Times(1)
+
+		err := userUsc.RequestEmailChange(ctx, signed, "jane@example.com", "qwerty1234")
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+
+	t.Run("deleted account is anonymized and signed out", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(john, nil).Times(1)
+		pgMock.EXPECT().AnonymizeUser(ctx, 7).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteUserSessions(ctx, 7).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteEmailChange(ctx, 7).Return(nil).Times(1)
+
+		err := userUsc.DeleteAccount(ctx, signed, "qwerty1234")
+		req.NoError(err)
+	})
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+var errOwnRole = errorStatus.New(errorStatus.CodeFailedPrecondition, "the own role can't be changed")
+
+// AssignRole gives the role to the user. The tokens carry the role, so the user is signed out
+// of every device and the new one applies from the next login
+func (a *UserUsecase) AssignRole(ctx context.Context, admin *entity.Users, userId int, role entity.UserRole) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.AssignRole", "adminId": admin.Id, "userId": userId})
+
+	if _, ok := entity.RolePermissions[role]; !ok {
+		return errorStatus.Violation("role", "is unknown")
+	}
+	// the admins would lock themselves out by mistake
+	if admin.Id == userId {
+		return errOwnRole
+	}
+
+	if err = a.authRepo.UpdateRole(ctx, userId, role); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdateRole")
+		if errors.Is(err, errorStatus.ErrNotFound) {
+			return errorStatus.ErrNotFound
+		}
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.redisRepo.DeleteUserSessions(ctx, userId); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteUserSessions")
+		return errorStatus.ErrInternalServer
+	}
+	a.audit(ctx, admin.Id, userId, entity.AuditRoleAssigned, string(role), "")
+	return nil
+}
@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+func TestAssignRole(t *testing.T) {
+	req := require.New(t)
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+	admin := &entity.Users{Id: 1, Role: entity.UserRoleAdmin}
+
+	t.Run("assign role signs the user out", func(t *testing.T) {
+		pgMock.EXPECT().UpdateRole(ctx, 5, entity.UserRoleCatalogManager).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteUserSessions(ctx, 5).Return(nil).Times(1)
+		pgMock.EXPECT().CreateAudit(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, audit *entity.UserAudit) error {
+			req.Equal(entity.AuditRoleAssigned, audit.Action)
+			req.Equal(string(entity.UserRoleCatalogManager), audit.Detail)
+			return nil
+		}).Times(1)
+
+		err := userUsc.AssignRole(ctx, admin, 5, entity.UserRoleCatalogManager)
+		req.NoError(err)
+		req.True(entity.UserRoleCatalogManager.Can(entity.PermProductWrite))
+		req.False(entity.UserRoleCatalogManager.Can(entity.PermOrderStatus))
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		pgMock.EXPECT().UpdateRole(ctx, 6, entity.UserRoleSupportAgent).Return(errorStatus.ErrNotFound).Times(1)
+
+		err := userUsc.AssignRole(ctx, admin, 6, entity.UserRoleSupportAgent)
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
+
+	t.Run("guest and own role are refused", func(t *testing.T) {
+		err := userUsc.AssignRole(ctx, admin, 5, entity.UserRoleGuest)
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+
+		err = userUsc.AssignRole(ctx, admin, admin.Id, entity.UserRoleUser)
+		req.ErrorIs(err, errOwnRole)
+	})
+}
@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+// ListSessions returns the signed in devices of the user, the latest seen first,
+// the session of the request is marked as current
+func (a *UserUsecase) ListSessions(ctx context.Context, user *entity.Users) (sessions []*entity.SessionJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ListSessions"})
+
+	cached, err := a.redisRepo.ListSessions(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.ListSessions")
+		return nil, errorStatus.ErrInternalServer
+	}
+	sort.Slice(cached, func(i, j int) bool {
+		return cached[i].LastSeenTs.After(cached[j].LastSeenTs)
+	})
+
+	sessions = make([]*entity.SessionJson, len(cached))
+	for idx, session := range cached {
+		sessions[idx] = &entity.SessionJson{
+			Id:         session.Id,
+			Device:     session.Device,
+			IP:         session.IP,
+			UserAgent:  session.UserAgent,
+			CreateTs:   session.CreateTs,
+			LastSeenTs: session.LastSeenTs,
+			Current:    session.Id == user.SessionId,
+		}
+	}
+	return sessions, nil
+}
+
+// RevokeSession signs the device out, the session of another user is reported as not found
+func (a *UserUsecase) RevokeSession(ctx context.Context, userId int, sessionId string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.RevokeSession"})
+
+	session, err := a.redisRepo.GetSession(ctx, sessionId)
+	if err != nil || session.UserId != userId {
+		ctLog.WithError(err).Warning("a.redisRepo.GetSession")
+		return errorStatus.ErrNotFound
+	}
+	if err = a.redisRepo.DeleteSession(ctx, userId, sessionId); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteSession")
+		return errorStatus.ErrInternalServer
+	}
+	return nil
+}
+
+// RevokeSessions signs the user out of every device
+func (a *UserUsecase) RevokeSessions(ctx context.Context, userId int) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.RevokeSessions"})
+
+	if err = a.redisRepo.DeleteUserSessions(ctx, userId); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteUserSessions")
+		return errorStatus.ErrInternalServer
+	}
+	return nil
+}
@@ -0,0 +1,364 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+	"go-store/utils/jwt"
+)
+
+func TestSessions(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	accessKey, err := jwt.GenerateKey("2024-01")
+	req.NoError(err)
+	accessKeys, err := jwt.NewKeySet(accessKey)
+	req.NoError(err)
+	tokenConf := &entity.TokenConf{
+		AccesTokenTimeout:   15 * time.Minute,
+		RefreshTokenTimeout: time.Hour,
+		AutoLogoffTimeout:   30 * time.Minute,
+		AccessKeys:          accessKeys,
+		RefreshKeys:         jwt.NewHMACKeySet([]byte("refresh-secret")),
+	}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+	userDb := &entity.Users{Id: 7, Username: "john", Password: string(hash), Role: entity.UserRoleUser}
+
+	var session *entity.Session
+	var accessToken string
+	var claims, refreshClaims *entity.JwtClaims
+
+	t.Run("login opens a session", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(userDb, nil).Times(1)
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).DoAndReturn(func(_ context.Context, s *entity.Session, _ time.Duration) error {
+			session = s
+			return nil
+		}).Times(1)
+
+		client := &entity.SessionClient{Device: "Pixel 7", IP: "10.0.0.1", UserAgent: "okhttp/4.9"}
+		user, err := userUsc.Login(ctx, "john", "qwerty1234", client, tokenConf)
+		req.NoError(err)
+		req.Equal(7, session.UserId)
+		req.Equal("Pixel 7", session.Device)
+		req.Equal("10.0.0.1", session.IP)
+
+		accessToken = user.Tokens.AccessToken
+		claims, err = userUsc.ParseToken(ctx, accessToken, tokenConf.AccessKeys)
+		req.NoError(err)
+		req.Equal(session.Id, claims.Session)
+		req.Equal(session.Signatures.AccessSign, claims.UID)
+
+		refreshClaims, err = userUsc.ParseToken(ctx, user.Tokens.RefreshToken, tokenConf.RefreshKeys)
+		req.NoError(err)
+		req.Equal(session.Signatures.RefreshSign, refreshClaims.UID)
+	})
+
+	t.Run("rotated access keys", func(t *testing.T) {
+		newKey, err := jwt.GenerateKey("2024-02")
+		req.NoError(err)
+		rotated, err := jwt.NewKeySet(newKey, accessKey)
+		req.NoError(err)
+
+		// the tokens of the previous key stay valid until they expire
+		_, err = userUsc.ParseToken(ctx, accessToken, rotated)
+		req.NoError(err)
+		jwks := rotated.JWKS()
+		req.Len(jwks.Keys, 2)
+		req.Equal("2024-02", jwks.Keys[0].Kid)
+		req.Equal("OKP", jwks.Keys[0].Kty)
+
+		token, err := rotated.Sign(claims)
+		req.NoError(err)
+		_, err = userUsc.ParseToken(ctx, token, tokenConf.AccessKeys)
+		req.ErrorIs(err, jwt.ErrUnknownKey)
+	})
+
+	t.Run("forged algorithm", func(t *testing.T) {
+		// a token signed by HS256 with the published key as the secret must not verify
+		forged, err := jwt.NewKeySet(jwt.NewHMACKey("2024-01", []byte(tokenConf.AccessKeys.JWKS().Keys[0].X)))
+		req.NoError(err)
+		token, err := forged.Sign(claims)
+		req.NoError(err)
+
+		_, err = userUsc.ParseToken(ctx, token, tokenConf.AccessKeys)
+		req.ErrorIs(err, jwt.ErrSignature)
+	})
+
+	t.Run("validate token", func(t *testing.T) {
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(session, nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(userDb, nil).Times(1)
+
+		user, err := userUsc.ValidateToken(ctx, claims, claims.UID, false)
+		req.NoError(err)
+		req.Equal(session.Id, user.SessionId)
+	})
+
+	t.Run("blocked and deleted accounts", func(t *testing.T) {
+		for _, state := range []entity.State{entity.Blocked, entity.Deleted} {
+			blocked := *userDb
+			blocked.State = state
+			redisMock.EXPECT().GetSession(ctx, session.Id).Return(session, nil).Times(1)
+			pgMock.EXPECT().UserByUsername(ctx, "john").Return(&blocked, nil).Times(1)
+
+			_, err := userUsc.ValidateToken(ctx, claims, claims.UID, false)
+			req.ErrorIs(err, errAccountBlocked, state)
+		}
+
+		// the username of the deleted account is anonymized
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(session, nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(nil, errorStatus.ErrNotFound).Times(1)
+		_, err := userUsc.ValidateToken(ctx, claims, claims.UID, false)
+		req.ErrorIs(err, errorStatus.ErrAuth)
+	})
+
+	t.Run("revoked session", func(t *testing.T) {
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(nil, errors.New("redis: nil")).Times(1)
+
+		_, err := userUsc.ValidateToken(ctx, claims, claims.UID, false)
+		req.ErrorIs(err, errorStatus.ErrAuth)
+	})
+
+	t.Run("list sessions", func(t *testing.T) {
+		other := &entity.Session{Id: "b2", UserId: 7, LastSeenTs: session.LastSeenTs.Add(-time.Hour)}
+		redisMock.EXPECT().ListSessions(ctx, 7).Return([]*entity.Session{other, session}, nil).Times(1)
+
+		sessions, err := userUsc.ListSessions(ctx, &entity.Users{Id: 7, SessionId: session.Id})
+		req.NoError(err)
+		req.Len(sessions, 2)
+		req.Equal(session.Id, sessions[0].Id)
+		req.True(sessions[0].Current)
+		req.False(sessions[1].Current)
+	})
+
+	t.Run("revoke the session of another user", func(t *testing.T) {
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(session, nil).Times(1)
+
+		err := userUsc.RevokeSession(ctx, 8, session.Id)
+		req.ErrorIs(err, errorStatus.ErrNotFound)
+	})
+
+	t.Run("revoke session", func(t *testing.T) {
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(session, nil).Times(1)
+		redisMock.EXPECT().DeleteSession(ctx, 7, session.Id).Return(nil).Times(1)
+
+		err := userUsc.RevokeSession(ctx, 7, session.Id)
+		req.NoError(err)
+	})
+
+	t.Run("refresh rotates the tokens", func(t *testing.T) {
+		cached := *session
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(userDb, nil).Times(1)
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(&cached, nil).Times(1)
+		redisMock.EXPECT().UseRefreshToken(ctx, refreshClaims.UID, session.Id, time.Hour).Return(true, nil).Times(1)
+		var rotated *entity.Session
+		redisMock.EXPECT().SetSession(ctx, any, 30*time.Minute).DoAndReturn(func(_ context.Context, s *entity.Session, _ time.Duration) error {
+			rotated = s
+			return nil
+		}).Times(1)
+
+		user, err := userUsc.Refresh(ctx, refreshClaims, tokenConf)
+		req.NoError(err)
+		req.Equal(session.Id, rotated.Id)
+		req.NotEqual(refreshClaims.UID, rotated.Signatures.RefreshSign)
+
+		newClaims, err := userUsc.ParseToken(ctx, user.Tokens.RefreshToken, tokenConf.RefreshKeys)
+		req.NoError(err)
+		req.Equal(rotated.Signatures.RefreshSign, newClaims.UID)
+		req.Equal(session.Id, newClaims.Session)
+	})
+
+	t.Run("reused refresh token revokes the session", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(userDb, nil).Times(1)
+		redisMock.EXPECT().GetSession(ctx, session.Id).Return(session, nil).Times(1)
+		redisMock.EXPECT().UseRefreshToken(ctx, refreshClaims.UID, session.Id, time.Hour).Return(false, nil).Times(1)
+		redisMock.EXPECT().DeleteSession(ctx, 7, session.Id).Return(nil).Times(1)
+
+		_, err := userUsc.Refresh(ctx, refreshClaims, tokenConf)
+		req.ErrorIs(err, errorStatus.ErrAuth)
+	})
+}
+
+// sessionStore keeps the sessions in memory like the redis repository, the other calls are not expected
+type sessionStore struct {
+	entity.AuthRedisRepository
+	mu       sync.Mutex
+	sessions map[string]entity.Session
+	used     map[string]bool
+	// afterGet runs once a session is read, before the caller can write it back
+	afterGet func()
+}
+
+func (s *sessionStore) GetSession(_ context.Context, sessionId string) (*entity.Session, error) {
+	s.mu.Lock()
+	session, ok := s.sessions[sessionId]
+	afterGet := s.afterGet
+	s.afterGet = nil
+	s.mu.Unlock()
+	if !ok {
+		return nil, errors.New("redis: nil")
+	}
+	if afterGet != nil {
+		afterGet()
+	}
+	return &session, nil
+}
+
+func (s *sessionStore) SetSession(_ context.Context, session *entity.Session, _ time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[session.Id] = *session
+	return nil
+}
+
+func (s *sessionStore) TouchSession(_ context.Context, _ int, sessionId string, lastSeenTs time.Time, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	session, ok := s.sessions[sessionId]
+	if !ok {
+		return false, nil
+	}
+	session.LastSeenTs = lastSeenTs
+	s.sessions[sessionId] = session
+	return true, nil
+}
+
+func (s *sessionStore) UseRefreshToken(_ context.Context, tokenId string, _ string, _ time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	firstUse := !s.used[tokenId]
+	s.used[tokenId] = true
+	return firstUse, nil
+}
+
+func (s *sessionStore) DeleteSession(_ context.Context, _ int, sessionId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.sessions, sessionId)
+	return nil
+}
+
+func TestTokenExpire(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+	store := &sessionStore{sessions: map[string]entity.Session{}, used: map[string]bool{}}
+
+	tokenConf := &entity.TokenConf{
+		AccesTokenTimeout:   15 * time.Minute,
+		RefreshTokenTimeout: time.Hour,
+		AutoLogoffTimeout:   30 * time.Minute,
+		AccessKeys:          jwt.NewHMACKeySet([]byte("access-secret")),
+		RefreshKeys:         jwt.NewHMACKeySet([]byte("refresh-secret")),
+	}
+	userUsc := NewAuthUsecase(pgMock, store, brokerMock, &entity.PasswordResetConf{}, &entity.VerificationConf{}, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("qwerty1234"), bcrypt.MinCost)
+	req.NoError(err)
+	userDb := &entity.Users{Id: 7, Username: "john", Password: string(hash), Role: entity.UserRoleUser}
+	pgMock.EXPECT().UserByUsername(any, "john").Return(userDb, nil).AnyTimes()
+
+	user, err := userUsc.Login(ctx, "john", "qwerty1234", &entity.SessionClient{Device: "Pixel 7"}, tokenConf)
+	req.NoError(err)
+	claims, err := userUsc.ParseToken(ctx, user.Tokens.AccessToken, tokenConf.AccessKeys)
+	req.NoError(err)
+
+	refreshToken := user.Tokens.RefreshToken
+
+	t.Run("request doesn't undo the refresh between its read and write", func(t *testing.T) {
+		refreshClaims, err := userUsc.ParseToken(ctx, refreshToken, tokenConf.RefreshKeys)
+		req.NoError(err)
+		var rotated *entity.UserJson
+		refresh := func() {
+			rotated, err = userUsc.Refresh(ctx, refreshClaims, tokenConf)
+			req.NoError(err)
+		}
+		store.afterGet = refresh
+
+		req.NoError(userUsc.TokenExpire(ctx, claims, tokenConf.AutoLogoffTimeout))
+		// the request doesn't read the session, the refresh comes after it then
+		if rotated == nil {
+			store.afterGet = nil
+			refresh()
+		}
+
+		refreshToken = rotated.Tokens.RefreshToken
+		newClaims, err := userUsc.ParseToken(ctx, refreshToken, tokenConf.RefreshKeys)
+		req.NoError(err)
+		session, err := store.GetSession(ctx, claims.Session)
+		req.NoError(err)
+		req.Equal(newClaims.UID, session.Signatures.RefreshSign)
+	})
+
+	t.Run("requests don't undo a concurrent refresh", func(t *testing.T) {
+		for round := 0; round < 20; round++ {
+			refreshClaims, err := userUsc.ParseToken(ctx, refreshToken, tokenConf.RefreshKeys)
+			req.NoError(err)
+
+			var wg sync.WaitGroup
+			var rotated *entity.UserJson
+			var refreshErr error
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				rotated, refreshErr = userUsc.Refresh(ctx, refreshClaims, tokenConf)
+			}()
+			errs := make(chan error, 10)
+			for i := 0; i < cap(errs); i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					errs <- userUsc.TokenExpire(ctx, claims, tokenConf.AutoLogoffTimeout)
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				req.NoError(err)
+			}
+			req.NoError(refreshErr)
+
+			// the session keeps the signatures of the rotated pair
+			refreshToken = rotated.Tokens.RefreshToken
+			newClaims, err := userUsc.ParseToken(ctx, refreshToken, tokenConf.RefreshKeys)
+			req.NoError(err)
+			session, err := store.GetSession(ctx, claims.Session)
+			req.NoError(err)
+			req.Equal(newClaims.UID, session.Signatures.RefreshSign)
+		}
+	})
+
+	t.Run("revoked session isn't brought back", func(t *testing.T) {
+		req.NoError(userUsc.RevokeSession(ctx, 7, claims.Session))
+
+		err := userUsc.TokenExpire(ctx, claims, tokenConf.AutoLogoffTimeout)
+		req.ErrorIs(err, errorStatus.ErrAuth)
+		_, err = store.GetSession(ctx, claims.Session)
+		req.Error(err)
+	})
+}
@@ -1,61 +1,129 @@
 package usecase
 
 import (
-	"bytes"
 	"context"
-	"text/template"
+	"errors"
+	htmlTemplate "html/template"
+	"sync"
 	"time"
 
-	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/crypto/bcrypt"
 
 	"go-store/internal/entity"
+	"go-store/utils/broker"
 	errorStatus "go-store/utils/errors"
-	generator "go-store/utils/generator"
+	"go-store/utils/jwt"
 )
 
 // UserUsecase will initiate usecase of entity.AuthPgxRepository interface
 type UserUsecase struct {
-	authRepo   entity.AuthPgxRepository
-	redisRepo  entity.AuthRedisRepository
-	brokerRepo entity.AuthBroker
+	authRepo    entity.AuthPgxRepository
+	redisRepo   entity.AuthRedisRepository
+	brokerRepo  entity.AuthBroker
+	resetConf   *entity.PasswordResetConf
+	resetKeys   *jwt.KeySet
+	resetTmpl   *htmlTemplate.Template
+	verifyConf  *entity.VerificationConf
+	mfaConf     *entity.MfaConf
+	verifyTmpl  *htmlTemplate.Template
+	lockoutConf *entity.LockoutConf
+	lockTmpl    *htmlTemplate.Template
+	oidcConf    *entity.OidcConf
+	// jobs are the emails sent after the calls return
+	jobs sync.WaitGroup
 }
 
-// NewAuthUsecase will create new an UserUsecase object representation of entity.UserUsecase interface
-func NewAuthUsecase(a entity.AuthPgxRepository, r entity.AuthRedisRepository) entity.UserUsecase {
+// NewAuthUsecase will create new an UserUsecase object representation of entity.UserUsecase interface,
+// the broker is nil when it is not configured and the emails are not sent
+func NewAuthUsecase(a entity.AuthPgxRepository, r entity.AuthRedisRepository, b entity.AuthBroker, resetConf *entity.PasswordResetConf, verifyConf *entity.VerificationConf, mfaConf *entity.MfaConf, lockoutConf *entity.LockoutConf, oidcConf *entity.OidcConf) entity.UserUsecase {
 	return &UserUsecase{
-		authRepo:  a,
-		redisRepo: r,
+		authRepo:    a,
+		redisRepo:   r,
+		brokerRepo:  b,
+		resetConf:   resetConf,
+		resetKeys:   jwt.NewHMACKeySet(resetConf.Secret),
+		resetTmpl:   htmlTemplate.Must(htmlTemplate.New("password_reset").Parse(broker.PasswordResetTemplate)),
+		verifyConf:  verifyConf,
+		mfaConf:     mfaConf,
+		verifyTmpl:  htmlTemplate.Must(htmlTemplate.New("verification").Parse(broker.VerificationTemplate)),
+		lockoutConf: lockoutConf,
+		lockTmpl:    htmlTemplate.Must(htmlTemplate.New("account_lock").Parse(broker.AccountLockTemplate)),
+		oidcConf:    oidcConf,
 	}
 }
 
 // Method GetLoginUser which recieve authentification credintials and return users token, role and username
-func (a *UserUsecase) Login(ctx context.Context, username string, password string, tokenConf *entity.TokenConf) (result *entity.UserJson, err error) {
+func (a *UserUsecase) Login(ctx context.Context, username string, password string, client *entity.SessionClient, tokenConf *entity.TokenConf) (result *entity.UserJson, err error) {
 
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.GetLoginUser"})
+	// the locked accounts and blocked addresses are refused before the password is compared
+	if err = a.checkLogin(ctx, username, client.IP); err != nil {
+		ctLog.WithError(err).Warning("a.checkLogin")
+		return nil, err
+	}
 	// take from database username data
 	userDb, err := a.authRepo.UserByUsername(ctx, username)
+	if errors.Is(err, errorStatus.ErrNotFound) {
+		ctLog.Warning("a.authRepo.UserByUsername - unknown user")
+		return nil, a.failedLogin(ctx, username, client.IP, nil)
+	}
 	if err != nil {
 		ctLog.WithError(err).Warning("a.authRepo.UserByUsername - can't get user")
-		return nil, err
+		return nil, errorStatus.ErrInternalServer
 	}
 	// recieve hashed password from database and compared with hash
 	if err = bcrypt.CompareHashAndPassword([]byte(userDb.Password), []byte(password)); err != nil {
 		ctLog.WithError(err).Warning("bcrypt.CompareHashAndPassword - User credentials invalid")
-		return nil, err
+		return nil, a.failedLogin(ctx, username, client.IP, userDb)
 	}
+	if accountBlocked(userDb) {
+		ctLog.WithFields(log.Fields{"userId": userDb.Id}).Warning("blocked account")
+		return nil, errAccountBlocked
+	}
+	// the second factor is asked before any token is issued, the failures are kept until it is passed
+	if a.mfaRequired(userDb) {
+		return a.startMfa(ctx, userDb, client)
+	}
+	a.clearLoginFailures(ctx, username)
+	return a.openSession(ctx, userDb, client, tokenConf)
+}
+
+// openSession opens a new session of the user on the device and issues its token pair
+func (a *UserUsecase) openSession(ctx context.Context, userDb *entity.Users, client *entity.SessionClient, tokenConf *entity.TokenConf) (result *entity.UserJson, err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.openSession"})
 	var accessToken, refreshToken *string
 
 	accessExpirationTime := time.Now().Add(tokenConf.AccesTokenTimeout)
 	refreshExpirationTime := time.Now().Add(tokenConf.RefreshTokenTimeout)
 
-	accessToken, accSign, err := a.createToken(ctx, userDb, accessExpirationTime, tokenConf.AccessSecret)
-	refreshToken, refSign, err := a.createToken(ctx, userDb, refreshExpirationTime, tokenConf.RefreshSecret)
+	// every login opens a new session of the user
+	now := time.Now()
+	session := &entity.Session{
+		Id:         uuid.New().String(),
+		UserId:     userDb.Id,
+		Device:     client.Device,
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+		CreateTs:   now,
+		LastSeenTs: now,
+	}
+
+	accessToken, accSign, err := a.createToken(ctx, userDb, session.Id, accessExpirationTime, tokenConf.AccessKeys)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.createToken")
+		return nil, errorStatus.ErrInternalServer
+	}
+	refreshToken, refSign, err := a.createToken(ctx, userDb, session.Id, refreshExpirationTime, tokenConf.RefreshKeys)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.createToken")
+		return nil, errorStatus.ErrInternalServer
+	}
 
 	// Create the JWT claims, which includes the username and expiry time
 	user := &entity.UserJson{
+		Id:       userDb.Id,
 		PublicId: userDb.PublicId,
 		Username: userDb.Username,
 		Role:     userDb.Role,
@@ -66,13 +134,13 @@ func (a *UserUsecase) Login(ctx context.Context, username string, password strin
 	}
 	user.Tokens = *tokens
 
-	signatures := &entity.Signatures{
+	session.Signatures = entity.Signatures{
 		AccessSign:  *accSign,
 		RefreshSign: *refSign,
 	}
-	err = a.redisRepo.SetUserToken(ctx, signatures, userDb.Id, tokenConf.AutoLogoffTimeout)
+	err = a.redisRepo.SetSession(ctx, session, tokenConf.AutoLogoffTimeout)
 	if err != nil {
-		ctLog.WithError(err).Warning("a.redisRepo.SetUserToken")
+		ctLog.WithError(err).Warning("a.redisRepo.SetSession")
 		err = errorStatus.ErrInternalServer
 		return
 	}
@@ -80,7 +148,8 @@ func (a *UserUsecase) Login(ctx context.Context, username string, password strin
 	return user, nil
 }
 
-// Method GetLoginUser which recieve authentification credintials and return users token, role and username
+// Method Refresh which recieve the claims of the refresh token and rotate the token pair of its session.
+// Every refresh token is used once, a replayed one revokes the session with all its tokens
 func (a *UserUsecase) Refresh(ctx context.Context, claims *entity.JwtClaims, tokenConf *entity.TokenConf) (result *entity.UserJson, err error) {
 
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.Refresh"})
@@ -95,11 +164,37 @@ func (a *UserUsecase) Refresh(ctx context.Context, claims *entity.JwtClaims, tok
 	accessExpirationTime := time.Now().Add(tokenConf.AccesTokenTimeout)
 	refreshExpirationTime := time.Now().Add(tokenConf.RefreshTokenTimeout)
 
-	accessToken, accSign, err := a.createToken(ctx, userDb, accessExpirationTime, tokenConf.AccessSecret)
-	refreshToken, refSign, err := a.createToken(ctx, userDb, refreshExpirationTime, tokenConf.RefreshSecret)
+	// the session is the family of the rotated tokens, the refreshed pair replaces its signatures
+	session, err := a.redisRepo.GetSession(ctx, claims.Session)
+	if err != nil || session.UserId != claims.Id {
+		ctLog.WithError(err).Warning("a.redisRepo.GetSession")
+		return nil, errorStatus.ErrAuth
+	}
+	// the pair is signed before the token is used up, a failed signing can be retried
+	accessToken, accSign, err := a.createToken(ctx, userDb, session.Id, accessExpirationTime, tokenConf.AccessKeys)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.createToken")
+		return nil, errorStatus.ErrInternalServer
+	}
+	refreshToken, refSign, err := a.createToken(ctx, userDb, session.Id, refreshExpirationTime, tokenConf.RefreshKeys)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.createToken")
+		return nil, errorStatus.ErrInternalServer
+	}
+
+	firstUse, err := a.redisRepo.UseRefreshToken(ctx, claims.UID, session.Id, tokenConf.RefreshTokenTimeout)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.UseRefreshToken")
+		return nil, errorStatus.ErrInternalServer
+	}
+	if !firstUse || claims.UID != session.Signatures.RefreshSign {
+		a.revokeFamily(ctx, session, claims)
+		return nil, errorStatus.ErrAuth
+	}
 
 	// Create the JWT claims, which includes the username and expiry time
 	userJs := &entity.UserJson{
+		Id:       userDb.Id,
 		PublicId: userDb.PublicId,
 		Username: userDb.Username,
 		Role:     userDb.Role,
@@ -110,31 +205,49 @@ func (a *UserUsecase) Refresh(ctx context.Context, claims *entity.JwtClaims, tok
 	}
 	userJs.Tokens = *tokens
 
-	signatures := &entity.Signatures{
+	session.Signatures = entity.Signatures{
 		AccessSign:  *accSign,
 		RefreshSign: *refSign,
 	}
-	err = a.redisRepo.SetUserToken(ctx, signatures, userDb.Id, tokenConf.AutoLogoffTimeout)
+	session.LastSeenTs = time.Now()
+	err = a.redisRepo.SetSession(ctx, session, tokenConf.AutoLogoffTimeout)
 	if err != nil {
-		ctLog.WithError(err).Warning("a.redisRepo.SetUserToken")
+		ctLog.WithError(err).Warning("a.redisRepo.SetSession")
 		return nil, err
 	}
 
 	return userJs, nil
 }
 
+// revokeFamily drops the session of the replayed refresh token, the stolen and the legitimate
+// tokens of the session stop working together
+func (a *UserUsecase) revokeFamily(ctx context.Context, session *entity.Session, claims *entity.JwtClaims) {
+	ctLog := log.WithFields(log.Fields{
+		"func":      "UserUsecase.revokeFamily",
+		"event":     "refresh_token_reuse",
+		"userId":    session.UserId,
+		"sessionId": session.Id,
+		"tokenId":   claims.UID,
+		"device":    session.Device,
+		"ip":        session.IP,
+	})
+	ctLog.Error("refresh token reused, revoking the session")
+	if err := a.redisRepo.DeleteSession(ctx, session.UserId, session.Id); err != nil {
+		ctLog.WithError(err).Error("a.redisRepo.DeleteSession")
+	}
+}
+
 func (a *UserUsecase) CheckIsAuthorized(ctx context.Context, tokenString string) (claims *entity.Claims, err error) {
 	return nil, nil
 }
 
-// Method Logout which recieve userId and remove tokens with this id from cache
-func (a *UserUsecase) Logout(ctx context.Context, userId int) (err error) {
+// Method Logout which recieve userId and the session of the token and remove the session from cache
+func (a *UserUsecase) Logout(ctx context.Context, userId int, sessionId string) (err error) {
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.Logout"})
-	// take from database username data
 
-	err = a.redisRepo.DeleteUserToken(ctx, userId)
+	err = a.redisRepo.DeleteSession(ctx, userId, sessionId)
 	if err != nil {
-		ctLog.WithError(err).Warning("a.redisRepo.DeleteUserToken")
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteSession")
 		err = errorStatus.ErrInternalServer
 		return
 	}
@@ -144,26 +257,33 @@ func (a *UserUsecase) Logout(ctx context.Context, userId int) (err error) {
 
 func (a *UserUsecase) ValidateToken(ctx context.Context, claims *entity.JwtClaims, uidMdlw string, isRefresh bool) (user *entity.Users, err error) {
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ValidateToken"})
-	cachedJson, err := a.redisRepo.GetUserToken(ctx, claims.Id)
+	// a revoked or idle session is gone from cache together with its signatures
+	session, err := a.redisRepo.GetSession(ctx, claims.Session)
 	if err != nil {
-		ctLog.WithError(err).Warning("a.redisRepo.GetUserToken")
-		err = errorStatus.ErrInternalServer
+		ctLog.WithError(err).Warning("a.redisRepo.GetSession")
+		err = errorStatus.ErrAuth
 		return
 	}
 	var tokenUid string
 	if isRefresh {
-		tokenUid = cachedJson.RefreshSign
+		tokenUid = session.Signatures.RefreshSign
 	} else {
-		tokenUid = cachedJson.AccessSign
+		tokenUid = session.Signatures.AccessSign
 	}
 
-	if err != nil || tokenUid != uidMdlw {
+	if session.UserId != claims.Id || tokenUid != uidMdlw {
 		ctLog.Warning("token not validate")
 		err = errorStatus.ErrAuth
 		return nil, err
 	}
 
 	userDb, err := a.authRepo.UserByUsername(ctx, claims.Username)
+	// the username of a deleted account is anonymized
+	if errors.Is(err, errorStatus.ErrNotFound) {
+		ctLog.Warning("a.authRepo.UserByUsername - User not found")
+		err = errorStatus.ErrAuth
+		return nil, err
+	}
 	if err != nil {
 		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
 		err = errorStatus.ErrInternalServer
@@ -174,30 +294,46 @@ func (a *UserUsecase) ValidateToken(ctx context.Context, claims *entity.JwtClaim
 		err = errorStatus.ErrAuth
 		return nil, err
 	}
+	// the sessions of a blocked account are dropped, the account is checked too so a session
+	// that outlived the drop can't be used
+	if accountBlocked(userDb) {
+		ctLog.WithFields(log.Fields{"userId": userDb.Id}).Warning("blocked account")
+		return nil, errAccountBlocked
+	}
 
 	user = &entity.Users{
-		Id:       userDb.Id,
-		PublicId: userDb.PublicId,
-		Username: userDb.Username,
-		Role:     userDb.Role,
+		Id:        userDb.Id,
+		PublicId:  userDb.PublicId,
+		Username:  userDb.Username,
+		Role:      userDb.Role,
+		RegionId:  userDb.RegionId,
+		Email:     userDb.Email,
+		SessionId: session.Id,
 	}
 
 	return user, nil
 }
 
-func (a *UserUsecase) TokenExpire(ctx context.Context, userId int, timeExp time.Duration) (err error) {
+// TokenExpire marks the session of the token as seen and extends it by the auto logoff timeout.
+// Only the last seen time is written, so a refresh running at the same time keeps its signatures
+// and a revoked session stays revoked
+func (a *UserUsecase) TokenExpire(ctx context.Context, claims *entity.JwtClaims, timeExp time.Duration) (err error) {
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.TokenExpire"})
-	err = a.redisRepo.ExpireUserToken(ctx, userId, timeExp)
+	touched, err := a.redisRepo.TouchSession(ctx, claims.Id, claims.Session, time.Now(), timeExp)
 	if err != nil {
-		ctLog.WithError(err).Warning("a.redisRepo.ExpireUserToken")
+		ctLog.WithError(err).Warning("a.redisRepo.TouchSession")
 		err = errorStatus.ErrInternalServer
 		return
 	}
+	if !touched {
+		err = errorStatus.ErrAuth
+		return
+	}
 
 	return nil
 }
 
-func (a *UserUsecase) createToken(ctx context.Context, user *entity.Users, timeExp time.Time, jwtKey []byte) (tokenStr *string, sign *string, err error) {
+func (a *UserUsecase) createToken(ctx context.Context, user *entity.Users, sessionId string, timeExp time.Time, keys *jwt.KeySet) (tokenStr *string, sign *string, err error) {
 	// Declare the expiration time of the token
 	// here, we have kept it as X minutes
 	uuidS := uuid.New().String()
@@ -206,16 +342,16 @@ func (a *UserUsecase) createToken(ctx context.Context, user *entity.Users, timeE
 		Username: user.Username,
 		Role:     user.Role,
 		UID:      uuidS,
-		StandardClaims: jwt.StandardClaims{
-			// In JWT, the expiry time is expressed as unix milliseconds
-			ExpiresAt: timeExp.Unix(),
+		Session:  sessionId,
+		RegisteredClaims: jwt.RegisteredClaims{
+			// In JWT, the expiry time is expressed as unix seconds
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(timeExp),
 		},
 	}
-	// Declare the token with the algorithm used for signing, and the claims
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim)
 
-	// Create the JWT string
-	tokenString, err := token.SignedString(jwtKey)
+	// Create the JWT string signed by the current key of the set
+	tokenString, err := keys.Sign(claim)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -223,36 +359,33 @@ func (a *UserUsecase) createToken(ctx context.Context, user *entity.Users, timeE
 	return &tokenString, &uuidS, nil
 }
 
-func (a *UserUsecase) ParseToken(ctx context.Context, tokenStr string, secret []byte) (claims *entity.JwtClaims, err error) {
+// ParseToken verifies the token by the key of its kid header, the tokens issued before
+// the sessions carry no session id and fail the validation
+func (a *UserUsecase) ParseToken(ctx context.Context, tokenStr string, keys *jwt.KeySet) (claims *entity.JwtClaims, err error) {
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ParseToken"})
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			err := errorStatus.ErrToken
-			return nil, err
-		}
-		return secret, nil
-	})
-	if err != nil {
-		ctLog.WithError(err).Warning("jwt.Parse")
+	claims = &entity.JwtClaims{}
+	if err = keys.Parse(tokenStr, claims); err != nil {
+		ctLog.WithError(err).Warning("keys.Parse")
 		return nil, err
 	}
-	claimsM := token.Claims.(jwt.MapClaims)
-
-	claims = &entity.JwtClaims{
-		Id:       int(claimsM["id"].(float64)),
-		UID:      claimsM["uid"].(string),
-		Username: claimsM["username"].(string),
-		Role:     entity.UserRole(claimsM["role"].(string)),
-	}
 	return claims, nil
 }
 
 func (a *UserUsecase) CreateUser(ctx context.Context, user *entity.Users, sendMethod *string) (err error) {
 	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.CreateUser"})
 
+	method := entity.SendMethodEmail
+	if sendMethod != nil && *sendMethod != "" {
+		method = *sendMethod
+	}
+	if method == entity.SendMethodSms && user.PhoneNumber == "" {
+		return errorStatus.Violation("phone", "required")
+	}
+
 	user.PublicId = uuid.New()
-	user.VerificationCode = generator.RandStringRunes(6)
 	user.Role = entity.UserRoleUser
+	// the account is enabled by the verification code
+	user.State = entity.Disabled
 
 	err = a.authRepo.Create(ctx, user)
 	if err != nil {
@@ -260,44 +393,5 @@ func (a *UserUsecase) CreateUser(ctx context.Context, user *entity.Users, sendMe
 		return err
 	}
 
-	tmpl := template.Must(template.ParseFiles("utils/broker/email.html"))
-	buff := new(bytes.Buffer)
-	if err = tmpl.Execute(buff, struct{ Code string }{user.VerificationCode}); err != nil {
-		ctLog.WithError(err).Warning("tmpl.Execute")
-		return err
-	}
-
-	err = a.brokerRepo.SendEmail(ctx, user.Email, buff.Bytes())
-	if err != nil {
-		ctLog.WithError(err).Warning("a.brokerRepo.SendEmail")
-		return err
-	}
-
-	return nil
-}
-
-func (a *UserUsecase) ActivateUser(ctx context.Context, username string, verificationCode string) (err error) {
-	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ActivateUser"})
-
-	user, err := a.authRepo.UserByUsername(ctx, username)
-	if err != nil {
-		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
-		return err
-	}
-
-	if !(user.State == entity.Disabled && user.VerificationCode == verificationCode) {
-		ctLog.WithError(err).Warning("not permit!")
-		return err
-	}
-
-	user.UpdateTs = time.Now()
-	user.State = entity.Enabled
-
-	err = a.authRepo.Update(ctx, user)
-	if err != nil {
-		ctLog.WithError(err).Warning("a.authRepo.Update")
-		return err
-	}
-
-	return nil
+	return a.sendVerification(ctx, user, method)
 }
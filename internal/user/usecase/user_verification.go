@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+	generator "go-store/utils/generator"
+)
+
+const verificationSubject = "Confirm your email"
+
+var (
+	errAlreadyActive   = errorStatus.New(errorStatus.CodeFailedPrecondition, "account is already active")
+	errCodeExpired     = errorStatus.New(errorStatus.CodeFailedPrecondition, "verification code expired")
+	errTooManyAttempts = errorStatus.New(errorStatus.CodeFailedPrecondition, "too many attempts, request a new code")
+)
+
+// ActivateUser enables the account of the verification code, the code is dropped
+// after the allowed number of failed attempts
+func (a *UserUsecase) ActivateUser(ctx context.Context, username string, verificationCode string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ActivateUser"})
+
+	user, err := a.authRepo.UserByUsername(ctx, username)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
+		return errorStatus.ErrNotFound
+	}
+	if user.State != entity.Disabled {
+		return errAlreadyActive
+	}
+
+	verification, err := a.redisRepo.GetVerification(ctx, user.Id)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.GetVerification")
+		return errCodeExpired
+	}
+	ttl := time.Until(verification.ExpireTs)
+	if ttl <= 0 {
+		return errCodeExpired
+	}
+
+	attempts, err := a.redisRepo.IncrRate(ctx, "verify-attempts-"+verification.Id, ttl)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.IncrRate")
+		return errorStatus.ErrInternalServer
+	}
+	if attempts > int64(a.verifyConf.MaxAttempts) {
+		if err = a.redisRepo.DeleteVerification(ctx, user.Id); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.DeleteVerification")
+		}
+		return errTooManyAttempts
+	}
+	if subtle.ConstantTimeCompare([]byte(verification.Code), []byte(verificationCode)) != 1 {
+		return errorStatus.Violation("code", "doesn't match")
+	}
+
+	if err = a.authRepo.UpdateState(ctx, user.Id, entity.Enabled); err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UpdateState")
+		return errorStatus.ErrInternalServer
+	}
+	// the code sent by sms proves the phone, not the email
+	if verification.Method == entity.SendMethodEmail {
+		if err = a.authRepo.VerifyEmail(ctx, user.Id); err != nil {
+			ctLog.WithError(err).Warning("a.authRepo.VerifyEmail")
+			return errorStatus.ErrInternalServer
+		}
+	}
+	if err = a.redisRepo.DeleteVerification(ctx, user.Id); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.DeleteVerification")
+	}
+	return nil
+}
+
+// resendLockKey is the lock of the account while the cooldown of the last sent code runs,
+// it is kept apart from the code so an expired code doesn't end the cooldown
+func resendLockKey(userId int) string {
+	return fmt.Sprintf("verify-resend-%d", userId)
+}
+
+// ResendVerification sends a new code to the account that is not active yet, at most once per cooldown.
+// An empty sendMethod sends it the way the previous code was sent
+func (a *UserUsecase) ResendVerification(ctx context.Context, username string, sendMethod string, ip string) (err error) {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.ResendVerification"})
+
+	if ip != "" {
+		if err = a.checkRate(ctx, "verify-ip-"+ip, a.verifyConf.IPLimit, a.verifyConf.RateWindow); err != nil {
+			return err
+		}
+	}
+
+	user, err := a.authRepo.UserByUsername(ctx, username)
+	if err != nil {
+		ctLog.WithError(err).Warning("a.authRepo.UserByUsername")
+		return errorStatus.ErrNotFound
+	}
+	if user.State != entity.Disabled {
+		return errAlreadyActive
+	}
+
+	if a.verifyConf.ResendCooldown > 0 {
+		if err = a.checkLock(ctx, resendLockKey(user.Id), errorStatus.ErrTooManyRequests); err != nil {
+			return err
+		}
+	}
+
+	method := entity.SendMethodEmail
+	if previous, err := a.redisRepo.GetVerification(ctx, user.Id); err == nil {
+		method = previous.Method
+	}
+	if sendMethod != "" {
+		method = sendMethod
+	}
+	if method == entity.SendMethodSms && user.PhoneNumber == "" {
+		return errorStatus.Violation("sendMethod", "no phone number on the account")
+	}
+
+	return a.sendVerification(ctx, user, method)
+}
+
+// sendVerification caches a new code of the user, replacing the previous one, starts the resend cooldown
+// and delivers it by the method
+func (a *UserUsecase) sendVerification(ctx context.Context, user *entity.Users, method string) error {
+	ctLog := log.WithFields(log.Fields{"func": "UserUsecase.sendVerification"})
+
+	now := time.Now()
+	verification := &entity.Verification{
+		Id:       uuid.New().String(),
+		Code:     generator.RandDigits(a.verifyConf.CodeLength),
+		Method:   method,
+		SentTs:   now,
+		ExpireTs: now.Add(a.verifyConf.CodeTTL),
+	}
+	if err := a.redisRepo.SetVerification(ctx, user.Id, verification); err != nil {
+		ctLog.WithError(err).Warning("a.redisRepo.SetVerification")
+		return errorStatus.ErrInternalServer
+	}
+	if a.verifyConf.ResendCooldown > 0 {
+		if err := a.redisRepo.SetLock(ctx, resendLockKey(user.Id), a.verifyConf.ResendCooldown); err != nil {
+			ctLog.WithError(err).Warning("a.redisRepo.SetLock")
+		}
+	}
+
+	if a.brokerRepo == nil {
+		ctLog.Warning("broker is not configured, the verification code is not sent")
+		return nil
+	}
+	if method == entity.SendMethodSms {
+		message := fmt.Sprintf("Your verification code is %s, it is valid for %d minutes", verification.Code, int(a.verifyConf.CodeTTL.Minutes()))
+		if err := a.brokerRepo.SendSms(ctx, user.PhoneNumber, []byte(message)); err != nil {
+			ctLog.WithError(err).Warning("a.brokerRepo.SendSms")
+			return errorStatus.ErrUnavailable
+		}
+		return nil
+	}
+
+	buff := new(bytes.Buffer)
+	err := a.verifyTmpl.Execute(buff, struct {
+		Code   string
+		Expire string
+	}{
+		Code:   verification.Code,
+		Expire: verification.ExpireTs.Format("Jan 2, 15:04 MST"),
+	})
+	if err != nil {
+		ctLog.WithError(err).Warning("a.verifyTmpl.Execute")
+		return errorStatus.ErrInternalServer
+	}
+	if err = a.brokerRepo.SendEmail(ctx, user.Email, verificationSubject, buff.Bytes()); err != nil {
+		ctLog.WithError(err).Warning("a.brokerRepo.SendEmail")
+		return errorStatus.ErrUnavailable
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	gomock "github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"go-store/internal/entity"
+	mocks "go-store/internal/user/mock"
+	errorStatus "go-store/utils/errors"
+)
+
+func TestVerification(t *testing.T) {
+	req := require.New(t)
+	any := gomock.Any()
+	ctx := context.Background()
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	pgMock := mocks.NewMockAuthPgxRepository(mockCtrl)
+	redisMock := mocks.NewMockAuthRedisRepository(mockCtrl)
+	brokerMock := mocks.NewMockAuthBroker(mockCtrl)
+
+	conf := &entity.VerificationConf{
+		CodeLength:     6,
+		CodeTTL:        15 * time.Minute,
+		MaxAttempts:    3,
+		ResendCooldown: time.Minute,
+		RateWindow:     time.Hour,
+		IPLimit:        5,
+	}
+	userUsc := NewAuthUsecase(pgMock, redisMock, brokerMock, &entity.PasswordResetConf{}, conf, &entity.MfaConf{}, &entity.LockoutConf{}, &entity.OidcConf{})
+
+	t.Run("create user and send code by sms", func(t *testing.T) {
+		user := &entity.Users{Username: "john", Email: "john@example.com", PhoneNumber: "+4912345678", State: entity.Enabled}
+		pgMock.EXPECT().Create(ctx, user).Return(nil).Times(1)
+		var verification *entity.Verification
+		redisMock.EXPECT().SetVerification(ctx, 0, any).DoAndReturn(func(_ context.Context, _ int, v *entity.Verification) error {
+			verification = v
+			return nil
+		}).Times(1)
+		redisMock.EXPECT().SetLock(ctx, "verify-resend-0", time.Minute).Return(nil).Times(1)
+		var sms []byte
+		brokerMock.EXPECT().SendSms(ctx, "+4912345678", any).DoAndReturn(func(_ context.Context, _ string, message []byte) error {
+			sms = message
+			return nil
+		}).Times(1)
+
+		sendMethod := entity.SendMethodSms
+		err := userUsc.CreateUser(ctx, user, &sendMethod)
+		req.NoError(err)
+		req.Equal(entity.Disabled, user.State)
+		req.Regexp(regexp.MustCompile(`^[0-9]{6}$`), verification.Code)
+		req.Equal(entity.SendMethodSms, verification.Method)
+		req.WithinDuration(time.Now().Add(15*time.Minute), verification.ExpireTs, time.Second)
+		req.Contains(string(sms), verification.Code)
+	})
+
+	t.Run("sms without phone", func(t *testing.T) {
+		sendMethod := entity.SendMethodSms
+		err := userUsc.CreateUser(ctx, &entity.Users{Username: "jane"}, &sendMethod)
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+
+	verification := &entity.Verification{
+		Id:       "a6c1f2de",
+		Code:     "123456",
+		Method:   entity.SendMethodEmail,
+		SentTs:   time.Now(),
+		ExpireTs: time.Now().Add(15 * time.Minute),
+	}
+
+	t.Run("activate", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(&entity.Users{Id: 7, State: entity.Disabled}, nil).Times(2)
+		redisMock.EXPECT().GetVerification(ctx, 7).Return(verification, nil).Times(2)
+		redisMock.EXPECT().IncrRate(ctx, "verify-attempts-a6c1f2de", any).Return(int64(1), nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "verify-attempts-a6c1f2de", any).Return(int64(2), nil).Times(1)
+
+		err := userUsc.ActivateUser(ctx, "john", "654321")
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+
+		pgMock.EXPECT().UpdateState(ctx, 7, entity.Enabled).Return(nil).Times(1)
+		pgMock.EXPECT().VerifyEmail(ctx, 7).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteVerification(ctx, 7).Return(nil).Times(1)
+		err = userUsc.ActivateUser(ctx, "john", "123456")
+		req.NoError(err)
+	})
+
+	t.Run("code sent by sms doesn't verify the email", func(t *testing.T) {
+		sms := *verification
+		sms.Id = "b7d2e3ef"
+		sms.Method = entity.SendMethodSms
+		pgMock.EXPECT().UserByUsername(ctx, "jane").Return(&entity.Users{Id: 8, State: entity.Disabled}, nil).Times(1)
+		redisMock.EXPECT().GetVerification(ctx, 8).Return(&sms, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "verify-attempts-b7d2e3ef", any).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UpdateState(ctx, 8, entity.Enabled).Return(nil).Times(1)
+		redisMock.EXPECT().DeleteVerification(ctx, 8).Return(nil).Times(1)
+
+		err := userUsc.ActivateUser(ctx, "jane", "123456")
+		req.NoError(err)
+	})
+
+	t.Run("too many attempts", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(&entity.Users{Id: 7, State: entity.Disabled}, nil).Times(1)
+		redisMock.EXPECT().GetVerification(ctx, 7).Return(verification, nil).Times(1)
+		redisMock.EXPECT().IncrRate(ctx, "verify-attempts-a6c1f2de", any).Return(int64(4), nil).Times(1)
+		redisMock.EXPECT().DeleteVerification(ctx, 7).Return(nil).Times(1)
+
+		// the right code doesn't help once the attempts are used up
+		err := userUsc.ActivateUser(ctx, "john", "123456")
+		req.ErrorIs(err, errTooManyAttempts)
+	})
+
+	t.Run("resend cooldown outlives the code", func(t *testing.T) {
+		redisMock.EXPECT().IncrRate(ctx, "verify-ip-10.0.0.1", time.Hour).Return(int64(1), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").Return(&entity.Users{Id: 7, State: entity.Disabled}, nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "verify-resend-7").Return(40*time.Second, nil).Times(1)
+
+		err := userUsc.ResendVerification(ctx, "john", "", "10.0.0.1")
+		req.ErrorIs(err, errorStatus.ErrTooManyRequests)
+		header, ok := errorStatus.AsError(err).RetryAfterHeader()
+		req.True(ok)
+		req.Equal("40", header)
+	})
+
+	t.Run("resend", func(t *testing.T) {
+		sent := *verification
+		sent.SentTs = time.Now().Add(-2 * time.Minute)
+		redisMock.EXPECT().IncrRate(ctx, "verify-ip-10.0.0.1", time.Hour).Return(int64(2), nil).Times(1)
+		pgMock.EXPECT().UserByUsername(ctx, "john").
+			Return(&entity.Users{Id: 7, Email: "john@example.com", State: entity.Disabled}, nil).Times(1)
+		redisMock.EXPECT().LockTTL(ctx, "verify-resend-7").Return(time.Duration(0), nil).Times(1)
+		redisMock.EXPECT().GetVerification(ctx, 7).Return(&sent, nil).Times(1)
+		var resent *entity.Verification
+		redisMock.EXPECT().SetVerification(ctx, 7, any).DoAndReturn(func(_ context.Context, _ int, v *entity.Verification) error {
+			resent = v
+			return nil
+		}).Times(1)
+		redisMock.EXPECT().SetLock(ctx, "verify-resend-7", time.Minute).Return(nil).Times(1)
+		var email []byte
+		brokerMock.EXPECT().SendEmail(ctx, "john@example.com", verificationSubject, any).DoAndReturn(func(_ context.Context, _ string, _ string, message []byte) error {
+			email = message
+			return nil
+		}).Times(1)
+
+		err := userUsc.ResendVerification(ctx, "john", "", "10.0.0.1")
+		req.NoError(err)
+		req.NotEqual(sent.Id, resent.Id)
+		req.Contains(string(email), resent.Code)
+	})
+
+	t.Run("resend limit of the ip", func(t *testing.T) {
+		redisMock.EXPECT().IncrRate(ctx, "verify-ip-10.0.0.1", time.Hour).Return(int64(6), nil).Times(1)
+
+		err := userUsc.ResendVerification(ctx, "mallory", "", "10.0.0.1")
+		req.ErrorIs(err, errorStatus.ErrTooManyRequests)
+	})
+
+	t.Run("already active", func(t *testing.T) {
+		pgMock.EXPECT().UserByUsername(ctx, "jane").Return(&entity.Users{Id: 8, State: entity.Enabled}, nil).Times(1)
+
+		err := userUsc.ResendVerification(ctx, "jane", "", "")
+		req.ErrorIs(err, errAlreadyActive)
+	})
+}
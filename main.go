@@ -1,47 +1,65 @@
 package main
 
 import (
+	"context"
+	"expvar"
 	"fmt"
 	"net"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	"go-store/conf"
 	"go-store/internal/entity"
+	"go-store/utils/broker"
 	"go-store/utils/cachestore"
 	"go-store/utils/database"
+	grpchelper "go-store/utils/grpc"
+	"go-store/utils/health"
 	v1 "go-store/utils/http"
 
+	_addressHttp "go-store/internal/address/handler/http"
 	_cartHttp "go-store/internal/cart/handler/http"
 	_catHttp "go-store/internal/category/handler/http"
 	_orderHttp "go-store/internal/order/handler/http"
 	_prodHttp "go-store/internal/product/handler/http"
+	_taxHttp "go-store/internal/tax/handler/http"
 	_userHttp "go-store/internal/user/handler/http"
 
+	_cartGrpc "go-store/internal/cart/handler/grpc"
+	_catGrpc "go-store/internal/category/handler/grpc"
 	_orderGrpc "go-store/internal/order/handler/grpc"
 	_prodGrpc "go-store/internal/product/handler/grpc"
 	_userGrpc "go-store/internal/user/handler/grpc"
 
+	_addressRepo "go-store/internal/address/repository/pgsql"
+	_cartBroker "go-store/internal/cart/repository/broker"
 	_cartRepo "go-store/internal/cart/repository/pgsql"
 	_catRepo "go-store/internal/category/repository/pgsql"
 	_optionRepo "go-store/internal/option/repository/pgsql"
 	_orderRepo "go-store/internal/order/repository/pgsql"
 	_prodRepo "go-store/internal/product/repository/pgsql"
+	_taxRepo "go-store/internal/tax/repository/pgsql"
 	_userRepo "go-store/internal/user/repository/pgsql"
 
 	_prodRedisRepo "go-store/internal/product/repository/redis"
+	_userBroker "go-store/internal/user/repository/broker"
 	_authRedisRepo "go-store/internal/user/repository/redis"
 
+	_addressUsecase "go-store/internal/address/usecase"
 	_cartUsecase "go-store/internal/cart/usecase"
 	_catUsecase "go-store/internal/category/usecase"
 	_orderUsecase "go-store/internal/order/usecase"
 	_prodUsecase "go-store/internal/product/usecase"
+	_taxUsecase "go-store/internal/tax/usecase"
 	_authUsecase "go-store/internal/user/usecase"
 )
 
@@ -50,8 +68,9 @@ func CORSMiddleware() gin.HandlerFunc {
 
 		c.Header("Access-Control-Allow-Origin", "*")
 		c.Header("Access-Control-Allow-Credentials", "true")
-		c.Header("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, x-access-token")
-		c.Header("Access-Control-Allow-Methods", "POST,HEAD,PATCH, OPTIONS, GET, PUT")
+		c.Header("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, x-access-token, x-cart-token")
+		c.Header("Access-Control-Expose-Headers", "x-cart-token")
+		c.Header("Access-Control-Allow-Methods", "POST,HEAD,PATCH, OPTIONS, GET, PUT, DELETE")
 
 		if c.Request.Method == "OPTIONS" {
 			c.AbortWithStatus(204)
@@ -86,6 +105,7 @@ func main() {
 		return
 	}
 	// defer before databse connection will closed
+	defer dbConn.Pool.Close()
 	mLog.Info("Database connected")
 
 	cacheCfg, err := configs.Cache()
@@ -104,6 +124,14 @@ func main() {
 	defer redisClient.Close()
 	mLog.Info("Redis connected")
 
+	// the dependencies are probed for the grpc.health.v1 service and the /readyz endpoint
+	healthConf := configs.HealthConf()
+	healthCheck := health.NewService(healthConf)
+	healthCheck.Add("postgres", dbConn.Pool.Ping)
+	healthCheck.Add("redis", func(ctx context.Context) error {
+		return redisClient.Ping(ctx).Err()
+	})
+
 	// next code paragraphs at bottom compiled to create clean architecture
 	// first send  database connection to authentification repository function
 	// which in turn will transmit dbConn to user repository interface
@@ -114,18 +142,54 @@ func main() {
 	categoryRepo := _catRepo.NewPgxCategoryRepository(dbConn)
 	optionRepo := _optionRepo.NewPgxOptionRepository(dbConn)
 	cartRepo := _cartRepo.NewPgxCartRepository(dbConn)
+	taxRepo := _taxRepo.NewPgxTaxRepository(dbConn)
+	addressRepo := _addressRepo.NewPgxAddressRepository(dbConn)
 
 	authRedisRepo := _authRedisRepo.NewAuthRedisRepo(redisClient)
 	prodRedisRepo := _prodRedisRepo.NewProdRedisRepo(redisClient)
 
+	secrets, err := configs.Token()
+	if err != nil {
+		mLog.WithError(err).Fatal("token conf err")
+		return
+	}
+
+	// the logins with the OpenID Connect providers are off when none is configured
+	oidcConf, err := configs.OidcConf()
+	if err != nil {
+		mLog.WithError(err).Fatal("oidc conf err")
+		return
+	}
+
+	// The emails are sent through the broker, without it the cart reminders, password resets and verification codes are off
+	var authBroker entity.AuthBroker
+	var cartBroker entity.CartBroker
+	brokerConf, err := configs.BrokerConf()
+	if err == nil && brokerConf.Host != "" {
+		smsConn, emailConn, err := broker.NewKafkaProducer(brokerConf)
+		if err != nil {
+			mLog.WithError(err).Warning("broker connection err, emails are off")
+		} else {
+			defer smsConn.Close()
+			defer emailConn.Close()
+			authBroker = _userBroker.NewUserBroker(emailConn, smsConn)
+			cartBroker = _cartBroker.NewCartBroker(emailConn)
+			healthCheck.Add("kafka", func(ctx context.Context) error {
+				return broker.Ping(ctx, brokerConf)
+			})
+		}
+	}
+
 	// Second send repository variable to usecase(Application Buseness Rule, usecase) interface
 	// which contain available methods of usecase. In this way we can access to repository methods from usecase
 	// then create varible of usecase
-	authUsecase := _authUsecase.NewAuthUsecase(authPgxRepo, authRedisRepo)
+	authUsecase := _authUsecase.NewAuthUsecase(authPgxRepo, authRedisRepo, authBroker, configs.PasswordResetConf(), configs.VerificationConf(), configs.MfaConf(), configs.LockoutConf(), oidcConf)
 	prodUsecase := _prodUsecase.NewProductUsecase(prodRepo, prodRedisRepo, optionRepo)
-	orderUsecase := _orderUsecase.NewOrderUsecase(orderRepo)
+	orderUsecase := _orderUsecase.NewOrderUsecase(orderRepo, taxRepo, addressRepo)
 	categoryUsecase := _catUsecase.NewCategoryUsecase(categoryRepo, optionRepo)
-	cartUsecase := _cartUsecase.NewCartUsecase(cartRepo, prodRepo)
+	cartUsecase := _cartUsecase.NewCartUsecase(cartRepo, prodRepo, optionRepo, secrets.CartSecret)
+	taxUsecase := _taxUsecase.NewTaxUsecase(taxRepo)
+	addressUsecase := _addressUsecase.NewAddressUsecase(addressRepo)
 
 	uc := &entity.Usecases{
 		UserUsecase:     authUsecase,
@@ -133,7 +197,19 @@ func main() {
 		OrderUsecase:    orderUsecase,
 		CategoryUsecase: categoryUsecase,
 		CartUsecase:     cartUsecase,
+		TaxUsecase:      taxUsecase,
+		AddressUsecase:  addressUsecase,
+	}
+
+	// Abandoned cart reminders are sent through the broker, without it the job stays off
+	jobCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+	if cartBroker != nil {
+		cartReminder := _cartUsecase.NewCartReminderUsecase(cartRepo, cartBroker, configs.CartReminderConf())
+		go cartReminder.Run(jobCtx)
+		mLog.Info("Cart reminders started")
 	}
+	go healthCheck.Run(jobCtx)
 
 	// HTTP Server
 	httpConf, err := configs.HTTP()
@@ -143,15 +219,24 @@ func main() {
 		return
 	}
 
-	secrets := configs.Token()
-
 	router := gin.New()
+	// the client address limits the failed logins and the password resets, it is only
+	// taken from the forwarded headers of the configured proxies
+	if err = router.SetTrustedProxies(httpConf.TrustedProxies); err != nil {
+		mLog.WithError(err).Warning("http trusted proxies err")
+		return
+	}
 	router.Use(CORSMiddleware())
 	// Options
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
 	router.Static("/static", "./static")
+	router.GET("/healthz", healthCheck.Healthz)
+	router.GET("/readyz", healthCheck.Readyz)
+	router.GET("/.well-known/jwks.json", _userHttp.JWKS(secrets))
 	middleware := _userHttp.ValidateJWT(authUsecase, secrets)
+	// the counters and the process memstats and cmdline are for the staff only
+	router.GET("/debug/vars", middleware, v1.RequirePermission(entity.PermMetricsRead), gin.WrapH(expvar.Handler()))
 	// Routers
 	h := router.Group("/api/v1")
 	{
@@ -160,8 +245,33 @@ func main() {
 		_prodHttp.NewProductHandler(h, middleware, uc, mLog)
 		_catHttp.NewCategoryHandler(h, middleware, uc, mLog)
 		_cartHttp.NewCartHandler(h, middleware, uc, mLog)
+		_taxHttp.NewTaxHandler(h, middleware, uc, mLog)
+		_addressHttp.NewAddressHandler(h, middleware, uc, mLog)
 	}
 	// v1.NewRouter(router, mLog, uc)
+
+	grpcCfg := configs.GrpcConf()
+	host := fmt.Sprintf("%s:%s", grpcCfg.Host, grpcCfg.Port)
+
+	// the gateway transcodes the REST calls of the proto http annotations to the gRPC server,
+	// so the interceptors apply to both transports
+	gwConn, err := grpc.DialContext(jobCtx, host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		mLog.WithError(err).Warning("grpc gateway connection err")
+		return
+	}
+	defer gwConn.Close()
+	gwMux := grpchelper.NewGatewayMux()
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		_userGrpc.RegisterGateway, _orderGrpc.RegisterGateway, _prodGrpc.RegisterGateway, _cartGrpc.RegisterGateway, _catGrpc.RegisterGateway,
+	} {
+		if err = register(jobCtx, gwMux, gwConn); err != nil {
+			mLog.WithError(err).Warning("grpc gateway register err")
+			return
+		}
+	}
+	router.Any("/api/v2/*path", grpchelper.GatewayHandler(gwMux))
+
 	httpServer, err := v1.NewService(router, httpConf)
 	if err != nil {
 		// handle http connection error and log it
@@ -169,14 +279,6 @@ func main() {
 		return
 	}
 
-	grpcCfg := configs.GrpcConf()
-	type grpcServer struct {
-		usecases *entity.Usecases
-		_userGrpc.UnimplementedGrpcHandlerServer
-		_orderGrpc.UnimplementedOrderHandlerServer
-		_prodGrpc.UnimplementedProductHandlerServer
-	}
-	host := fmt.Sprintf("%s:%s", grpcCfg.Host, grpcCfg.Port)
 	lis, err := net.Listen("tcp", host)
 	if err != nil {
 		mLog.Warning("grpc Connection Create: ", err)
@@ -184,23 +286,33 @@ func main() {
 	}
 	mLog.Info("gRPC server, listening on ", host)
 
-	gsrv := grpc.NewServer()
-	srv := grpcServer{
-		usecases: uc,
+	// the interceptors resolve the user of the bearer token like the http middleware
+	// and enforce the permissions of the methods, the methods missing from the policy are denied
+	grpcPolicy := _userGrpc.MethodPermissions{}
+	for _, methodPermissions := range []map[string]entity.Permission{
+		_userGrpc.ServerPermissions, _userGrpc.AuthPermissions, _orderGrpc.AuthPermissions,
+		_prodGrpc.AuthPermissions, _catGrpc.AuthPermissions, _cartGrpc.AuthPermissions,
+	} {
+		for method, perm := range methodPermissions {
+			grpcPolicy[method] = perm
+		}
 	}
-	_userGrpc.RegisterGrpcHandlerServer(gsrv, &srv)
-	_orderGrpc.RegisterOrderHandlerServer(gsrv, &srv)
-	_prodGrpc.RegisterProductHandlerServer(gsrv, &srv)
+	gsrv := grpc.NewServer(
+		grpc.UnaryInterceptor(_userGrpc.UnaryAuthInterceptor(authUsecase, secrets, grpcPolicy)),
+		grpc.StreamInterceptor(_userGrpc.StreamAuthInterceptor(authUsecase, secrets, grpcPolicy)),
+	)
+	_userGrpc.RegisterServices(gsrv, uc, secrets)
+	_orderGrpc.RegisterServices(gsrv, uc)
+	_prodGrpc.RegisterServices(gsrv, uc)
+	_cartGrpc.RegisterServices(gsrv, uc)
+	_catGrpc.RegisterServices(gsrv, uc)
+	healthCheck.Register(gsrv)
 	// Register reflection service on gRPC server.
 	reflection.Register(gsrv)
 
-	defer gsrv.GracefulStop()
-
+	grpcNotify := make(chan error, 1)
 	go func() {
-		if err := gsrv.Serve(lis); err != nil {
-			mLog.WithError(err).Panic("Cannot serve user grpc server")
-			return
-		}
+		grpcNotify <- gsrv.Serve(lis)
 	}()
 
 	// Waiting signal
@@ -212,11 +324,19 @@ func main() {
 		mLog.Info("app - Run - signal: " + s.String())
 	case err = <-httpServer.Notify():
 		mLog.Error(fmt.Errorf("app - Run - httpServer.Notify: %w", err))
+	case err = <-grpcNotify:
+		mLog.Error(fmt.Errorf("app - Run - grpcServer.Serve: %w", err))
 	}
 
-	// Shutdown
+	// Shutdown, the readiness is flipped first so the load balancers stop routing
+	// to the instance, then both servers drain the calls in flight
+	healthCheck.Shutdown()
+	time.Sleep(healthConf.DrainDelay)
+
 	err = httpServer.Shutdown()
 	if err != nil {
 		mLog.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
 	}
+	health.GracefulStop(gsrv, healthConf.ShutdownTimeout)
+	mLog.Info("app - Run - servers stopped")
 }
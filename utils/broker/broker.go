@@ -34,3 +34,13 @@ func NewKafkaProducer(cfg *BrokerConfig) (smsConn *kafka.Conn, emailConn *kafka.
 
 	return smsConn, emailConn, nil
 }
+
+// Ping dials the broker on a connection of its own, the producer connections
+// are not shared with the health checks
+func Ping(ctx context.Context, cfg *BrokerConfig) error {
+	conn, err := kafka.DialContext(ctx, "tcp", fmt.Sprintf("%s:%s", cfg.Host, cfg.Port))
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
@@ -0,0 +1,25 @@
+package broker
+
+import (
+	_ "embed"
+)
+
+// CartReminderTemplate is the html/template of the abandoned cart reminder email
+//
+//go:embed cart_reminder.html
+var CartReminderTemplate string
+
+// PasswordResetTemplate is the html/template of the password reset email
+//
+//go:embed password_reset.html
+var PasswordResetTemplate string
+
+// VerificationTemplate is the html/template of the account verification email
+//
+//go:embed email.html
+var VerificationTemplate string
+
+// AccountLockTemplate is the html/template of the account lockout and unlock emails
+//
+//go:embed account_lock.html
+var AccountLockTemplate string
@@ -24,7 +24,8 @@ CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 CREATE TYPE public.statet AS ENUM (
     'enabled',
     'disabled',
-    'deleted'
+    'deleted',
+    'blocked'
 );
 
 
@@ -37,6 +38,9 @@ ALTER TYPE public.statet OWNER TO market;
 CREATE TYPE public.userrole AS ENUM (
     'SUPERADMIN',
     'ADMIN',
+    'CATALOG_MANAGER',
+    'ORDER_OPERATOR',
+    'SUPPORT_AGENT',
     'USER'
 );
 
@@ -47,6 +51,55 @@ SET default_tablespace = '';
 
 SET default_table_access_method = heap;
 
+--
+-- Name: address; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.address (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    recipient character varying(100) NOT NULL,
+    phone character varying(70) NOT NULL,
+    country character varying(50) NOT NULL,
+    region_id integer,
+    city character varying(100) NOT NULL,
+    street character varying(200) NOT NULL,
+    postcode character varying(20),
+    latitude double precision DEFAULT 0 NOT NULL,
+    longitude double precision DEFAULT 0 NOT NULL,
+    default_shipping boolean DEFAULT false NOT NULL,
+    default_billing boolean DEFAULT false NOT NULL,
+    create_ts timestamp without time zone NOT NULL,
+    update_ts timestamp without time zone NOT NULL,
+    state public.statet NOT NULL,
+    version integer
+);
+
+
+ALTER TABLE public.address OWNER TO market;
+
+--
+-- Name: address_id_seq; Type: SEQUENCE; Schema: public; Owner: market
+--
+
+CREATE SEQUENCE public.address_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.address_id_seq OWNER TO market;
+
+--
+-- Name: address_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: market
+--
+
+ALTER SEQUENCE public.address_id_seq OWNED BY public.address.id;
+
+
 --
 -- Name: brand; Type: TABLE; Schema: public; Owner: market
 --
@@ -92,18 +145,61 @@ ALTER SEQUENCE public.brand_id_seq OWNED BY public.brand.id;
 --
 
 CREATE TABLE public.cart (
-    user_id integer NOT NULL,
+    user_id integer,
     sku_id bigint NOT NULL,
     quantity integer NOT NULL,
     create_ts timestamp without time zone NOT NULL,
     update_ts timestamp without time zone NOT NULL,
     state public.statet NOT NULL,
-    version integer
+    version integer,
+    guest_id uuid,
+    price double precision DEFAULT 0 NOT NULL,
+    CONSTRAINT cart_owner_check CHECK (((user_id IS NOT NULL) OR (guest_id IS NOT NULL)))
 );
 
 
 ALTER TABLE public.cart OWNER TO market;
 
+--
+-- Name: cart_reminder; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.cart_reminder (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    stage integer NOT NULL,
+    token uuid NOT NULL,
+    coupon_code character varying(20),
+    sent_ts timestamp without time zone NOT NULL,
+    click_ts timestamp without time zone,
+    recovered_ts timestamp without time zone
+);
+
+
+ALTER TABLE public.cart_reminder OWNER TO market;
+
+--
+-- Name: cart_reminder_id_seq; Type: SEQUENCE; Schema: public; Owner: market
+--
+
+CREATE SEQUENCE public.cart_reminder_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.cart_reminder_id_seq OWNER TO market;
+
+--
+-- Name: cart_reminder_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: market
+--
+
+ALTER SEQUENCE public.cart_reminder_id_seq OWNED BY public.cart_reminder.id;
+
+
 --
 -- Name: category; Type: TABLE; Schema: public; Owner: market
 --
@@ -145,6 +241,24 @@ ALTER TABLE public.category_id_seq OWNER TO market;
 ALTER SEQUENCE public.category_id_seq OWNED BY public.category.id;
 
 
+--
+-- Name: coupon; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.coupon (
+    code character varying(20) NOT NULL,
+    user_id integer NOT NULL,
+    percent double precision NOT NULL,
+    expire_ts timestamp without time zone NOT NULL,
+    used_ts timestamp without time zone,
+    order_id uuid,
+    create_ts timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.coupon OWNER TO market;
+
+
 --
 -- Name: currency; Type: TABLE; Schema: public; Owner: market
 --
@@ -283,6 +397,44 @@ ALTER TABLE public.option_value_id_seq OWNER TO market;
 ALTER SEQUENCE public.option_value_id_seq OWNED BY public.option_value.id;
 
 
+--
+-- Name: order_event; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.order_event (
+    id integer NOT NULL,
+    order_id uuid NOT NULL,
+    user_id integer,
+    type character varying(20) NOT NULL,
+    status character varying(50) NOT NULL,
+    create_ts timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.order_event OWNER TO market;
+
+--
+-- Name: order_event_id_seq; Type: SEQUENCE; Schema: public; Owner: market
+--
+
+CREATE SEQUENCE public.order_event_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.order_event_id_seq OWNER TO market;
+
+--
+-- Name: order_event_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: market
+--
+
+ALTER SEQUENCE public.order_event_id_seq OWNED BY public.order_event.id;
+
+
 --
 -- Name: order_item; Type: TABLE; Schema: public; Owner: market
 --
@@ -296,7 +448,10 @@ CREATE TABLE public.order_item (
     create_ts timestamp without time zone NOT NULL,
     update_ts timestamp without time zone NOT NULL,
     state public.statet NOT NULL,
-    version integer
+    version integer,
+    tax_rate real DEFAULT 0 NOT NULL,
+    tax_amount double precision DEFAULT 0 NOT NULL,
+    subtotal double precision DEFAULT 0 NOT NULL
 );
 
 
@@ -330,7 +485,7 @@ ALTER SEQUENCE public.order_item_id_seq OWNED BY public.order_item.id;
 
 CREATE TABLE public.orders (
     id uuid NOT NULL,
-    user_id integer NOT NULL,
+    user_id integer,
     address character varying(100),
     phone character varying(100),
     comment text,
@@ -339,7 +494,22 @@ CREATE TABLE public.orders (
     create_ts timestamp without time zone NOT NULL,
     update_ts timestamp without time zone NOT NULL,
     state public.statet NOT NULL,
-    version integer
+    version integer,
+    region_id integer,
+    address_id integer,
+    recipient character varying(100),
+    country character varying(50),
+    city character varying(100),
+    street character varying(200),
+    postcode character varying(20),
+    latitude double precision,
+    longitude double precision,
+    subtotal double precision DEFAULT 0 NOT NULL,
+    tax_total double precision DEFAULT 0 NOT NULL,
+    shipping_total double precision DEFAULT 0 NOT NULL,
+    discount_total double precision DEFAULT 0 NOT NULL,
+    total double precision DEFAULT 0 NOT NULL,
+    email character varying(70)
 );
 
 
@@ -359,7 +529,8 @@ CREATE TABLE public.product (
     create_ts timestamp without time zone NOT NULL,
     update_ts timestamp without time zone NOT NULL,
     state public.statet NOT NULL,
-    version integer
+    version integer,
+    tax_class_id integer
 );
 
 
@@ -442,7 +613,8 @@ CREATE TABLE public.sku (
     create_ts timestamp without time zone NOT NULL,
     update_ts timestamp without time zone NOT NULL,
     state public.statet NOT NULL,
-    version integer
+    version integer,
+    max_per_order integer DEFAULT 0 NOT NULL
 );
 
 
@@ -508,6 +680,142 @@ ALTER TABLE public.sku_value_id_seq OWNER TO market;
 ALTER SEQUENCE public.sku_value_id_seq OWNED BY public.sku_value.id;
 
 
+--
+-- Name: tax_class; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.tax_class (
+    id integer NOT NULL,
+    name character varying(50) NOT NULL,
+    description text,
+    create_ts timestamp without time zone NOT NULL,
+    update_ts timestamp without time zone NOT NULL,
+    state public.statet NOT NULL,
+    version integer
+);
+
+
+ALTER TABLE public.tax_class OWNER TO market;
+
+--
+-- Name: tax_class_id_seq; Type: SEQUENCE; Schema: public; Owner: market
+--
+
+CREATE SEQUENCE public.tax_class_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.tax_class_id_seq OWNER TO market;
+
+--
+-- Name: tax_class_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: market
+--
+
+ALTER SEQUENCE public.tax_class_id_seq OWNED BY public.tax_class.id;
+
+
+--
+-- Name: tax_rate; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.tax_rate (
+    id integer NOT NULL,
+    tax_class_id integer NOT NULL,
+    region_id integer NOT NULL,
+    name character varying(50),
+    rate real NOT NULL,
+    inclusive boolean DEFAULT false NOT NULL,
+    create_ts timestamp without time zone NOT NULL,
+    update_ts timestamp without time zone NOT NULL,
+    state public.statet NOT NULL,
+    version integer
+);
+
+
+ALTER TABLE public.tax_rate OWNER TO market;
+
+--
+-- Name: tax_rate_id_seq; Type: SEQUENCE; Schema: public; Owner: market
+--
+
+CREATE SEQUENCE public.tax_rate_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.tax_rate_id_seq OWNER TO market;
+
+--
+-- Name: tax_rate_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: market
+--
+
+ALTER SEQUENCE public.tax_rate_id_seq OWNED BY public.tax_rate.id;
+
+
+--
+-- Name: user_audit; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.user_audit (
+    id integer NOT NULL,
+    user_id integer NOT NULL,
+    actor_id integer NOT NULL,
+    action character varying(30) NOT NULL,
+    detail character varying(100),
+    reason text,
+    create_ts timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.user_audit OWNER TO market;
+
+--
+-- Name: user_audit_id_seq; Type: SEQUENCE; Schema: public; Owner: market
+--
+
+CREATE SEQUENCE public.user_audit_id_seq
+    AS integer
+    START WITH 1
+    INCREMENT BY 1
+    NO MINVALUE
+    NO MAXVALUE
+    CACHE 1;
+
+
+ALTER TABLE public.user_audit_id_seq OWNER TO market;
+
+--
+-- Name: user_audit_id_seq; Type: SEQUENCE OWNED BY; Schema: public; Owner: market
+--
+
+ALTER SEQUENCE public.user_audit_id_seq OWNED BY public.user_audit.id;
+
+
+--
+-- Name: user_identity; Type: TABLE; Schema: public; Owner: market
+--
+
+CREATE TABLE public.user_identity (
+    provider character varying(30) NOT NULL,
+    subject character varying(255) NOT NULL,
+    user_id integer NOT NULL,
+    email character varying(70),
+    create_ts timestamp without time zone NOT NULL
+);
+
+
+ALTER TABLE public.user_identity OWNER TO market;
+
+
 --
 -- Name: users; Type: TABLE; Schema: public; Owner: market
 --
@@ -520,14 +828,18 @@ CREATE TABLE public.users (
     email character varying(70),
     phone_number character varying(70),
     address character varying(70),
-    photo character varying(70),
+    photo character varying(255),
     role public.userrole NOT NULL,
     region_id integer,
     parent integer NOT NULL,
     create_ts timestamp without time zone NOT NULL,
     update_ts timestamp without time zone NOT NULL,
     state public.statet NOT NULL,
-    version integer
+    version integer,
+    totp_secret character varying(64),
+    recovery_codes text[],
+    fullname character varying(100),
+    email_verified boolean DEFAULT false NOT NULL
 );
 
 
@@ -555,6 +867,13 @@ ALTER TABLE public.users_id_seq OWNER TO market;
 ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id;
 
 
+--
+-- Name: address id; Type: DEFAULT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.address ALTER COLUMN id SET DEFAULT nextval('public.address_id_seq'::regclass);
+
+
 --
 -- Name: brand id; Type: DEFAULT; Schema: public; Owner: market
 --
@@ -562,6 +881,13 @@ ALTER SEQUENCE public.users_id_seq OWNED BY public.users.id;
 ALTER TABLE ONLY public.brand ALTER COLUMN id SET DEFAULT nextval('public.brand_id_seq'::regclass);
 
 
+--
+-- Name: cart_reminder id; Type: DEFAULT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.cart_reminder ALTER COLUMN id SET DEFAULT nextval('public.cart_reminder_id_seq'::regclass);
+
+
 --
 -- Name: category id; Type: DEFAULT; Schema: public; Owner: market
 --
@@ -590,6 +916,13 @@ ALTER TABLE ONLY public.option ALTER COLUMN id SET DEFAULT nextval('public.optio
 ALTER TABLE ONLY public.option_value ALTER COLUMN id SET DEFAULT nextval('public.option_value_id_seq'::regclass);
 
 
+--
+-- Name: order_event id; Type: DEFAULT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.order_event ALTER COLUMN id SET DEFAULT nextval('public.order_event_id_seq'::regclass);
+
+
 --
 -- Name: order_item id; Type: DEFAULT; Schema: public; Owner: market
 --
@@ -625,6 +958,27 @@ ALTER TABLE ONLY public.sku ALTER COLUMN id SET DEFAULT nextval('public.sku_id_s
 ALTER TABLE ONLY public.sku_value ALTER COLUMN id SET DEFAULT nextval('public.sku_value_id_seq'::regclass);
 
 
+--
+-- Name: tax_class id; Type: DEFAULT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.tax_class ALTER COLUMN id SET DEFAULT nextval('public.tax_class_id_seq'::regclass);
+
+
+--
+-- Name: tax_rate id; Type: DEFAULT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.tax_rate ALTER COLUMN id SET DEFAULT nextval('public.tax_rate_id_seq'::regclass);
+
+
+--
+-- Name: user_audit id; Type: DEFAULT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.user_audit ALTER COLUMN id SET DEFAULT nextval('public.user_audit_id_seq'::regclass);
+
+
 --
 -- Name: users id; Type: DEFAULT; Schema: public; Owner: market
 --
@@ -916,6 +1270,14 @@ SELECT pg_catalog.setval('public.sku_value_id_seq', 1, false);
 SELECT pg_catalog.setval('public.users_id_seq', 4, true);
 
 
+--
+-- Name: address address_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.address
+    ADD CONSTRAINT address_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: brand brand_pkey; Type: CONSTRAINT; Schema: public; Owner: market
 --
@@ -925,11 +1287,35 @@ ALTER TABLE ONLY public.brand
 
 
 --
--- Name: cart cart_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+-- Name: cart cart_guest_id_sku_id_key; Type: CONSTRAINT; Schema: public; Owner: market
 --
 
 ALTER TABLE ONLY public.cart
-    ADD CONSTRAINT cart_pkey PRIMARY KEY (user_id, sku_id);
+    ADD CONSTRAINT cart_guest_id_sku_id_key UNIQUE (guest_id, sku_id);
+
+
+--
+-- Name: cart cart_user_id_sku_id_key; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.cart
+    ADD CONSTRAINT cart_user_id_sku_id_key UNIQUE (user_id, sku_id);
+
+
+--
+-- Name: cart_reminder cart_reminder_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.cart_reminder
+    ADD CONSTRAINT cart_reminder_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: cart_reminder cart_reminder_token_key; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.cart_reminder
+    ADD CONSTRAINT cart_reminder_token_key UNIQUE (token);
 
 
 --
@@ -940,6 +1326,14 @@ ALTER TABLE ONLY public.category
     ADD CONSTRAINT category_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: coupon coupon_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.coupon
+    ADD CONSTRAINT coupon_pkey PRIMARY KEY (code);
+
+
 --
 -- Name: currency currency_pkey; Type: CONSTRAINT; Schema: public; Owner: market
 --
@@ -980,6 +1374,14 @@ ALTER TABLE ONLY public.option_value
     ADD CONSTRAINT option_value_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: order_event order_event_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.order_event
+    ADD CONSTRAINT order_event_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: order_item order_item_pkey; Type: CONSTRAINT; Schema: public; Owner: market
 --
@@ -1036,6 +1438,38 @@ ALTER TABLE ONLY public.sku_value
     ADD CONSTRAINT sku_value_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: tax_class tax_class_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.tax_class
+    ADD CONSTRAINT tax_class_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: tax_rate tax_rate_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.tax_rate
+    ADD CONSTRAINT tax_rate_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_audit user_audit_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.user_audit
+    ADD CONSTRAINT user_audit_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: user_identity user_identity_pkey; Type: CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.user_identity
+    ADD CONSTRAINT user_identity_pkey PRIMARY KEY (provider, subject);
+
+
 --
 -- Name: users users_email_key; Type: CONSTRAINT; Schema: public; Owner: market
 --
@@ -1084,6 +1518,22 @@ ALTER TABLE ONLY public.users
     ADD CONSTRAINT users_username_key UNIQUE (username);
 
 
+--
+-- Name: address address_region_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.address
+    ADD CONSTRAINT address_region_id_fkey FOREIGN KEY (region_id) REFERENCES public.region(id);
+
+
+--
+-- Name: address address_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.address
+    ADD CONSTRAINT address_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);
+
+
 --
 -- Name: cart cart_sku_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
 --
@@ -1100,6 +1550,30 @@ ALTER TABLE ONLY public.cart
     ADD CONSTRAINT cart_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);
 
 
+--
+-- Name: cart_reminder cart_reminder_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.cart_reminder
+    ADD CONSTRAINT cart_reminder_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);
+
+
+--
+-- Name: coupon coupon_order_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.coupon
+    ADD CONSTRAINT coupon_order_id_fkey FOREIGN KEY (order_id) REFERENCES public.orders(id);
+
+
+--
+-- Name: coupon coupon_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.coupon
+    ADD CONSTRAINT coupon_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id);
+
+
 --
 -- Name: discount discount_product_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
 --
@@ -1132,6 +1606,14 @@ ALTER TABLE ONLY public.option_value
     ADD CONSTRAINT option_value_option_id_fkey FOREIGN KEY (option_id) REFERENCES public.option(id);
 
 
+--
+-- Name: order_event order_event_order_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.order_event
+    ADD CONSTRAINT order_event_order_id_fkey FOREIGN KEY (order_id) REFERENCES public.orders(id);
+
+
 --
 -- Name: order_item order_item_order_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
 --
@@ -1212,6 +1694,46 @@ ALTER TABLE ONLY public.sku_value
     ADD CONSTRAINT sku_value_sku_id_fkey FOREIGN KEY (sku_id) REFERENCES public.sku(id);
 
 
+--
+-- Name: product product_tax_class_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.product
+    ADD CONSTRAINT product_tax_class_id_fkey FOREIGN KEY (tax_class_id) REFERENCES public.tax_class(id);
+
+
+--
+-- Name: tax_rate tax_rate_region_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.tax_rate
+    ADD CONSTRAINT tax_rate_region_id_fkey FOREIGN KEY (region_id) REFERENCES public.region(id);
+
+
+--
+-- Name: tax_rate tax_rate_tax_class_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.tax_rate
+    ADD CONSTRAINT tax_rate_tax_class_id_fkey FOREIGN KEY (tax_class_id) REFERENCES public.tax_class(id);
+
+
+--
+-- Name: user_audit user_audit_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.user_audit
+    ADD CONSTRAINT user_audit_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
+--
+-- Name: user_identity user_identity_user_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
+--
+
+ALTER TABLE ONLY public.user_identity
+    ADD CONSTRAINT user_identity_user_id_fkey FOREIGN KEY (user_id) REFERENCES public.users(id) ON DELETE CASCADE;
+
+
 --
 -- Name: users users_region_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: market
 --
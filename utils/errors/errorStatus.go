@@ -1,7 +1,11 @@
 package errors
 
 import (
+	"errors"
+	"math"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 //Handling Errors
@@ -11,25 +15,127 @@ type APIError interface {
 	APIError() (int, string)
 }
 
-type sentinelAPIError struct {
-	status int
-	msg    string
+// Code is the transport independent kind of an error, the transports map it
+// to the HTTP status and to the gRPC code
+type Code string
+
+const (
+	CodeInvalidArgument    Code = "invalid_argument"
+	CodeUnauthenticated    Code = "unauthenticated"
+	CodePermissionDenied   Code = "permission_denied"
+	CodeNotFound           Code = "not_found"
+	CodeAlreadyExists      Code = "already_exists"
+	CodeFailedPrecondition Code = "failed_precondition"
+	CodeResourceExhausted  Code = "resource_exhausted"
+	CodeUnavailable        Code = "unavailable"
+	CodeUnimplemented      Code = "unimplemented"
+	CodeInternal           Code = "internal"
+)
+
+var codeStatus = map[Code]int{
+	CodeInvalidArgument:    http.StatusBadRequest,
+	CodeUnauthenticated:    http.StatusUnauthorized,
+	CodePermissionDenied:   http.StatusForbidden,
+	CodeNotFound:           http.StatusNotFound,
+	CodeAlreadyExists:      http.StatusConflict,
+	CodeFailedPrecondition: http.StatusConflict,
+	CodeResourceExhausted:  http.StatusTooManyRequests,
+	CodeUnavailable:        http.StatusServiceUnavailable,
+	CodeUnimplemented:      http.StatusNotImplemented,
+	CodeInternal:           http.StatusInternalServerError,
+}
+
+// FieldViolation describes the invalid field of a request
+type FieldViolation struct {
+	Field       string `json:"name"`
+	Description string `json:"reason"`
+}
+
+// Error is the domain error returned by the usecases, the message is safe to show to the clients.
+// Retryable errors may succeed when the same call is repeated, after RetryAfter if it is set
+type Error struct {
+	Code       Code
+	Message    string
+	Violations []FieldViolation
+	Retryable  bool
+	RetryAfter time.Duration
+}
+
+// New returns the error of the code with the message
+func New(code Code, msg string) *Error {
+	return &Error{Code: code, Message: msg}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Status returns the HTTP status of the error code
+func (e *Error) Status() int {
+	if status, ok := codeStatus[e.Code]; ok {
+		return status
+	}
+	return http.StatusInternalServerError
+}
+
+func (e *Error) APIError() (int, string) {
+	return e.Status(), e.Message
+}
+
+// Is matches the errors of the same code and message, so a sentinel still matches
+// the copies made by WithViolations and WithRetry
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && t.Code == e.Code && t.Message == e.Message
+}
+
+// WithViolations returns a copy of the error listing the invalid fields
+func (e *Error) WithViolations(violations ...FieldViolation) *Error {
+	err := *e
+	err.Violations = append(append([]FieldViolation(nil), e.Violations...), violations...)
+	return &err
+}
+
+// WithRetry returns a retryable copy of the error, a zero delay leaves the backoff to the client
+func (e *Error) WithRetry(after time.Duration) *Error {
+	err := *e
+	err.Retryable = true
+	err.RetryAfter = after
+	return &err
+}
+
+// RetryAfterHeader returns the Retry-After header value in seconds, if the delay is set
+func (e *Error) RetryAfterHeader() (string, bool) {
+	if e.RetryAfter <= 0 {
+		return "", false
+	}
+	return strconv.Itoa(int(math.Ceil(e.RetryAfter.Seconds()))), true
 }
 
-func (e sentinelAPIError) Error() string {
-	return e.msg
+// AsError returns the domain error of the chain, the other errors are internal ones
+// and their text is not shown to the clients
+func AsError(err error) *Error {
+	var domainErr *Error
+	if errors.As(err, &domainErr) {
+		return domainErr
+	}
+	return ErrInternalServer
 }
 
-func (e sentinelAPIError) APIError() (int, string) {
-	return e.status, e.msg
+// Violation returns ErrBadReq listing the invalid field
+func Violation(field, description string) *Error {
+	return ErrBadReq.WithViolations(FieldViolation{Field: field, Description: description})
 }
 
 var (
-	ErrAuth           = &sentinelAPIError{status: http.StatusUnauthorized, msg: "not authorized"}
-	ErrToken          = &sentinelAPIError{status: http.StatusUnauthorized, msg: "invalid token"}
-	ErrPermission     = &sentinelAPIError{status: http.StatusNetworkAuthenticationRequired, msg: "permission denied"}
-	ErrNotFound       = &sentinelAPIError{status: http.StatusNotFound, msg: "not found"}
-	ErrBadReq         = &sentinelAPIError{status: http.StatusBadRequest, msg: "bad request"}
-	ErrDuplicate      = &sentinelAPIError{status: http.StatusBadRequest, msg: "duplicate"}
-	ErrInternalServer = &sentinelAPIError{status: http.StatusInternalServerError, msg: "internal server"}
+	ErrAuth            = New(CodeUnauthenticated, "not authorized")
+	ErrToken           = New(CodeUnauthenticated, "invalid token")
+	ErrPermission      = New(CodePermissionDenied, "permission denied")
+	ErrNotFound        = New(CodeNotFound, "not found")
+	ErrBadReq          = New(CodeInvalidArgument, "bad request")
+	ErrDuplicate       = New(CodeAlreadyExists, "duplicate")
+	ErrOutOfStock      = New(CodeFailedPrecondition, "out of stock")
+	ErrTooManyRequests = &Error{Code: CodeResourceExhausted, Message: "too many requests", Retryable: true}
+	ErrUnavailable     = &Error{Code: CodeUnavailable, Message: "service unavailable", Retryable: true}
+	ErrInternalServer  = New(CodeInternal, "internal server")
 )
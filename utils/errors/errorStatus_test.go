@@ -0,0 +1,102 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorStatus(t *testing.T) {
+	for _, tc := range []struct {
+		err    *Error
+		status int
+	}{
+		{ErrBadReq, http.StatusBadRequest},
+		{ErrAuth, http.StatusUnauthorized},
+		{ErrToken, http.StatusUnauthorized},
+		{ErrPermission, http.StatusForbidden},
+		{ErrNotFound, http.StatusNotFound},
+		{ErrDuplicate, http.StatusConflict},
+		{ErrOutOfStock, http.StatusConflict},
+		{ErrTooManyRequests, http.StatusTooManyRequests},
+		{ErrUnavailable, http.StatusServiceUnavailable},
+		{ErrInternalServer, http.StatusInternalServerError},
+		{New("unknown", "unknown"), http.StatusInternalServerError},
+	} {
+		require.Equal(t, tc.status, tc.err.Status(), tc.err.Message)
+	}
+}
+
+func TestError(t *testing.T) {
+	req := require.New(t)
+
+	t.Run("copies match their sentinel", func(t *testing.T) {
+		req.ErrorIs(Violation("email", "required"), ErrBadReq)
+		req.ErrorIs(ErrTooManyRequests.WithRetry(time.Second), ErrTooManyRequests)
+		req.NotErrorIs(ErrToken, ErrAuth)
+	})
+
+	t.Run("violations are appended to a copy", func(t *testing.T) {
+		first := Violation("email", "required")
+		second := first.WithViolations(FieldViolation{Field: "phone", Description: "required"})
+
+		req.Len(first.Violations, 1)
+		req.Len(second.Violations, 2)
+		req.Empty(ErrBadReq.Violations)
+	})
+
+	t.Run("domain error of a wrapped error", func(t *testing.T) {
+		err := fmt.Errorf("create user: %w", ErrDuplicate)
+		req.Equal(ErrDuplicate, AsError(err))
+	})
+
+	t.Run("other errors are internal", func(t *testing.T) {
+		req.Equal(ErrInternalServer, AsError(errors.New("pq: connection reset")))
+	})
+
+	t.Run("retry after is rounded up to seconds", func(t *testing.T) {
+		header, ok := ErrTooManyRequests.WithRetry(1500 * time.Millisecond).RetryAfterHeader()
+		req.True(ok)
+		req.Equal("2", header)
+
+		_, ok = ErrTooManyRequests.RetryAfterHeader()
+		req.False(ok)
+	})
+}
+
+func TestNewProblem(t *testing.T) {
+	req := require.New(t)
+
+	t.Run("duplicate is a conflict", func(t *testing.T) {
+		problem := NewProblem(fmt.Errorf("update email: %w", ErrDuplicate), "/api/v1/user/email")
+		req.Equal(&Problem{
+			Type:     "about:blank",
+			Title:    http.StatusText(http.StatusConflict),
+			Status:   http.StatusConflict,
+			Detail:   ErrDuplicate.Message,
+			Instance: "/api/v1/user/email",
+			Code:     CodeAlreadyExists,
+		}, problem)
+	})
+
+	t.Run("violations are the invalid params", func(t *testing.T) {
+		problem := NewProblem(Violation("email", "required"), "/api/v1/user")
+		req.Equal(http.StatusBadRequest, problem.Status)
+		req.Equal([]FieldViolation{{Field: "email", Description: "required"}}, problem.InvalidParams)
+	})
+
+	t.Run("internal errors hide their text", func(t *testing.T) {
+		problem := NewProblem(errors.New("pq: connection reset"), "/api/v1/order")
+		req.Equal(http.StatusInternalServerError, problem.Status)
+		req.Equal(ErrInternalServer.Message, problem.Detail)
+	})
+
+	t.Run("retryable", func(t *testing.T) {
+		problem := NewProblem(ErrUnavailable, "/api/v1/order")
+		req.True(problem.Retryable)
+	})
+}
@@ -0,0 +1,37 @@
+package errors
+
+import (
+	"net/http"
+)
+
+// ProblemContentType is the media type of the RFC 7807 error responses
+const ProblemContentType = "application/problem+json"
+
+// Problem is the RFC 7807 body of the HTTP error responses,
+// code, invalid-params and retryable are the extension members of the domain error
+type Problem struct {
+	Type          string           `json:"type"`
+	Title         string           `json:"title"`
+	Status        int              `json:"status"`
+	Detail        string           `json:"detail,omitempty"`
+	Instance      string           `json:"instance,omitempty"`
+	Code          Code             `json:"code"`
+	InvalidParams []FieldViolation `json:"invalid-params,omitempty"`
+	Retryable     bool             `json:"retryable"`
+}
+
+// NewProblem renders the error of the request path as a problem
+func NewProblem(err error, instance string) *Problem {
+	domainErr := AsError(err)
+	status := domainErr.Status()
+	return &Problem{
+		Type:          "about:blank",
+		Title:         http.StatusText(status),
+		Status:        status,
+		Detail:        domainErr.Message,
+		Instance:      instance,
+		Code:          domainErr.Code,
+		InvalidParams: domainErr.Violations,
+		Retryable:     domainErr.Retryable,
+	}
+}
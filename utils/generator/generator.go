@@ -1,20 +1,42 @@
 package util
 
 import (
-	"math/rand"
-	"time"
+	"crypto/rand"
+	"math/big"
 )
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
+var (
+	letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	digitRunes  = []rune("0123456789")
+	// the lowercase letters and digits without the ones that look alike
+	readableRunes = []rune("abcdefghjkmnpqrstuvwxyz23456789")
+)
+
+// RandStringRunes returns n random letters, they come from crypto/rand as the codes are secrets
+func RandStringRunes(n int) string {
+	return randRunes(letterRunes, n)
 }
 
-var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ")
+// RandDigits returns n random digits, for the codes typed in by the users
+func RandDigits(n int) string {
+	return randRunes(digitRunes, n)
+}
 
-func RandStringRunes(n int) string {
+// RandReadable returns n random letters and digits, for the codes the users copy by hand
+func RandReadable(n int) string {
+	return randRunes(readableRunes, n)
+}
+
+func randRunes(runes []rune, n int) string {
+	max := big.NewInt(int64(len(runes)))
 	b := make([]rune, n)
 	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			// the system random source is broken, no code can be generated safely
+			panic(err)
+		}
+		b[i] = runes[idx.Int64()]
 	}
 	return string(b)
 }
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/textproto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	log "github.com/sirupsen/logrus"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	errorStatus "go-store/utils/errors"
+)
+
+// gatewayHeaders are forwarded to the gRPC services as metadata with the same name,
+// the access token and the cart token are read by the interceptors and the cart service
+var gatewayHeaders = map[string]bool{
+	"X-Access-Token": true,
+	"X-Cart-Token":   true,
+}
+
+// NewGatewayMux returns the mux transcoding the REST calls described by the google.api.http
+// annotations of the protos to the gRPC services
+func NewGatewayMux() *runtime.ServeMux {
+	return runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			if gatewayHeaders[textproto.CanonicalMIMEHeaderKey(key)] {
+				return key, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+		runtime.WithErrorHandler(problemHandler),
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{
+			MarshalOptions: protojson.MarshalOptions{
+				UseProtoNames:   true,
+				EmitUnpopulated: true,
+			},
+			UnmarshalOptions: protojson.UnmarshalOptions{
+				DiscardUnknown: true,
+			},
+		}),
+	)
+}
+
+// GatewayHandler serves the gateway mux on the gin router. The request reaches the gateway from
+// the client address resolved by gin with its trusted proxies, the X-Forwarded-For of the client
+// is dropped so the gateway forwards that address only
+func GatewayHandler(mux *runtime.ServeMux) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request.Header.Del("X-Forwarded-For")
+		c.Request.RemoteAddr = net.JoinHostPort(c.ClientIP(), "0")
+		mux.ServeHTTP(c.Writer, c.Request)
+	}
+}
+
+// problemHandler renders the gRPC status as the RFC 7807 problem, like the errors of the gin handlers
+func problemHandler(ctx context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	domainErr := DomainError(status.Convert(err))
+	if retryAfter, ok := domainErr.RetryAfterHeader(); ok {
+		w.Header().Set("Retry-After", retryAfter)
+	}
+	if md, ok := runtime.ServerMetadataFromContext(ctx); ok {
+		for key, values := range md.HeaderMD {
+			for _, value := range values {
+				w.Header().Add(runtime.MetadataHeaderPrefix+key, value)
+			}
+		}
+	}
+
+	problem := errorStatus.NewProblem(domainErr, r.URL.Path)
+	w.Header().Set("Content-Type", errorStatus.ProblemContentType)
+	w.WriteHeader(problem.Status)
+	if err := json.NewEncoder(w).Encode(problem); err != nil {
+		log.WithError(err).Warning("gateway problem write")
+	}
+}
@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	codes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+type userCtxKey struct{}
+
+// ContextWithUser stores the user resolved by the auth interceptor in the call context
+func ContextWithUser(ctx context.Context, user *entity.Users) context.Context {
+	return context.WithValue(ctx, userCtxKey{}, user)
+}
+
+// UserFromContext returns the user of the call, callers without token are guests
+func UserFromContext(ctx context.Context) *entity.Users {
+	if user, ok := ctx.Value(userCtxKey{}).(*entity.Users); ok && user != nil {
+		return user
+	}
+	return &entity.Users{Role: entity.UserRoleGuest}
+}
+
+// MetadataValue returns the first value of the incoming metadata key
+func MetadataValue(ctx context.Context, key string) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// ClientIP returns the address of the caller. The gateway dials the server over the loopback and
+// appends the client address it got from gin to x-forwarded-for, so only the last entry of the calls
+// of a loopback peer is believed, the entries before it are sent by the client
+func ClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get("x-forwarded-for"); len(values) > 0 {
+		hops := strings.Split(values[len(values)-1], ",")
+		if last := strings.TrimSpace(hops[len(hops)-1]); last != "" {
+			return last
+		}
+	}
+	return host
+}
+
+// UserAgent returns the user agent of the caller, the gateway forwards the one of the http client
+func UserAgent(ctx context.Context) string {
+	if userAgent := MetadataValue(ctx, "grpcgateway-user-agent"); userAgent != "" {
+		return userAgent
+	}
+	return MetadataValue(ctx, "user-agent")
+}
+
+const errorDomain = "go-store"
+
+var codeGrpc = map[errorStatus.Code]codes.Code{
+	errorStatus.CodeInvalidArgument:    codes.InvalidArgument,
+	errorStatus.CodeUnauthenticated:    codes.Unauthenticated,
+	errorStatus.CodePermissionDenied:   codes.PermissionDenied,
+	errorStatus.CodeNotFound:           codes.NotFound,
+	errorStatus.CodeAlreadyExists:      codes.AlreadyExists,
+	errorStatus.CodeFailedPrecondition: codes.FailedPrecondition,
+	errorStatus.CodeResourceExhausted:  codes.ResourceExhausted,
+	errorStatus.CodeUnavailable:        codes.Unavailable,
+	errorStatus.CodeUnimplemented:      codes.Unimplemented,
+	errorStatus.CodeInternal:           codes.Internal,
+}
+
+// StatusError maps the domain errors of the usecases to the gRPC status, the code travels
+// in the ErrorInfo reason, the field violations in BadRequest and the retry delay in RetryInfo
+func StatusError(err error) error {
+	domainErr := errorStatus.AsError(err)
+	code, ok := codeGrpc[domainErr.Code]
+	if !ok {
+		code = codes.Internal
+	}
+
+	details := []protoiface.MessageV1{
+		&errdetails.ErrorInfo{Reason: string(domainErr.Code), Domain: errorDomain},
+	}
+	if len(domainErr.Violations) > 0 {
+		badReq := &errdetails.BadRequest{}
+		for _, v := range domainErr.Violations {
+			badReq.FieldViolations = append(badReq.FieldViolations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		details = append(details, badReq)
+	}
+	if domainErr.Retryable {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(domainErr.RetryAfter)})
+	}
+
+	st, detailErr := status.New(code, domainErr.Message).WithDetails(details...)
+	if detailErr != nil {
+		return status.Error(code, domainErr.Message)
+	}
+	return st.Err()
+}
+
+// DomainError reads the domain error back from the gRPC status, the statuses without
+// the details, like the ones of the gateway itself, are mapped by their code
+func DomainError(st *status.Status) *errorStatus.Error {
+	domainErr := &errorStatus.Error{
+		Code:    grpcCode(st.Code()),
+		Message: st.Message(),
+	}
+	// the transport failures of the gateway carry no RetryInfo
+	domainErr.Retryable = domainErr.Code == errorStatus.CodeUnavailable
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if d.Domain == errorDomain {
+				domainErr.Code = errorStatus.Code(d.Reason)
+			}
+		case *errdetails.BadRequest:
+			for _, v := range d.FieldViolations {
+				domainErr.Violations = append(domainErr.Violations, errorStatus.FieldViolation{
+					Field:       v.Field,
+					Description: v.Description,
+				})
+			}
+		case *errdetails.RetryInfo:
+			domainErr.Retryable = true
+			domainErr.RetryAfter = d.RetryDelay.AsDuration()
+		}
+	}
+	return domainErr
+}
+
+func grpcCode(code codes.Code) errorStatus.Code {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return errorStatus.CodeInvalidArgument
+	case codes.Aborted:
+		return errorStatus.CodeFailedPrecondition
+	case codes.DeadlineExceeded:
+		return errorStatus.CodeUnavailable
+	}
+	for domainCode, grpcCode := range codeGrpc {
+		if grpcCode == code {
+			return domainCode
+		}
+	}
+	return errorStatus.CodeInternal
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	errorStatus "go-store/utils/errors"
+)
+
+func TestStatusError(t *testing.T) {
+	req := require.New(t)
+
+	for _, tc := range []struct {
+		err  error
+		code codes.Code
+	}{
+		{errorStatus.ErrBadReq, codes.InvalidArgument},
+		{errorStatus.ErrAuth, codes.Unauthenticated},
+		{errorStatus.ErrPermission, codes.PermissionDenied},
+		{errorStatus.ErrNotFound, codes.NotFound},
+		{errorStatus.ErrDuplicate, codes.AlreadyExists},
+		{errorStatus.ErrOutOfStock, codes.FailedPrecondition},
+		{errorStatus.ErrTooManyRequests, codes.ResourceExhausted},
+		{errorStatus.ErrUnavailable, codes.Unavailable},
+		{fmt.Errorf("create user: %w", errorStatus.ErrDuplicate), codes.AlreadyExists},
+		{errors.New("pq: connection reset"), codes.Internal},
+	} {
+		req.Equal(tc.code, status.Code(StatusError(tc.err)), tc.err.Error())
+	}
+
+	t.Run("internal errors hide their text", func(t *testing.T) {
+		st := status.Convert(StatusError(errors.New("pq: connection reset")))
+		req.Equal(errorStatus.ErrInternalServer.Message, st.Message())
+	})
+
+	t.Run("domain error round trip", func(t *testing.T) {
+		err := errorStatus.ErrTooManyRequests.
+			WithViolations(errorStatus.FieldViolation{Field: "code", Description: "expired"}).
+			WithRetry(3 * time.Second)
+
+		domainErr := DomainError(status.Convert(StatusError(err)))
+		req.ErrorIs(domainErr, errorStatus.ErrTooManyRequests)
+		req.Equal(err.Violations, domainErr.Violations)
+		req.True(domainErr.Retryable)
+		req.Equal(3*time.Second, domainErr.RetryAfter)
+	})
+
+	t.Run("failed precondition keeps its code over the gateway", func(t *testing.T) {
+		domainErr := DomainError(status.Convert(StatusError(errorStatus.ErrOutOfStock)))
+		req.Equal(errorStatus.CodeFailedPrecondition, domainErr.Code)
+		req.Equal(http.StatusConflict, domainErr.Status())
+	})
+
+	t.Run("status without the details is mapped by its code", func(t *testing.T) {
+		domainErr := DomainError(status.New(codes.DeadlineExceeded, "deadline exceeded"))
+		req.Equal(errorStatus.CodeUnavailable, domainErr.Code)
+		req.True(domainErr.Retryable)
+	})
+}
+
+func TestProblemHandler(t *testing.T) {
+	req := require.New(t)
+	r := httptest.NewRequest(http.MethodPut, "/api/v2/user/email", nil)
+
+	t.Run("duplicate is a conflict", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		problemHandler(context.Background(), nil, nil, w, r, StatusError(errorStatus.ErrDuplicate))
+
+		req.Equal(http.StatusConflict, w.Code)
+		req.Equal(errorStatus.ProblemContentType, w.Header().Get("Content-Type"))
+		var problem errorStatus.Problem
+		req.NoError(json.Unmarshal(w.Body.Bytes(), &problem))
+		req.Equal(errorStatus.CodeAlreadyExists, problem.Code)
+		req.Equal("/api/v2/user/email", problem.Instance)
+	})
+
+	t.Run("retry after of the rate limit", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		problemHandler(context.Background(), nil, nil, w, r, StatusError(errorStatus.ErrTooManyRequests.WithRetry(30*time.Second)))
+
+		req.Equal(http.StatusTooManyRequests, w.Code)
+		req.Equal("30", w.Header().Get("Retry-After"))
+	})
+}
@@ -0,0 +1,178 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+var errNotProbed = errors.New("not probed yet")
+
+// Check probes a dependency, a nil error means it is up
+type Check func(ctx context.Context) error
+
+// Config holds the probe interval and the timings of the shutdown
+type Config struct {
+	Interval        time.Duration
+	Timeout         time.Duration
+	DrainDelay      time.Duration
+	ShutdownTimeout time.Duration
+}
+
+// Service probes the dependencies of the app and reports them through the standard
+// grpc.health.v1 service, the dependency name is the service name and the empty name is the whole app
+type Service struct {
+	cfg    *Config
+	server *grpchealth.Server
+
+	mu       sync.RWMutex
+	names    []string
+	checks   map[string]Check
+	status   map[string]error
+	shutdown bool
+}
+
+// NewService returns the health service, the dependencies are reported not serving until the first probe
+func NewService(cfg *Config) *Service {
+	server := grpchealth.NewServer()
+	server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	return &Service{
+		cfg:    cfg,
+		server: server,
+		checks: make(map[string]Check),
+		status: make(map[string]error),
+	}
+}
+
+// Add registers the check of a dependency, it is called before Run
+func (s *Service) Add(name string, check Check) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.names = append(s.names, name)
+	s.checks[name] = check
+	s.status[name] = errNotProbed
+	s.server.SetServingStatus(name, healthpb.HealthCheckResponse_NOT_SERVING)
+}
+
+// Register registers the grpc.health.v1 service on the gRPC server
+func (s *Service) Register(gsrv *grpc.Server) {
+	healthpb.RegisterHealthServer(gsrv, s.server)
+}
+
+// Run probes the dependencies every interval until the context is done
+func (s *Service) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		s.probe(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) probe(ctx context.Context) {
+	hLog := log.WithFields(log.Fields{"func": "health.probe"})
+
+	s.mu.RLock()
+	checks := make(map[string]Check, len(s.checks))
+	for name, check := range s.checks {
+		checks[name] = check
+	}
+	s.mu.RUnlock()
+
+	results := make(map[string]error, len(checks))
+	for name, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+		err := check(checkCtx)
+		cancel()
+		if err != nil {
+			hLog.WithError(err).WithField("dependency", name).Warning("dependency down")
+		}
+		results[name] = err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.shutdown {
+		return
+	}
+	serving := true
+	for name, err := range results {
+		s.status[name] = err
+		s.server.SetServingStatus(name, servingStatus(err == nil))
+		serving = serving && err == nil
+	}
+	s.server.SetServingStatus("", servingStatus(serving))
+}
+
+// Shutdown flips the readiness before the servers are stopped,
+// the load balancers stop routing to the instance while the calls in flight drain
+func (s *Service) Shutdown() {
+	s.mu.Lock()
+	s.shutdown = true
+	s.mu.Unlock()
+	s.server.Shutdown()
+}
+
+// Healthz is the liveness probe, the process answers as long as it is running
+func (s *Service) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{"status": healthpb.HealthCheckResponse_SERVING.String()})
+}
+
+// Readyz is the readiness probe, it fails while a dependency is down or the app is shutting down
+func (s *Service) Readyz(c *gin.Context) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	ready := !s.shutdown
+	checks := make(map[string]string, len(s.names))
+	for _, name := range s.names {
+		if err := s.status[name]; err != nil {
+			checks[name] = err.Error()
+			ready = false
+			continue
+		}
+		checks[name] = healthpb.HealthCheckResponse_SERVING.String()
+	}
+
+	code := http.StatusOK
+	if !ready {
+		code = http.StatusServiceUnavailable
+	}
+	c.JSON(code, gin.H{
+		"status": servingStatus(ready).String(),
+		"checks": checks,
+	})
+}
+
+// GracefulStop waits for the gRPC calls in flight, the streams left open past the timeout are cut
+func GracefulStop(gsrv *grpc.Server, timeout time.Duration) {
+	stopped := make(chan struct{})
+	go func() {
+		gsrv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(timeout):
+		gsrv.Stop()
+	}
+}
+
+func servingStatus(serving bool) healthpb.HealthCheckResponse_ServingStatus {
+	if serving {
+		return healthpb.HealthCheckResponse_SERVING
+	}
+	return healthpb.HealthCheckResponse_NOT_SERVING
+}
@@ -0,0 +1,167 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type readyzResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func readyz(t *testing.T, s *Service) (int, readyzResponse) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	s.Readyz(c)
+
+	var res readyzResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	return w.Code, res
+}
+
+func grpcStatus(t *testing.T, s *Service, name string) healthpb.HealthCheckResponse_ServingStatus {
+	res, err := s.server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: name})
+	require.NoError(t, err)
+	return res.Status
+}
+
+func TestService(t *testing.T) {
+	req := require.New(t)
+	ctx := context.Background()
+	cfg := &Config{Interval: time.Second, Timeout: time.Second}
+	errDown := errors.New("connection refused")
+
+	t.Run("not ready until the first probe", func(t *testing.T) {
+		s := NewService(cfg)
+		s.Add("postgres", func(context.Context) error { return nil })
+
+		code, res := readyz(t, s)
+		req.Equal(http.StatusServiceUnavailable, code)
+		req.Equal(errNotProbed.Error(), res.Checks["postgres"])
+		req.Equal(healthpb.HealthCheckResponse_NOT_SERVING, grpcStatus(t, s, ""))
+	})
+
+	t.Run("ready when every dependency is up", func(t *testing.T) {
+		s := NewService(cfg)
+		s.Add("postgres", func(context.Context) error { return nil })
+		s.Add("redis", func(context.Context) error { return nil })
+		s.probe(ctx)
+
+		code, res := readyz(t, s)
+		req.Equal(http.StatusOK, code)
+		req.Equal(healthpb.HealthCheckResponse_SERVING.String(), res.Status)
+		req.Equal(healthpb.HealthCheckResponse_SERVING, grpcStatus(t, s, ""))
+		req.Equal(healthpb.HealthCheckResponse_SERVING, grpcStatus(t, s, "redis"))
+	})
+
+	t.Run("dependency down", func(t *testing.T) {
+		s := NewService(cfg)
+		s.Add("postgres", func(context.Context) error { return nil })
+		s.Add("redis", func(context.Context) error { return errDown })
+		s.probe(ctx)
+
+		code, res := readyz(t, s)
+		req.Equal(http.StatusServiceUnavailable, code)
+		req.Equal(errDown.Error(), res.Checks["redis"])
+		req.Equal(healthpb.HealthCheckResponse_SERVING.String(), res.Checks["postgres"])
+		req.Equal(healthpb.HealthCheckResponse_NOT_SERVING, grpcStatus(t, s, ""))
+		req.Equal(healthpb.HealthCheckResponse_SERVING, grpcStatus(t, s, "postgres"))
+		req.Equal(healthpb.HealthCheckResponse_NOT_SERVING, grpcStatus(t, s, "redis"))
+	})
+
+	t.Run("check past the timeout is down", func(t *testing.T) {
+		s := NewService(&Config{Interval: time.Second, Timeout: 10 * time.Millisecond})
+		s.Add("postgres", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		s.probe(ctx)
+
+		code, _ := readyz(t, s)
+		req.Equal(http.StatusServiceUnavailable, code)
+	})
+
+	t.Run("shutdown stays not ready", func(t *testing.T) {
+		s := NewService(cfg)
+		s.Add("postgres", func(context.Context) error { return nil })
+		s.probe(ctx)
+		s.Shutdown()
+		s.probe(ctx)
+
+		code, res := readyz(t, s)
+		req.Equal(http.StatusServiceUnavailable, code)
+		req.Equal(healthpb.HealthCheckResponse_NOT_SERVING.String(), res.Status)
+		req.Equal(healthpb.HealthCheckResponse_NOT_SERVING, grpcStatus(t, s, ""))
+	})
+
+	t.Run("liveness answers while shutting down", func(t *testing.T) {
+		s := NewService(cfg)
+		s.Shutdown()
+
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		s.Healthz(c)
+		req.Equal(http.StatusOK, w.Code)
+	})
+}
+
+func TestGracefulStop(t *testing.T) {
+	req := require.New(t)
+
+	start := func() (*grpc.Server, healthpb.HealthClient) {
+		s := NewService(&Config{})
+		lis := bufconn.Listen(1 << 20)
+		gsrv := grpc.NewServer()
+		s.Register(gsrv)
+		go gsrv.Serve(lis)
+
+		conn, err := grpc.DialContext(context.Background(), "bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()))
+		req.NoError(err)
+		t.Cleanup(func() { conn.Close() })
+		return gsrv, healthpb.NewHealthClient(conn)
+	}
+
+	t.Run("stops at once without calls in flight", func(t *testing.T) {
+		gsrv, client := start()
+		_, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		req.NoError(err)
+
+		begin := time.Now()
+		GracefulStop(gsrv, time.Minute)
+		req.Less(time.Since(begin), time.Second)
+	})
+
+	t.Run("cuts the streams open past the timeout", func(t *testing.T) {
+		gsrv, client := start()
+		stream, err := client.Watch(context.Background(), &healthpb.HealthCheckRequest{})
+		req.NoError(err)
+		_, err = stream.Recv()
+		req.NoError(err)
+
+		begin := time.Now()
+		GracefulStop(gsrv, 50*time.Millisecond)
+		req.GreaterOrEqual(time.Since(begin), 50*time.Millisecond)
+		req.Less(time.Since(begin), time.Second)
+		_, err = stream.Recv()
+		req.Error(err)
+	})
+}
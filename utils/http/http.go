@@ -37,6 +37,8 @@ type Config struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	DialTimeout  time.Duration
+	// TrustedProxies may set the client address by X-Forwarded-For, none of them by default
+	TrustedProxies []string
 }
 
 // NewService returns an instance of HTTP with all its dependencies set
@@ -0,0 +1,146 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	errorStatus "go-store/utils/errors"
+	"go-store/utils/validate"
+)
+
+// BindJSON decodes the JSON body into the request and checks its validate tags,
+// the malformed and the invalid fields are returned as the violations of ErrBadReq
+func BindJSON(c *gin.Context, req interface{}) error {
+	if err := json.NewDecoder(c.Request.Body).Decode(req); err != nil {
+		return decodeError(err)
+	}
+	return validate.Struct(req)
+}
+
+// BindForm reads the url encoded or multipart fields into the request by their form tags
+// and checks its validate tags
+func BindForm(c *gin.Context, req interface{}) error {
+	return bindValues(req, c.GetPostForm)
+}
+
+// BindQuery reads the query params into the request by their form tags and checks its validate tags
+func BindQuery(c *gin.Context, req interface{}) error {
+	return bindValues(req, c.GetQuery)
+}
+
+func decodeError(err error) error {
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &typeErr) && typeErr.Field != "":
+		return errorStatus.Violation(typeErr.Field, "must be "+jsonType(typeErr.Type))
+	case errors.Is(err, io.EOF):
+		return errorStatus.Violation("body", "required")
+	}
+	return errorStatus.Violation("body", "must be valid json")
+}
+
+func jsonType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "an integer"
+	case reflect.Float32, reflect.Float64:
+		return "a number"
+	case reflect.Bool:
+		return "true or false"
+	case reflect.String:
+		return "a string"
+	case reflect.Slice, reflect.Array:
+		return "a list"
+	}
+	return "an object"
+}
+
+// bindValues sets the fields of the struct the value getter knows, the fields that can't be
+// parsed are reported together with the failed validate rules
+func bindValues(req interface{}, get func(key string) (string, bool)) error {
+	violations := setFields(reflect.ValueOf(req).Elem(), get)
+	err := validate.Struct(req)
+	if err == nil && len(violations) == 0 {
+		return nil
+	}
+	if err != nil {
+		// a field that failed to parse is left zero, its parse error is the one reported
+		for _, violation := range errorStatus.AsError(err).Violations {
+			if !hasViolation(violations, violation.Field) {
+				violations = append(violations, violation)
+			}
+		}
+		if len(violations) == 0 {
+			return err
+		}
+	}
+	return errorStatus.ErrBadReq.WithViolations(violations...)
+}
+
+func setFields(v reflect.Value, get func(key string) (string, bool)) (violations []errorStatus.FieldViolation) {
+	t := v.Type()
+	for idx := 0; idx < t.NumField(); idx++ {
+		field := t.Field(idx)
+		name := field.Tag.Get("form")
+		if name == "" || name == "-" {
+			continue
+		}
+		value, ok := get(name)
+		if !ok || value == "" {
+			continue
+		}
+		target := v.Field(idx)
+		if target.Kind() == reflect.Ptr {
+			target.Set(reflect.New(field.Type.Elem()))
+			target = target.Elem()
+		}
+		if description := setValue(target, value); description != "" {
+			violations = append(violations, errorStatus.FieldViolation{Field: name, Description: description})
+		}
+	}
+	return violations
+}
+
+// setValue parses the value into the field, it returns the violation description if it fails
+func setValue(target reflect.Value, value string) string {
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(value, 10, target.Type().Bits())
+		if err != nil {
+			return "must be an integer"
+		}
+		target.SetInt(number)
+	case reflect.Float32, reflect.Float64:
+		number, err := strconv.ParseFloat(value, target.Type().Bits())
+		if err != nil {
+			return "must be a number"
+		}
+		target.SetFloat(number)
+	case reflect.Bool:
+		flag, err := strconv.ParseBool(value)
+		if err != nil {
+			return "must be true or false"
+		}
+		target.SetBool(flag)
+	default:
+		return "unsupported field"
+	}
+	return ""
+}
+
+func hasViolation(violations []errorStatus.FieldViolation, field string) bool {
+	for _, violation := range violations {
+		if violation.Field == field {
+			return true
+		}
+	}
+	return false
+}
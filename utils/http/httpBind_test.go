@@ -0,0 +1,136 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	errorStatus "go-store/utils/errors"
+)
+
+type bindRequest struct {
+	Email    string   `json:"email" form:"email" validate:"required,email"`
+	Quantity int      `json:"quantity" form:"quantity" validate:"gte=0"`
+	Price    *float32 `json:"price" form:"price" validate:"omitempty,gte=0"`
+	Gift     bool     `json:"gift" form:"gift"`
+	Tags     []string `json:"tags"`
+}
+
+func bindContext(method, target, contentType, body string) *gin.Context {
+	gin.SetMode(gin.TestMode)
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest(method, target, strings.NewReader(body))
+	if contentType != "" {
+		c.Request.Header.Set("Content-Type", contentType)
+	}
+	return c
+}
+
+func bindViolations(t *testing.T, err error) []errorStatus.FieldViolation {
+	require.ErrorIs(t, err, errorStatus.ErrBadReq)
+	return errorStatus.AsError(err).Violations
+}
+
+func TestBindJSON(t *testing.T) {
+	req := require.New(t)
+	bind := func(body string) (*bindRequest, error) {
+		var r bindRequest
+		err := BindJSON(bindContext(http.MethodPost, "/", "application/json", body), &r)
+		return &r, err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		r, err := bind(`{"email":"john@example.com","quantity":2,"price":9.5,"tags":["new"]}`)
+		req.NoError(err)
+		req.Equal(2, r.Quantity)
+		req.Equal(float32(9.5), *r.Price)
+	})
+
+	t.Run("empty body", func(t *testing.T) {
+		_, err := bind("")
+		req.Equal([]errorStatus.FieldViolation{{Field: "body", Description: "required"}}, bindViolations(t, err))
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		_, err := bind(`{"email":`)
+		req.Equal([]errorStatus.FieldViolation{{Field: "body", Description: "must be valid json"}}, bindViolations(t, err))
+	})
+
+	t.Run("field of the wrong type", func(t *testing.T) {
+		for body, violation := range map[string]errorStatus.FieldViolation{
+			`{"email":"john@example.com","quantity":"two"}`: {Field: "quantity", Description: "must be an integer"},
+			`{"email":"john@example.com","price":"free"}`:   {Field: "price", Description: "must be a number"},
+			`{"email":"john@example.com","gift":"yes"}`:     {Field: "gift", Description: "must be true or false"},
+			`{"email":"john@example.com","tags":"new"}`:     {Field: "tags", Description: "must be a list"},
+			`{"email":1}`: {Field: "email", Description: "must be a string"},
+		} {
+			_, err := bind(body)
+			req.Equal([]errorStatus.FieldViolation{violation}, bindViolations(t, err), body)
+		}
+	})
+
+	t.Run("invalid fields", func(t *testing.T) {
+		_, err := bind(`{"quantity":-1}`)
+		req.ElementsMatch([]errorStatus.FieldViolation{
+			{Field: "email", Description: "required"},
+			{Field: "quantity", Description: "must be at least 0"},
+		}, bindViolations(t, err))
+	})
+}
+
+func TestBindQuery(t *testing.T) {
+	req := require.New(t)
+	bind := func(query url.Values) (*bindRequest, error) {
+		var r bindRequest
+		err := BindQuery(bindContext(http.MethodGet, "/?"+query.Encode(), "", ""), &r)
+		return &r, err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		r, err := bind(url.Values{"email": {"john@example.com"}, "quantity": {"3"}, "price": {"1.25"}, "gift": {"true"}})
+		req.NoError(err)
+		req.Equal(3, r.Quantity)
+		req.Equal(float32(1.25), *r.Price)
+		req.True(r.Gift)
+	})
+
+	t.Run("missing optional params stay unset", func(t *testing.T) {
+		r, err := bind(url.Values{"email": {"john@example.com"}, "price": {""}})
+		req.NoError(err)
+		req.Nil(r.Price)
+	})
+
+	t.Run("params that fail to parse", func(t *testing.T) {
+		_, err := bind(url.Values{"email": {"john@example.com"}, "quantity": {"two"}, "price": {"free"}, "gift": {"yes"}})
+		req.ElementsMatch([]errorStatus.FieldViolation{
+			{Field: "quantity", Description: "must be an integer"},
+			{Field: "price", Description: "must be a number"},
+			{Field: "gift", Description: "must be true or false"},
+		}, bindViolations(t, err))
+	})
+
+	t.Run("parse error is reported over the rule of the zero value", func(t *testing.T) {
+		_, err := bind(url.Values{"email": {"john"}, "quantity": {"-1"}, "price": {"x"}})
+		req.ElementsMatch([]errorStatus.FieldViolation{
+			{Field: "price", Description: "must be a number"},
+			{Field: "email", Description: "must be a valid email"},
+			{Field: "quantity", Description: "must be at least 0"},
+		}, bindViolations(t, err))
+	})
+}
+
+func TestBindForm(t *testing.T) {
+	req := require.New(t)
+	form := url.Values{"email": {"john@example.com"}, "quantity": {"2"}}
+	c := bindContext(http.MethodPost, "/", "application/x-www-form-urlencoded", form.Encode())
+
+	var r bindRequest
+	req.NoError(BindForm(c, &r))
+	req.Equal("john@example.com", r.Email)
+	req.Equal(2, r.Quantity)
+}
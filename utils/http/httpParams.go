@@ -2,7 +2,7 @@ package http
 
 import (
 	"errors"
-	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -13,31 +13,16 @@ import (
 
 	"go-store/internal/dto"
 	"go-store/internal/entity"
+	userDto "go-store/internal/user/dto"
 	errorStatus "go-store/utils/errors"
 )
 
 func PaginationParams(c *gin.Context) (cr *entity.PaginationParam, err error) {
-	limit_s, ok := c.GetQuery("limit")
-	if !ok {
-		err = errors.New("params not include 'limit'")
-		return nil, err
-	}
-	offset_s, ok := c.GetQuery("offset")
-	if !ok {
-		err = errors.New("params not include 'offset'")
-		return nil, err
-	}
-
-	limit, err := strconv.Atoi(limit_s)
-	if err != nil {
-		err = fmt.Errorf("Limit is not integer, Err: %w", err)
-		return
-	}
-
-	offset, err := strconv.Atoi(offset_s)
-	if err != nil {
-		err = fmt.Errorf("Offset is not integer, Err: %w", err)
-		return
+	var violations []errorStatus.FieldViolation
+	limit := queryInt(c, "limit", &violations)
+	offset := queryInt(c, "offset", &violations)
+	if len(violations) > 0 {
+		return nil, errorStatus.ErrBadReq.WithViolations(violations...)
 	}
 
 	cr = &entity.PaginationParam{
@@ -48,38 +33,12 @@ func PaginationParams(c *gin.Context) (cr *entity.PaginationParam, err error) {
 }
 
 func SortingParams(c *gin.Context) (cr *entity.PaginationParam, err error) {
-	limit_s, ok := c.GetQuery("limit")
-	if !ok {
-		err = errors.New("params not include 'limit'")
-		return nil, err
-	}
-	offset_s, ok := c.GetQuery("offset")
-	if !ok {
-		err = errors.New("params not include 'offset'")
-		return nil, err
-	}
-	categoryId_s, ok := c.GetQuery("categoryId")
-	if !ok {
-		err = errors.New("params not include 'categoryId'")
-		return nil, err
-	}
-
-	limit, err := strconv.Atoi(limit_s)
-	if err != nil {
-		err = fmt.Errorf("Limit is not integer, Err: %w", err)
-		return
-	}
-
-	offset, err := strconv.Atoi(offset_s)
-	if err != nil {
-		err = fmt.Errorf("Offset is not integer, Err: %w", err)
-		return
-	}
-
-	categoryId, err := strconv.Atoi(categoryId_s)
-	if err != nil {
-		err = fmt.Errorf("Category ID wrong! Err: %w", err)
-		return
+	var violations []errorStatus.FieldViolation
+	limit := queryInt(c, "limit", &violations)
+	offset := queryInt(c, "offset", &violations)
+	categoryId := queryInt(c, "categoryId", &violations)
+	if len(violations) > 0 {
+		return nil, errorStatus.ErrBadReq.WithViolations(violations...)
 	}
 
 	cr = &entity.PaginationParam{
@@ -90,40 +49,35 @@ func SortingParams(c *gin.Context) (cr *entity.PaginationParam, err error) {
 	return cr, nil
 }
 
-func ProdCreateForm(c *gin.Context) (*entity.Product, error) {
-	productName := c.PostForm("name")
-	productDescr := c.PostForm("description")
-	categoryID := c.PostForm("categoryId")
-	brandID := c.PostForm("brandId")
-	regionID := c.PostForm("regionId")
-
-	if productName == "" || productDescr == "" {
-		err := errorStatus.ErrBadReq
-		return nil, err
-	}
-
-	categoryIdInt, err := strconv.Atoi(categoryID)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.ProdCreateForm.categoryID")
-		return nil, err
+// queryInt reads the required integer query param, the missing and malformed ones are added to the violations
+func queryInt(c *gin.Context, name string, violations *[]errorStatus.FieldViolation) int {
+	value, ok := c.GetQuery(name)
+	if !ok {
+		*violations = append(*violations, errorStatus.FieldViolation{Field: name, Description: "required"})
+		return 0
 	}
-	brandIdInt, err := strconv.Atoi(brandID)
+	number, err := strconv.Atoi(value)
 	if err != nil {
-		logrus.WithError(err).Warning("utils.ProdCreateForm.brandID")
-		return nil, err
+		*violations = append(*violations, errorStatus.FieldViolation{Field: name, Description: "must be an integer"})
+		return 0
 	}
-	regionIdInt, err := strconv.Atoi(regionID)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.ProdCreateForm.regionID")
+	return number
+}
+
+func ProdCreateForm(c *gin.Context) (*entity.Product, error) {
+	var form dto.ProductForm
+	if err := BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.ProdCreateForm.BindForm")
 		return nil, err
 	}
 
 	prodCreate := &entity.Product{
-		ProductName: productName,
-		Description: productDescr,
-		CategoryId:  categoryIdInt,
-		BrandId:     brandIdInt,
-		RegionId:    regionIdInt,
+		ProductName: form.Name,
+		Description: form.Description,
+		CategoryId:  form.CategoryId,
+		BrandId:     form.BrandId,
+		RegionId:    form.RegionId,
+		TaxClassId:  form.TaxClassId,
 		CreateTs:    time.Now(),
 		UpdateTs:    time.Now(),
 		State:       entity.Enabled,
@@ -135,81 +89,36 @@ func ProdCreateForm(c *gin.Context) (*entity.Product, error) {
 
 func SkuCreateForm(c *gin.Context) (sku *entity.Sku, err error) {
 	productIdStr := c.Param("productId")
-	skuCode := c.PostForm("skuCode")
-	price := c.PostForm("price")
-	quantity := c.PostForm("quantity")
-	state := c.PostForm("state")
-	var productIdInt int
-	if skuCode == "" {
-		skuCode = "sku-" + productIdStr + strconv.FormatInt(time.Now().UnixMicro(), 10)
-	}
-
-	if productIdStr != "" {
-		productIdInt, err = strconv.Atoi(productIdStr)
-		if err != nil {
-			logrus.WithError(err).Warning("utils.SkuCreateForm.productId")
-			return nil, err
-		}
-	}
-
-	stateT, err := entity.ParseState(state)
+	productIdInt, err := strconv.Atoi(productIdStr)
 	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm.price")
-		return nil, err
+		logrus.WithError(err).Warning("utils.SkuCreateForm.productId")
+		return nil, errorStatus.Violation("productId", "must be an integer")
 	}
 
-	priceFl, err := strconv.ParseFloat(price, 32)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm.price")
+	var form dto.SkuForm
+	if err = BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.SkuCreateForm.BindForm")
 		return nil, err
 	}
-	if quantity == "" {
-		quantity = "0"
-	}
-	quantityInt, err := strconv.Atoi(quantity)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm.quantity")
-		return nil, err
+	if form.SkuCode == "" {
+		form.SkuCode = "sku-" + productIdStr + strconv.FormatInt(time.Now().UnixMicro(), 10)
 	}
 
-	form, err := c.MultipartForm()
+	imagesName, err := saveSkuImages(c, form.SkuCode)
 	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm")
 		return nil, err
 	}
-	images := form.File["images"]
-	var imagesName string
-	for idx, image := range images {
-		image.Filename = "img-" + strconv.Itoa(idx) + ".jpg"
-		path := filepath.Join("static", "images", skuCode, image.Filename)
-		if idx < len(images)-1 {
-			imagesName = imagesName + path + ","
-		} else {
-			imagesName = imagesName + path
-		}
-		if _, err := os.Stat(filepath.Dir(path)); errors.Is(err, os.ErrNotExist) {
-			err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
-			if err != nil {
-				logrus.WithError(err).Warning("utils.SkuCreateForm")
-				return nil, err
-			}
-		}
-		err := c.SaveUploadedFile(image, path)
-		if err != nil {
-			logrus.WithError(err).Warning("utils.SkuCreateForm.SaveUploadedFile")
-			return nil, err
-		}
-	}
 	sku = &entity.Sku{
 		ProductId:   productIdInt,
-		Sku:         skuCode,
-		Price:       float32(priceFl),
-		Quantity:    quantityInt,
+		Sku:         form.SkuCode,
+		Price:       form.Price,
+		Quantity:    form.Quantity,
+		MaxPerOrder: form.MaxPerOrder,
 		LargeImage:  imagesName,
 		CountViewed: 0,
 		CreateTs:    time.Now(),
 		UpdateTs:    time.Now(),
-		State:       stateT,
+		State:       entity.State(form.State),
 		Version:     0,
 	}
 
@@ -218,40 +127,42 @@ func SkuCreateForm(c *gin.Context) (sku *entity.Sku, err error) {
 
 func SkuUpdateForm(c *gin.Context) (sku *entity.Sku, err error) {
 	skuStr := c.Param("sku")
-	skuCode := c.PostForm("skuCode")
-	price := c.PostForm("price")
-	quantity := c.PostForm("quantity")
-	state := c.PostForm("state")
-	var productIdInt int
-
 	if skuStr == "" {
-		return nil, errorStatus.ErrBadReq
+		return nil, errorStatus.Violation("sku", "required")
 	}
 
-	stateT, err := entity.ParseState(state)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm.price")
+	var form dto.SkuForm
+	if err = BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.SkuUpdateForm.BindForm")
 		return nil, err
 	}
 
-	priceFl, err := strconv.ParseFloat(price, 32)
+	imagesName, err := saveSkuImages(c, form.SkuCode)
 	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm.price")
 		return nil, err
 	}
-	if quantity == "" {
-		quantity = "0"
-	}
-	quantityInt, err := strconv.Atoi(quantity)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm.quantity")
-		return nil, err
+	sku = &entity.Sku{
+		Sku:         skuStr,
+		Price:       form.Price,
+		Quantity:    form.Quantity,
+		MaxPerOrder: form.MaxPerOrder,
+		LargeImage:  imagesName,
+		CountViewed: 0,
+		CreateTs:    time.Now(),
+		UpdateTs:    time.Now(),
+		State:       entity.State(form.State),
+		Version:     0,
 	}
 
+	return sku, nil
+}
+
+// saveSkuImages stores the uploaded images of the sku, the paths are returned comma separated
+func saveSkuImages(c *gin.Context, skuCode string) (string, error) {
 	form, err := c.MultipartForm()
 	if err != nil {
-		logrus.WithError(err).Warning("utils.SkuCreateForm")
-		return nil, err
+		logrus.WithError(err).Warning("utils.saveSkuImages")
+		return "", errorStatus.Violation("images", "must be a multipart form")
 	}
 	images := form.File["images"]
 	var imagesName string
@@ -266,203 +177,149 @@ func SkuUpdateForm(c *gin.Context) (sku *entity.Sku, err error) {
 		if _, err := os.Stat(filepath.Dir(path)); errors.Is(err, os.ErrNotExist) {
 			err := os.MkdirAll(filepath.Dir(path), os.ModePerm)
 			if err != nil {
-				logrus.WithError(err).Warning("utils.SkuCreateForm")
-				return nil, err
+				logrus.WithError(err).Warning("utils.saveSkuImages")
+				return "", err
 			}
 		}
 		err := c.SaveUploadedFile(image, path)
 		if err != nil {
-			logrus.WithError(err).Warning("utils.SkuCreateForm.SaveUploadedFile")
-			return nil, err
+			logrus.WithError(err).Warning("utils.saveSkuImages.SaveUploadedFile")
+			return "", err
 		}
 	}
-	sku = &entity.Sku{
-		ProductId:   productIdInt,
-		Sku:         skuStr,
-		Price:       float32(priceFl),
-		Quantity:    quantityInt,
-		LargeImage:  imagesName,
-		CountViewed: 0,
-		CreateTs:    time.Now(),
-		UpdateTs:    time.Now(),
-		State:       stateT,
-		Version:     0,
-	}
-
-	return sku, nil
+	return imagesName, nil
 }
 
 func OptionForm(c *gin.Context) (optionForm *dto.ProductOptionRequest, err error) {
-
-	skuId := c.Param("sku")
-	if skuId == "" {
-		logrus.WithError(err).Warning("utils.OptionForm.skuId")
+	optionForm = &dto.ProductOptionRequest{SkuId: c.Param("sku")}
+	if err = BindQuery(c, optionForm); err != nil {
+		logrus.WithError(err).Warning("utils.OptionForm.BindQuery")
 		return nil, err
 	}
 
-	optionIdStr, ok := c.GetQuery("optionId")
-	if !ok {
-		logrus.WithError(err).Warning("utils.OptionForm.optionIdStr")
-		return nil, err
-	}
+	return optionForm, nil
+}
 
-	optionValueIdStr, ok := c.GetQuery("optionValueId")
-	if !ok {
-		logrus.WithError(err).Warning("utils.OptionForm.optionValueIdStr")
+func CategoryForm(c *gin.Context) (category *entity.Category, err error) {
+	var form dto.CategoryForm
+	if err = BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.CategoryForm.BindForm")
 		return nil, err
 	}
-
-	optionID, err := strconv.Atoi(optionIdStr)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.OptionForm.optionId")
-		return nil, err
+	icon, iconErr := c.FormFile("icon")
+	image, imageErr := c.FormFile("image")
+	var violations []errorStatus.FieldViolation
+	if iconErr != nil {
+		violations = append(violations, errorStatus.FieldViolation{Field: "icon", Description: "required"})
 	}
-
-	optionValueID, err := strconv.Atoi(optionValueIdStr)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.OptionForm.optionValueId")
-		return nil, err
+	if imageErr != nil {
+		violations = append(violations, errorStatus.FieldViolation{Field: "image", Description: "required"})
 	}
-
-	optionForm = &dto.ProductOptionRequest{
-		SkuId:         skuId,
-		OptionId:      optionID,
-		OptionValueId: optionValueID,
+	if len(violations) > 0 {
+		return nil, errorStatus.ErrBadReq.WithViolations(violations...)
 	}
 
-	return optionForm, nil
-}
-
-func CategoryForm(c *gin.Context) (category *entity.Category, err error) {
-	name := c.PostForm("name")
-	parentId := c.PostForm("parentId")
-	state := c.PostForm("state")
-
-	if name != "" && parentId != "" {
-		parentIdInt, err := strconv.Atoi(parentId)
-		if err != nil {
-			logrus.WithError(err).Warning("utils.CategoryForm.parentIdInt")
-			return nil, err
-		}
-
-		icon, err := c.FormFile("icon")
-		if err != nil {
-			logrus.WithError(err).Warning("utils.CategoryForm.MkdirAll")
-			return nil, err
-		}
-		pathIcon := filepath.Join("static", "images", "category", name, "icon", icon.Filename)
-		if _, err := os.Stat(filepath.Dir(pathIcon)); errors.Is(err, os.ErrNotExist) {
-			err := os.MkdirAll(filepath.Dir(pathIcon), os.ModePerm)
-			if err != nil {
-				logrus.WithError(err).Warning("utils.CategoryForm.icon.MkdirAll")
-				return nil, err
-			}
-		}
-		err = c.SaveUploadedFile(icon, pathIcon)
+	pathIcon := filepath.Join("static", "images", "category", form.Name, "icon", icon.Filename)
+	if _, err := os.Stat(filepath.Dir(pathIcon)); errors.Is(err, os.ErrNotExist) {
+		err := os.MkdirAll(filepath.Dir(pathIcon), os.ModePerm)
 		if err != nil {
-			logrus.WithError(err).Warning("utils.CategoryForm.icon.SaveUploadedFile")
+			logrus.WithError(err).Warning("utils.CategoryForm.icon.MkdirAll")
 			return nil, err
 		}
+	}
+	err = c.SaveUploadedFile(icon, pathIcon)
+	if err != nil {
+		logrus.WithError(err).Warning("utils.CategoryForm.icon.SaveUploadedFile")
+		return nil, err
+	}
 
-		image, err := c.FormFile("image")
-		if err != nil {
-			logrus.WithError(err).Warning("utils.CategoryForm.MkdirAll")
-			return nil, err
-		}
-		pathImage := filepath.Join("static", "images", "category", name, "image", image.Filename)
-		if _, err := os.Stat(filepath.Dir(pathImage)); errors.Is(err, os.ErrNotExist) {
-			err := os.MkdirAll(filepath.Dir(pathImage), os.ModePerm)
-			if err != nil {
-				logrus.WithError(err).Warning("utils.CategoryForm.image.MkdirAll")
-				return nil, err
-			}
-		}
-		err = c.SaveUploadedFile(image, pathImage)
+	pathImage := filepath.Join("static", "images", "category", form.Name, "image", image.Filename)
+	if _, err := os.Stat(filepath.Dir(pathImage)); errors.Is(err, os.ErrNotExist) {
+		err := os.MkdirAll(filepath.Dir(pathImage), os.ModePerm)
 		if err != nil {
-			logrus.WithError(err).Warning("utils.CategoryForm.image.SaveUploadedFile")
+			logrus.WithError(err).Warning("utils.CategoryForm.image.MkdirAll")
 			return nil, err
 		}
+	}
+	err = c.SaveUploadedFile(image, pathImage)
+	if err != nil {
+		logrus.WithError(err).Warning("utils.CategoryForm.image.SaveUploadedFile")
+		return nil, err
+	}
 
-		category = &entity.Category{
-			Name:   name,
-			Parent: parentIdInt,
-			Icon:   pathIcon,
-			Image:  pathImage,
-		}
-
-		if state == "" {
-			category.State = entity.Enabled
-		}
-
-	} else {
-		logrus.WithError(err).Warning("utils.CategoryForm.FormEmpty")
-		return nil, errorStatus.ErrBadReq
+	category = &entity.Category{
+		Name:   form.Name,
+		Parent: *form.ParentId,
+		Icon:   pathIcon,
+		Image:  pathImage,
+		State:  entity.State(form.State),
+	}
+	if category.State == "" {
+		category.State = entity.Enabled
 	}
 
 	return category, nil
 }
 
 func OrderForm(c *gin.Context) (order *entity.Order, err error) {
-
-	phone, ok := c.GetPostForm("phone")
-	if !ok {
-		logrus.WithError(err).Warning("utils.OrderForm.phone")
-		return nil, errorStatus.ErrBadReq
+	// address book entry, the default shipping address is used if omitted
+	var form dto.OrderForm
+	if err = BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.OrderForm.BindForm")
+		return nil, err
 	}
 
-	address, ok := c.GetPostForm("address")
-	if !ok {
-		logrus.WithError(err).Warning("utils.OrderForm.address")
-		return nil, errorStatus.ErrBadReq
+	order = &entity.Order{
+		AddressId: form.AddressId,
+		Comment:   form.Comment,
+		Status:    form.Status,
+		Notes:     form.Notes,
 	}
 
-	comment, ok := c.GetPostForm("comment")
-	if !ok {
-		logrus.WithError(err).Warning("utils.OrderForm.comment")
-		return nil, errorStatus.ErrBadReq
-	}
+	return order, nil
+}
 
-	notes, ok := c.GetPostForm("notes")
-	if !ok {
-		logrus.WithError(err).Warning("utils.OrderForm.notes")
-		return nil, errorStatus.ErrBadReq
+// GuestOrderForm reads the order of an anonymous checkout with the contact email and delivery address
+func GuestOrderForm(c *gin.Context) (order *entity.Order, address *entity.Address, err error) {
+	var form dto.GuestOrderForm
+	if err = BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.GuestOrderForm.BindForm")
+		return nil, nil, err
 	}
 
-	status, ok := c.GetPostForm("status")
-
 	order = &entity.Order{
-		Phone:   phone,
-		Address: address,
-		Comment: comment,
-		Status:  status,
-		Notes:   notes,
-	}
-
-	return order, nil
+		Email:   form.Email,
+		Comment: form.Comment,
+		Notes:   form.Notes,
+	}
+	address = &entity.Address{
+		Recipient: form.Recipient,
+		Phone:     form.Phone,
+		Country:   form.Country,
+		RegionId:  form.RegionId,
+		City:      form.City,
+		Street:    form.Street,
+		Postcode:  form.Postcode,
+		Latitude:  form.Latitude,
+		Longitude: form.Longitude,
+	}
+
+	return order, address, nil
 }
 
 func UserCreateForm(c *gin.Context) (users *entity.Users, sendMethod *string, err error) {
-	username := c.PostForm("username")
-	fullName := c.PostForm("fullName")
-	password := c.PostForm("password")
-	email := c.PostForm("email")
-	phone := c.PostForm("phone")
-	address := c.PostForm("address")
-	regionID := c.PostForm("regionId")
-	sendMet := c.PostForm("sendMethod")
-
-	regionIdInt, err := strconv.Atoi(regionID)
-	if err != nil {
-		logrus.WithError(err).Warning("utils.ProdCreateForm.regionID")
+	var form userDto.UserForm
+	if err = BindForm(c, &form); err != nil {
+		logrus.WithError(err).Warning("utils.UserCreateForm.BindForm")
 		return nil, nil, err
 	}
 
 	photo, err := c.FormFile("photo")
 	if err != nil {
 		logrus.WithError(err).Warning("utils.UserCreateForm.FormFile")
-		return nil, nil, err
+		return nil, nil, errorStatus.Violation("photo", "required")
 	}
-	pathPhoto := filepath.Join("static", "images", "user", username, "photo", photo.Filename)
+	pathPhoto := filepath.Join("static", "images", "user", form.Username, "photo", photo.Filename)
 	if _, err := os.Stat(filepath.Dir(pathPhoto)); errors.Is(err, os.ErrNotExist) {
 		err := os.MkdirAll(filepath.Dir(pathPhoto), os.ModePerm)
 		if err != nil {
@@ -472,19 +329,82 @@ func UserCreateForm(c *gin.Context) (users *entity.Users, sendMethod *string, er
 	}
 
 	userCreate := &entity.Users{
-		Username:    username,
-		FullName:    fullName,
-		Password:    password,
-		Email:       email,
-		PhoneNumber: phone,
-		Address:     address,
+		Username:    form.Username,
+		FullName:    form.FullName,
+		Password:    form.Password,
+		Email:       form.Email,
+		PhoneNumber: form.Phone,
+		Address:     form.Address,
 		Photo:       pathPhoto,
-		RegionId:    regionIdInt,
+		RegionId:    form.RegionId,
 		CreateTs:    time.Now(),
 		UpdateTs:    time.Now(),
 		State:       entity.Enabled,
 		Version:     0,
 	}
 
-	return userCreate, &sendMet, nil
+	return userCreate, &form.SendMethod, nil
+}
+
+const maxPhotoSize = 5 << 20
+
+// photoTypes are the extensions of the photo formats that can be uploaded, by their sniffed type
+var photoTypes = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/webp": ".webp",
+}
+
+// UserPhotoForm stores the uploaded photo of the user under its public id and returns its path,
+// every upload gets a new name so the cached photo is not shown
+func UserPhotoForm(c *gin.Context, publicId string) (string, error) {
+	photo, err := c.FormFile("photo")
+	if err != nil {
+		logrus.WithError(err).Warning("utils.UserPhotoForm.FormFile")
+		return "", errorStatus.Violation("photo", "required")
+	}
+	if photo.Size > maxPhotoSize {
+		return "", errorStatus.Violation("photo", "must be at most 5 MB")
+	}
+	file, err := photo.Open()
+	if err != nil {
+		logrus.WithError(err).Warning("utils.UserPhotoForm.Open")
+		return "", errorStatus.Violation("photo", "can't be read")
+	}
+	head := make([]byte, 512)
+	n, _ := file.Read(head)
+	file.Close()
+	ext, ok := photoTypes[http.DetectContentType(head[:n])]
+	if !ok {
+		return "", errorStatus.Violation("photo", "must be a jpeg, png or webp image")
+	}
+
+	pathPhoto := filepath.Join("static", "images", "user", publicId, "photo-"+strconv.FormatInt(time.Now().UnixNano(), 36)+ext)
+	if err = os.MkdirAll(filepath.Dir(pathPhoto), os.ModePerm); err != nil {
+		logrus.WithError(err).Warning("utils.UserPhotoForm.MkdirAll")
+		return "", err
+	}
+	if err = c.SaveUploadedFile(photo, pathPhoto); err != nil {
+		logrus.WithError(err).Warning("utils.UserPhotoForm.SaveUploadedFile")
+		return "", err
+	}
+	return pathPhoto, nil
+}
+
+// RemoveUserPhotos removes the photos of the user but the kept one, an empty keep removes them all
+func RemoveUserPhotos(publicId string, keep string) {
+	dir := filepath.Join("static", "images", "user", publicId)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		path := filepath.Join(dir, entry.Name())
+		if path == keep {
+			continue
+		}
+		if err = os.Remove(path); err != nil {
+			logrus.WithError(err).Warning("utils.RemoveUserPhotos.Remove")
+		}
+	}
 }
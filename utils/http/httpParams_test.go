@@ -0,0 +1,57 @@
+package http
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	errorStatus "go-store/utils/errors"
+)
+
+func TestUserPhotoForm(t *testing.T) {
+	req := require.New(t)
+	wd, err := os.Getwd()
+	req.NoError(err)
+	req.NoError(os.Chdir(t.TempDir()))
+	defer os.Chdir(wd)
+
+	upload := func(content []byte) (string, error) {
+		body := new(bytes.Buffer)
+		form := multipart.NewWriter(body)
+		part, err := form.CreateFormFile("photo", "photo")
+		req.NoError(err)
+		_, err = part.Write(content)
+		req.NoError(err)
+		req.NoError(form.Close())
+
+		c := bindContext(http.MethodPut, "/api/v1/users/me/photo", form.FormDataContentType(), body.String())
+		return UserPhotoForm(c, uuid.New().String())
+	}
+
+	t.Run("stored under the public id", func(t *testing.T) {
+		content := new(bytes.Buffer)
+		req.NoError(png.Encode(content, image.NewRGBA(image.Rect(0, 0, 2, 2))))
+
+		path, err := upload(content.Bytes())
+		req.NoError(err)
+		req.True(strings.HasPrefix(path, "static/images/user/"), path)
+		req.True(strings.HasSuffix(path, ".png"), path)
+		// the path is stored in users.photo
+		req.LessOrEqual(len(path), 255)
+		_, err = os.Stat(path)
+		req.NoError(err)
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		_, err := upload([]byte("#!/bin/sh"))
+		req.ErrorIs(err, errorStatus.ErrBadReq)
+	})
+}
@@ -1,7 +1,6 @@
 package http
 
 import (
-	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -11,40 +10,59 @@ import (
 	errorStatus "go-store/utils/errors"
 )
 
+// Success is the body of the calls that have no result to return
+const Success = "success"
+
 func SendResponse(c *gin.Context, data interface{}, handleErr error) {
 	// w.Header().Add("Content-Type", "application/json")
 	// if data == nil {
 	// 	log.Info("Data in 'sendResponse' is null")
 	// 	return
 	// }
-	var status int
-	var message string
 	if handleErr != nil {
-
-		var apiErr errorStatus.APIError
-		if errors.As(handleErr, &apiErr) {
-			status, message = apiErr.APIError()
-		} else {
-			status = http.StatusInternalServerError
-			message = "internal server Error"
-		}
-		c.JSON(status, message)
-	} else {
-		c.JSON(http.StatusOK, data)
+		sendProblem(c, handleErr)
+		return
 	}
+	c.JSON(http.StatusOK, data)
+}
+
+// AbortWithError stops the handler chain with the RFC 7807 problem of the error
+func AbortWithError(c *gin.Context, err error) {
+	sendProblem(c, err)
+	c.Abort()
 }
 
-func CheckIfAdmin(c *gin.Context) bool {
-	userCtx, exists := c.Get("user")
-	// This shouldn't happen, as our middleware ought to throw an error.
-	if !exists {
-		log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
-		return false
+func sendProblem(c *gin.Context, err error) {
+	if retryAfter, ok := errorStatus.AsError(err).RetryAfterHeader(); ok {
+		c.Header("Retry-After", retryAfter)
 	}
-	user := userCtx.(*entity.Users)
+	problem := errorStatus.NewProblem(err, c.Request.URL.Path)
+	c.Header("Content-Type", errorStatus.ProblemContentType)
+	c.JSON(problem.Status, problem)
+}
+
+// RequirePermission is the policy middleware of the routes, it goes after the ValidateJWT one.
+// The guests are asked to sign in and the users whose role lacks the permission are forbidden
+func RequirePermission(perm entity.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userCtx, exists := c.Get("user")
+		// This shouldn't happen, as our middleware ought to throw an error.
+		if !exists {
+			log.Printf("Unable to extract user from request context for unknown reason: %v\n", c)
+			AbortWithError(c, errorStatus.ErrInternalServer)
+			return
+		}
+		user := userCtx.(*entity.Users)
 
-	if user.Role != entity.UserRoleAdmin {
-		return false
+		if user.Role.Can(perm) {
+			c.Next()
+			return
+		}
+		if user.Role == entity.UserRoleGuest {
+			AbortWithError(c, errorStatus.ErrAuth)
+			return
+		}
+		log.WithFields(log.Fields{"userId": user.Id, "role": user.Role, "permission": perm}).Warning("permission denied")
+		AbortWithError(c, errorStatus.ErrPermission)
 	}
-	return true
 }
@@ -0,0 +1,77 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+func testContext(method, target string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, nil)
+	return c, w
+}
+
+func TestSendResponse(t *testing.T) {
+	req := require.New(t)
+
+	t.Run("result", func(t *testing.T) {
+		c, w := testContext(http.MethodGet, "/api/v1/category")
+		SendResponse(c, Success, nil)
+
+		req.Equal(http.StatusOK, w.Code)
+		req.Equal(`"success"`, w.Body.String())
+	})
+
+	t.Run("duplicate is the conflict problem", func(t *testing.T) {
+		c, w := testContext(http.MethodPost, "/api/v1/user")
+		SendResponse(c, nil, errorStatus.ErrDuplicate)
+
+		req.Equal(http.StatusConflict, w.Code)
+		req.Equal(errorStatus.ProblemContentType, w.Header().Get("Content-Type"))
+		var problem errorStatus.Problem
+		req.NoError(json.Unmarshal(w.Body.Bytes(), &problem))
+		req.Equal(errorStatus.CodeAlreadyExists, problem.Code)
+		req.Equal("/api/v1/user", problem.Instance)
+	})
+
+	t.Run("rate limit with retry after", func(t *testing.T) {
+		c, w := testContext(http.MethodPost, "/api/v1/login")
+		SendResponse(c, nil, errorStatus.ErrTooManyRequests.WithRetry(time.Minute))
+
+		req.Equal(http.StatusTooManyRequests, w.Code)
+		req.Equal("60", w.Header().Get("Retry-After"))
+	})
+}
+
+func TestRequirePermission(t *testing.T) {
+	req := require.New(t)
+	requireWrite := RequirePermission(entity.PermProductWrite)
+
+	for _, tc := range []struct {
+		role   entity.UserRole
+		status int
+	}{
+		{entity.UserRoleGuest, http.StatusUnauthorized},
+		{entity.UserRoleUser, http.StatusForbidden},
+		{entity.UserRoleCatalogManager, http.StatusOK},
+	} {
+		c, w := testContext(http.MethodPost, "/api/v1/admin/product")
+		c.Set("user", &entity.Users{Id: 1, Role: tc.role})
+		requireWrite(c)
+		if !c.IsAborted() {
+			c.Status(http.StatusOK)
+		}
+		req.Equal(tc.status, w.Code, tc.role)
+	}
+}
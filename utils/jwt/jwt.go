@@ -0,0 +1,102 @@
+package jwt
+
+import (
+	"errors"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+var (
+	ErrMalformed  = errors.New("jwt: malformed token")
+	ErrUnknownKey = errors.New("jwt: unknown signing key")
+	ErrSignature  = errors.New("jwt: invalid signature")
+	ErrExpired    = errors.New("jwt: token is expired")
+	ErrNoExpiry   = errors.New("jwt: token has no expiry")
+	ErrNoSigning  = errors.New("jwt: the key set only verifies")
+)
+
+// Claims are validated after the signature of the token is verified
+type Claims = gojwt.Claims
+
+// RegisteredClaims are the registered claims of RFC 7519
+type RegisteredClaims = gojwt.RegisteredClaims
+
+// NumericDate is the time of a registered claim, it is encoded as unix seconds
+type NumericDate = gojwt.NumericDate
+
+// ClaimStrings is the audience claim, a single string or an array of them
+type ClaimStrings = gojwt.ClaimStrings
+
+// ParserOption changes the validation of the claims
+type ParserOption = gojwt.ParserOption
+
+// NewNumericDate returns the claim time of t
+func NewNumericDate(t time.Time) *NumericDate {
+	return gojwt.NewNumericDate(t)
+}
+
+// WithLeeway accepts the tokens expired up to the leeway ago, for the clock skew of another issuer
+func WithLeeway(leeway time.Duration) ParserOption {
+	return gojwt.WithLeeway(leeway)
+}
+
+// Sign encodes the claims into a compact token signed by the signing key of the set,
+// the key id goes to the kid header
+func (s *KeySet) Sign(claims Claims) (string, error) {
+	key := s.signing
+	if key == nil {
+		return "", ErrNoSigning
+	}
+	token := gojwt.NewWithClaims(key.method, claims)
+	if key.Id != "" {
+		token.Header["kid"] = key.Id
+	}
+	return token.SignedString(key.private)
+}
+
+// Parse verifies the token with the key of its kid header and decodes its claims.
+// The algorithm of the header must be the one of the key and the token must expire
+func (s *KeySet) Parse(token string, claims Claims, opts ...ParserOption) error {
+	opts = append([]ParserOption{
+		gojwt.WithValidMethods(s.algs),
+		gojwt.WithExpirationRequired(),
+	}, opts...)
+	_, err := gojwt.ParseWithClaims(token, claims, s.verifyingKey, opts...)
+	if err != nil {
+		return parseError(err)
+	}
+	return nil
+}
+
+// verifyingKey returns the public key or the secret of the kid header of the token
+func (s *KeySet) verifyingKey(token *gojwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, ErrSignature
+	}
+	return key.public, nil
+}
+
+// parseError maps the errors of the parser to the errors of the package
+func parseError(err error) error {
+	switch {
+	case errors.Is(err, ErrUnknownKey):
+		return ErrUnknownKey
+	case errors.Is(err, ErrSignature),
+		errors.Is(err, gojwt.ErrTokenSignatureInvalid),
+		errors.Is(err, gojwt.ErrTokenUnverifiable):
+		return ErrSignature
+	case errors.Is(err, gojwt.ErrTokenExpired):
+		return ErrExpired
+	case errors.Is(err, gojwt.ErrTokenRequiredClaimMissing):
+		return ErrNoExpiry
+	case errors.Is(err, gojwt.ErrTokenMalformed):
+		return ErrMalformed
+	}
+	return err
+}
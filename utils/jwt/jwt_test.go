@@ -0,0 +1,197 @@
+package jwt
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+// unsignedToken encodes the header and the claims with an empty signature
+func unsignedToken(t *testing.T, header map[string]interface{}, claims interface{}) string {
+	headerJson, err := json.Marshal(header)
+	require.NoError(t, err)
+	claimsJson, err := json.Marshal(claims)
+	require.NoError(t, err)
+	return encoding.EncodeToString(headerJson) + "." + encoding.EncodeToString(claimsJson) + "."
+}
+
+func TestKeySet(t *testing.T) {
+	req := require.New(t)
+
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, minRSABits)
+	req.NoError(err)
+	rsaKey, err := NewKey("rsa-1", rsaPrivate)
+	req.NoError(err)
+	edKey, err := GenerateKey("ed-1")
+	req.NoError(err)
+	keys, err := NewKeySet(rsaKey, edKey)
+	req.NoError(err)
+
+	valid := func() *RegisteredClaims {
+		return &RegisteredClaims{Subject: "7", ExpiresAt: NewNumericDate(time.Now().Add(time.Minute))}
+	}
+
+	t.Run("signed token", func(t *testing.T) {
+		token, err := keys.Sign(valid())
+		req.NoError(err)
+
+		claims := &RegisteredClaims{}
+		req.NoError(keys.Parse(token, claims))
+		req.Equal("7", claims.Subject)
+	})
+
+	t.Run("token of a verifying key", func(t *testing.T) {
+		edOnly, err := NewKeySet(edKey)
+		req.NoError(err)
+		token, err := edOnly.Sign(valid())
+		req.NoError(err)
+
+		req.NoError(keys.Parse(token, &RegisteredClaims{}))
+	})
+
+	t.Run("alg none", func(t *testing.T) {
+		for _, alg := range []string{"none", "None", "NONE"} {
+			token := unsignedToken(t, map[string]interface{}{"alg": alg, "typ": "JWT", "kid": "rsa-1"}, valid())
+
+			err := keys.Parse(token, &RegisteredClaims{})
+			req.ErrorIs(err, ErrSignature, alg)
+		}
+	})
+
+	t.Run("hs256 signed by the rsa public key", func(t *testing.T) {
+		publicDer, err := x509.MarshalPKIXPublicKey(&rsaPrivate.PublicKey)
+		req.NoError(err)
+		publicPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDer})
+
+		forged := gojwt.NewWithClaims(gojwt.SigningMethodHS256, valid())
+		forged.Header["kid"] = "rsa-1"
+		token, err := forged.SignedString(publicPem)
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrSignature)
+	})
+
+	t.Run("algorithm of another key of the set", func(t *testing.T) {
+		forged := gojwt.NewWithClaims(gojwt.SigningMethodEdDSA, valid())
+		forged.Header["kid"] = "rsa-1"
+		token, err := forged.SignedString(edKey.private)
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrSignature)
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		token, err := keys.Sign(&RegisteredClaims{ExpiresAt: NewNumericDate(time.Now().Add(-time.Minute))})
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrExpired)
+	})
+
+	t.Run("expired within the leeway", func(t *testing.T) {
+		token, err := keys.Sign(&RegisteredClaims{ExpiresAt: NewNumericDate(time.Now().Add(-time.Minute))})
+		req.NoError(err)
+
+		req.NoError(keys.Parse(token, &RegisteredClaims{}, WithLeeway(2*time.Minute)))
+	})
+
+	t.Run("token without expiry", func(t *testing.T) {
+		token, err := keys.Sign(&RegisteredClaims{Subject: "7"})
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrNoExpiry)
+	})
+
+	t.Run("tampered claims", func(t *testing.T) {
+		token, err := keys.Sign(valid())
+		req.NoError(err)
+		parts := strings.Split(token, ".")
+		admin := valid()
+		admin.Subject = "1"
+		claimsJson, err := json.Marshal(admin)
+		req.NoError(err)
+
+		err = keys.Parse(parts[0]+"."+encoding.EncodeToString(claimsJson)+"."+parts[2], &RegisteredClaims{})
+		req.ErrorIs(err, ErrSignature)
+	})
+
+	t.Run("tampered signature", func(t *testing.T) {
+		other, err := GenerateKey("ed-1")
+		req.NoError(err)
+		otherKeys, err := NewKeySet(other)
+		req.NoError(err)
+		token, err := otherKeys.Sign(valid())
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrSignature)
+	})
+
+	t.Run("unknown kid", func(t *testing.T) {
+		other, err := GenerateKey("ed-2")
+		req.NoError(err)
+		otherKeys, err := NewKeySet(other)
+		req.NoError(err)
+		token, err := otherKeys.Sign(valid())
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrUnknownKey)
+	})
+
+	t.Run("malformed token", func(t *testing.T) {
+		err := keys.Parse("not.a-token", &RegisteredClaims{})
+		req.ErrorIs(err, ErrMalformed)
+	})
+
+	t.Run("verifying set can't sign", func(t *testing.T) {
+		jwksJson, err := json.Marshal(keys.JWKS())
+		req.NoError(err)
+		public, err := ParseJWKS(jwksJson)
+		req.NoError(err)
+
+		_, err = public.Sign(valid())
+		req.ErrorIs(err, ErrNoSigning)
+
+		token, err := keys.Sign(valid())
+		req.NoError(err)
+		req.NoError(public.Parse(token, &RegisteredClaims{}))
+	})
+}
+
+func TestHMACKeySet(t *testing.T) {
+	req := require.New(t)
+	keys := NewHMACKeySet([]byte("secret"))
+
+	t.Run("signed token", func(t *testing.T) {
+		token, err := keys.Sign(&RegisteredClaims{ExpiresAt: NewNumericDate(time.Now().Add(time.Minute))})
+		req.NoError(err)
+		req.NoError(keys.Parse(token, &RegisteredClaims{}))
+	})
+
+	t.Run("token of another secret", func(t *testing.T) {
+		token, err := NewHMACKeySet([]byte("guess")).Sign(&RegisteredClaims{ExpiresAt: NewNumericDate(time.Now().Add(time.Minute))})
+		req.NoError(err)
+
+		err = keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrSignature)
+	})
+
+	t.Run("alg none", func(t *testing.T) {
+		token := unsignedToken(t, map[string]interface{}{"alg": "none", "typ": "JWT"}, &RegisteredClaims{ExpiresAt: NewNumericDate(time.Now().Add(time.Minute))})
+
+		err := keys.Parse(token, &RegisteredClaims{})
+		req.ErrorIs(err, ErrSignature)
+	})
+}
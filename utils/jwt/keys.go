@@ -0,0 +1,237 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+
+	gojwt "github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	AlgHS256 = "HS256"
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+)
+
+const minRSABits = 2048
+
+var encoding = base64.RawURLEncoding
+
+// Key signs and verifies the tokens of one algorithm, the public keys of RS256 and EdDSA
+// are published in the key set
+type Key struct {
+	Id      string
+	method  gojwt.SigningMethod
+	private interface{}
+	public  interface{}
+}
+
+// NewHMACKey returns the HS256 key of the secret, the tokens it signs are verified
+// only by the holders of the secret
+func NewHMACKey(kid string, secret []byte) *Key {
+	return &Key{Id: kid, method: gojwt.SigningMethodHS256, private: secret, public: secret}
+}
+
+// NewKey returns the RS256 key of the rsa private key or the EdDSA key of the ed25519 one
+func NewKey(kid string, private crypto.Signer) (*Key, error) {
+	switch private := private.(type) {
+	case *rsa.PrivateKey:
+		if private.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("jwt: rsa key %q is shorter than %d bits", kid, minRSABits)
+		}
+		return &Key{Id: kid, method: gojwt.SigningMethodRS256, private: private, public: &private.PublicKey}, nil
+	case ed25519.PrivateKey:
+		return &Key{Id: kid, method: gojwt.SigningMethodEdDSA, private: private, public: private.Public()}, nil
+	}
+	return nil, fmt.Errorf("jwt: key %q is neither rsa nor ed25519", kid)
+}
+
+// ParseKey reads the PEM encoded PKCS #8 or PKCS #1 private key
+func ParseKey(kid string, pemBytes []byte) (*Key, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, fmt.Errorf("jwt: key %q is not PEM encoded", kid)
+	}
+	var private interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		private, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		private, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("jwt: key %q: %w", kid, err)
+	}
+	signer, ok := private.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("jwt: key %q can't sign", kid)
+	}
+	return NewKey(kid, signer)
+}
+
+// GenerateKey returns a new EdDSA key
+func GenerateKey(kid string) (*Key, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	return NewKey(kid, private)
+}
+
+// KeySet signs the tokens with its signing key and verifies them with any of its keys,
+// a new key is added for verification before it signs so the other services learn it first
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+	ordered []*Key
+	algs    []string // the algorithms a token of the set may have
+}
+
+// NewKeySet returns the set of the signing key and the keys that only verify
+func NewKeySet(signing *Key, verifying ...*Key) (*KeySet, error) {
+	if signing == nil {
+		return nil, errors.New("jwt: the signing key is required")
+	}
+	s := &KeySet{signing: signing, keys: map[string]*Key{}}
+	for _, key := range append([]*Key{signing}, verifying...) {
+		if err := s.add(key); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// NewHMACKeySet returns the set of the single HS256 key of the secret, its tokens carry no kid
+// and are verified by the service that signs them
+func NewHMACKeySet(secret []byte) *KeySet {
+	key := NewHMACKey("", secret)
+	s := &KeySet{signing: key, keys: map[string]*Key{}}
+	_ = s.add(key)
+	return s
+}
+
+// NewVerifyingKeySet returns the set of the public keys of another issuer, it can't sign
+func NewVerifyingKeySet(verifying ...*Key) (*KeySet, error) {
+	s := &KeySet{keys: map[string]*Key{}}
+	for _, key := range verifying {
+		if err := s.add(key); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func (s *KeySet) add(key *Key) error {
+	if _, ok := s.keys[key.Id]; ok {
+		return fmt.Errorf("jwt: key id %q is used twice", key.Id)
+	}
+	s.keys[key.Id] = key
+	s.ordered = append(s.ordered, key)
+	for _, alg := range s.algs {
+		if alg == key.method.Alg() {
+			return nil
+		}
+	}
+	s.algs = append(s.algs, key.method.Alg())
+	return nil
+}
+
+// JSONWebKey is the public key of RFC 7517
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+}
+
+// JSONWebKeySet is the document of the jwks endpoint
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// JWKS returns the public keys of the set, the HMAC keys are secret and left out
+func (s *KeySet) JWKS() *JSONWebKeySet {
+	jwks := &JSONWebKeySet{Keys: []JSONWebKey{}}
+	for _, key := range s.ordered {
+		jwk := JSONWebKey{Kid: key.Id, Use: "sig", Alg: key.method.Alg()}
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = encoding.EncodeToString(public.N.Bytes())
+			jwk.E = encoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = encoding.EncodeToString(public)
+		default:
+			continue
+		}
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
+
+// PublicKey returns the verifying key of the RSA or Ed25519 public key
+func PublicKey(jwk JSONWebKey) (*Key, error) {
+	switch {
+	case jwk.Kty == "RSA" && (jwk.Alg == "" || jwk.Alg == AlgRS256):
+		n, err := encoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwt: key %q: %w", jwk.Kid, err)
+		}
+		e, err := encoding.DecodeString(jwk.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("jwt: key %q has an invalid exponent", jwk.Kid)
+		}
+		public := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+		if public.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("jwt: rsa key %q is shorter than %d bits", jwk.Kid, minRSABits)
+		}
+		return &Key{Id: jwk.Kid, method: gojwt.SigningMethodRS256, public: public}, nil
+	case jwk.Kty == "OKP" && jwk.Crv == "Ed25519":
+		x, err := encoding.DecodeString(jwk.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("jwt: key %q has an invalid ed25519 point", jwk.Kid)
+		}
+		return &Key{Id: jwk.Kid, method: gojwt.SigningMethodEdDSA, public: ed25519.PublicKey(x)}, nil
+	}
+	return nil, fmt.Errorf("jwt: key %q of type %s %s is not supported", jwk.Kid, jwk.Kty, jwk.Alg)
+}
+
+// ParseJWKS returns the verifying set of the jwks document, the keys of other uses
+// and of the algorithms not supported are skipped
+func ParseJWKS(data []byte) (*KeySet, error) {
+	var jwks JSONWebKeySet
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return nil, fmt.Errorf("jwt: jwks: %w", err)
+	}
+	keys := make([]*Key, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := PublicKey(jwk)
+		if err != nil {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwt: jwks has no supported key")
+	}
+	return NewVerifyingKeySet(keys...)
+}
@@ -0,0 +1,292 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go-store/utils/jwt"
+)
+
+var (
+	ErrIssuer   = errors.New("oidc: id token of another issuer")
+	ErrAudience = errors.New("oidc: id token of another client")
+	ErrNonce    = errors.New("oidc: id token of another authorization")
+	ErrNoToken  = errors.New("oidc: token response has no id token")
+)
+
+// the responses of the provider are small, a larger one is not read to the end
+const maxResponse = 1 << 20
+
+var encoding = base64.RawURLEncoding
+
+// Provider is an OpenID Connect provider of the authorization code flow, the endpoints
+// are discovered from the issuer unless they are set
+type Provider struct {
+	Name          string
+	Issuer        string
+	ClientID      string
+	ClientSecret  string
+	RedirectURL   string
+	Scopes        []string
+	AuthURL       string
+	TokenURL      string
+	JWKSURL       string
+	Client        *http.Client // the default client when nil
+	ClockSkew     time.Duration
+	discoverMu    sync.Mutex
+	discovered    bool
+	keysMu        sync.Mutex
+	keys          *jwt.KeySet
+	keysRefreshTs time.Time
+}
+
+// discovery is the part of the provider metadata the flow uses
+type discovery struct {
+	Issuer   string `json:"issuer"`
+	AuthURL  string `json:"authorization_endpoint"`
+	TokenURL string `json:"token_endpoint"`
+	JWKSURL  string `json:"jwks_uri"`
+}
+
+// IDToken are the claims of the verified id token, the audience is a single client id or an array of them
+type IDToken struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce"`
+	Email             string `json:"email"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name"`
+	PreferredUsername string `json:"preferred_username"`
+}
+
+// forClient tells whether the token is issued to the client
+func (t *IDToken) forClient(clientId string) bool {
+	for _, aud := range t.Audience {
+		if aud == clientId {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenResponse is the response of the token endpoint, the access token of the provider is not used
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// NewVerifier returns a random PKCE code verifier of RFC 7636
+func NewVerifier() (string, error) {
+	return random(32)
+}
+
+// NewState returns a random value for the state or the nonce of an authorization
+func NewState() (string, error) {
+	return random(24)
+}
+
+func random(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return encoding.EncodeToString(b), nil
+}
+
+// Challenge returns the S256 code challenge of the verifier
+func Challenge(verifier string) string {
+	digest := sha256.Sum256([]byte(verifier))
+	return encoding.EncodeToString(digest[:])
+}
+
+func (p *Provider) client() *http.Client {
+	if p.Client != nil {
+		return p.Client
+	}
+	return http.DefaultClient
+}
+
+// discover fills the endpoints that are not set from the metadata of the issuer,
+// a failed discovery is tried again by the next login
+func (p *Provider) discover(ctx context.Context) error {
+	p.discoverMu.Lock()
+	defer p.discoverMu.Unlock()
+	if p.discovered || (p.AuthURL != "" && p.TokenURL != "" && p.JWKSURL != "") {
+		p.discovered = true
+		return nil
+	}
+
+	var meta discovery
+	wellKnown := strings.TrimSuffix(p.Issuer, "/") + "/.well-known/openid-configuration"
+	if err := p.getJson(ctx, wellKnown, &meta); err != nil {
+		return err
+	}
+	if meta.Issuer != p.Issuer {
+		return fmt.Errorf("oidc: %s discovery: issuer %q is not %q", p.Name, meta.Issuer, p.Issuer)
+	}
+	if p.AuthURL == "" {
+		p.AuthURL = meta.AuthURL
+	}
+	if p.TokenURL == "" {
+		p.TokenURL = meta.TokenURL
+	}
+	if p.JWKSURL == "" {
+		p.JWKSURL = meta.JWKSURL
+	}
+	p.discovered = true
+	return nil
+}
+
+func (p *Provider) getJson(ctx context.Context, target string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc: %s: %s", target, resp.Status)
+	}
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponse)).Decode(v)
+}
+
+// AuthCodeURL returns the authorization url the browser is redirected to
+func (p *Provider) AuthCodeURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", p.ClientID)
+	params.Set("redirect_uri", p.RedirectURL)
+	params.Set("scope", strings.Join(p.Scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", Challenge(verifier))
+	params.Set("code_challenge_method", "S256")
+	separator := "?"
+	if strings.Contains(p.AuthURL, "?") {
+		separator = "&"
+	}
+	return p.AuthURL + separator + params.Encode(), nil
+}
+
+// Exchange redeems the authorization code with the verifier of its challenge and returns the raw id token
+func (p *Provider) Exchange(ctx context.Context, code string, verifier string) (string, error) {
+	if err := p.discover(ctx); err != nil {
+		return "", err
+	}
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", p.RedirectURL)
+	form.Set("code_verifier", verifier)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(p.ClientID), url.QueryEscape(p.ClientSecret))
+
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	var token tokenResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxResponse)).Decode(&token); err != nil {
+		return "", fmt.Errorf("oidc: %s token response: %w", p.Name, err)
+	}
+	if resp.StatusCode != http.StatusOK || token.Error != "" {
+		return "", fmt.Errorf("oidc: %s token: %s %s %s", p.Name, resp.Status, token.Error, token.ErrorDescription)
+	}
+	if token.IDToken == "" {
+		return "", ErrNoToken
+	}
+	return token.IDToken, nil
+}
+
+// VerifyIDToken verifies the signature of the id token by the jwks of the provider and checks
+// its issuer, audience and nonce. The keys are fetched again for an unknown kid, at most once a minute
+func (p *Provider) VerifyIDToken(ctx context.Context, raw string, nonce string) (*IDToken, error) {
+	if err := p.discover(ctx); err != nil {
+		return nil, err
+	}
+	keys, err := p.keySet(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	token := &IDToken{}
+	err = keys.Parse(raw, token, jwt.WithLeeway(p.ClockSkew))
+	if errors.Is(err, jwt.ErrUnknownKey) {
+		// the provider rotated its keys
+		if keys, err = p.keySet(ctx, true); err != nil {
+			return nil, err
+		}
+		err = keys.Parse(raw, token, jwt.WithLeeway(p.ClockSkew))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if token.Issuer != p.Issuer {
+		return nil, ErrIssuer
+	}
+	if !token.forClient(p.ClientID) {
+		return nil, ErrAudience
+	}
+	if token.Nonce != nonce {
+		return nil, ErrNonce
+	}
+	if token.Subject == "" {
+		return nil, jwt.ErrMalformed
+	}
+	return token, nil
+}
+
+func (p *Provider) keySet(ctx context.Context, refresh bool) (*jwt.KeySet, error) {
+	p.keysMu.Lock()
+	defer p.keysMu.Unlock()
+	if p.keys != nil && (!refresh || time.Since(p.keysRefreshTs) < time.Minute) {
+		return p.keys, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.JWKSURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := p.client().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc: %s jwks: %s", p.Name, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponse))
+	if err != nil {
+		return nil, err
+	}
+	keys, err := jwt.ParseJWKS(data)
+	if err != nil {
+		return nil, err
+	}
+	p.keys = keys
+	p.keysRefreshTs = time.Now()
+	return keys, nil
+}
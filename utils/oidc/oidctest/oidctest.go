@@ -0,0 +1,238 @@
+// Package oidctest is a local OpenID Connect provider for the tests and the development,
+// it signs in the user it is given without a login page
+package oidctest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"go-store/utils/jwt"
+	"go-store/utils/oidc"
+)
+
+// User is the account the provider signs in
+type User struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Username      string
+}
+
+// grant is an issued authorization code waiting for its exchange
+type grant struct {
+	user        User
+	redirectURL string
+	nonce       string
+	challenge   string
+}
+
+// Server is the fake provider, its issuer is the url of the test server
+type Server struct {
+	*httptest.Server
+	ClientID     string
+	ClientSecret string
+	keys         *jwt.KeySet
+	mu           sync.Mutex
+	user         User
+	grants       map[string]grant
+}
+
+type idClaims struct {
+	jwt.RegisteredClaims
+	Nonce             string `json:"nonce,omitempty"`
+	Email             string `json:"email,omitempty"`
+	EmailVerified     bool   `json:"email_verified"`
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username,omitempty"`
+}
+
+// NewServer starts the provider of the client, Close stops it
+func NewServer(clientId string, clientSecret string) (*Server, error) {
+	key, err := jwt.GenerateKey("oidctest-1")
+	if err != nil {
+		return nil, err
+	}
+	keys, err := jwt.NewKeySet(key)
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{ClientID: clientId, ClientSecret: clientSecret, keys: keys, grants: map[string]grant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", s.discovery)
+	mux.HandleFunc("/jwks", s.jwks)
+	mux.HandleFunc("/authorize", s.authorizeHttp)
+	mux.HandleFunc("/token", s.token)
+	s.Server = httptest.NewServer(mux)
+	return s, nil
+}
+
+// Provider returns the provider config of the server for the redirect url
+func (s *Server) Provider(name string, redirectURL string) *oidc.Provider {
+	return &oidc.Provider{
+		Name:         name,
+		Issuer:       s.URL,
+		ClientID:     s.ClientID,
+		ClientSecret: s.ClientSecret,
+		RedirectURL:  redirectURL,
+		Scopes:       []string{"openid", "email", "profile"},
+		Client:       s.Client(),
+	}
+}
+
+// SetUser sets the account the authorize endpoint signs in
+func (s *Server) SetUser(user User) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.user = user
+}
+
+// Authorize signs the user in at the authorization url as the browser would,
+// it returns the code and the state the provider sends to the redirect url
+func (s *Server) Authorize(authURL string, user User) (code string, state string, err error) {
+	u, err := url.Parse(authURL)
+	if err != nil {
+		return "", "", err
+	}
+	code, err = s.grant(u.Query(), user)
+	if err != nil {
+		return "", "", err
+	}
+	return code, u.Query().Get("state"), nil
+}
+
+func (s *Server) grant(query url.Values, user User) (string, error) {
+	if query.Get("client_id") != s.ClientID || query.Get("response_type") != "code" {
+		return "", errBadRequest("unauthorized_client")
+	}
+	if query.Get("code_challenge_method") != "S256" || query.Get("code_challenge") == "" {
+		return "", errBadRequest("invalid_request")
+	}
+	code, err := oidc.NewState()
+	if err != nil {
+		return "", err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.grants[code] = grant{
+		user:        user,
+		redirectURL: query.Get("redirect_uri"),
+		nonce:       query.Get("nonce"),
+		challenge:   query.Get("code_challenge"),
+	}
+	return code, nil
+}
+
+type errBadRequest string
+
+func (e errBadRequest) Error() string {
+	return string(e)
+}
+
+func (s *Server) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"issuer":                                s.URL,
+		"authorization_endpoint":                s.URL + "/authorize",
+		"token_endpoint":                        s.URL + "/token",
+		"jwks_uri":                              s.URL + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{jwt.AlgEdDSA},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+func (s *Server) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, s.keys.JWKS())
+}
+
+// authorizeHttp redirects to the redirect url with the code of the user set by SetUser
+func (s *Server) authorizeHttp(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	user := s.user
+	s.mu.Unlock()
+	code, err := s.grant(r.URL.Query(), user)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	redirect, err := url.Parse(r.URL.Query().Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	params := redirect.Query()
+	params.Set("code", code)
+	params.Set("state", r.URL.Query().Get("state"))
+	redirect.RawQuery = params.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+// token redeems a code once, for the client credentials and the verifier of its challenge
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJson(w, http.StatusMethodNotAllowed, map[string]string{"error": "invalid_request"})
+		return
+	}
+	clientId, clientSecret, ok := r.BasicAuth()
+	if !ok {
+		clientId, clientSecret = r.PostFormValue("client_id"), r.PostFormValue("client_secret")
+	} else {
+		clientId, _ = url.QueryUnescape(clientId)
+		clientSecret, _ = url.QueryUnescape(clientSecret)
+	}
+	if clientId != s.ClientID || clientSecret != s.ClientSecret {
+		writeJson(w, http.StatusUnauthorized, map[string]string{"error": "invalid_client"})
+		return
+	}
+	if r.PostFormValue("grant_type") != "authorization_code" {
+		writeJson(w, http.StatusBadRequest, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	s.mu.Lock()
+	g, ok := s.grants[r.PostFormValue("code")]
+	delete(s.grants, r.PostFormValue("code"))
+	s.mu.Unlock()
+	if !ok || g.redirectURL != r.PostFormValue("redirect_uri") || oidc.Challenge(r.PostFormValue("code_verifier")) != g.challenge {
+		writeJson(w, http.StatusBadRequest, map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	now := time.Now()
+	idToken, err := s.keys.Sign(&idClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.URL,
+			Subject:   g.user.Subject,
+			Audience:  jwt.ClaimStrings{s.ClientID},
+			ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+			IssuedAt:  jwt.NewNumericDate(now),
+		},
+		Nonce:             g.nonce,
+		Email:             g.user.Email,
+		EmailVerified:     g.user.EmailVerified,
+		Name:              g.user.Name,
+		PreferredUsername: g.user.Username,
+	})
+	if err != nil {
+		writeJson(w, http.StatusInternalServerError, map[string]string{"error": "server_error"})
+		return
+	}
+	writeJson(w, http.StatusOK, map[string]interface{}{
+		"access_token": "oidctest-" + g.user.Subject,
+		"token_type":   "Bearer",
+		"expires_in":   300,
+		"id_token":     idToken,
+	})
+}
+
+func writeJson(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
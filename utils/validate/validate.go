@@ -0,0 +1,132 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+var phoneRe = regexp.MustCompile(`^\+?[0-9]{7,15}$`)
+
+var validate = newValidator()
+
+func newValidator() *validator.Validate {
+	v := validator.New()
+	// the violations name the fields like the clients send them
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		for _, tag := range []string{"json", "form"} {
+			name := strings.SplitN(f.Tag.Get(tag), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name != "" {
+				return name
+			}
+		}
+		return f.Name
+	})
+	mustRegister(v, "state", func(fl validator.FieldLevel) bool {
+		_, err := entity.ParseState(fl.Field().String())
+		return err == nil
+	})
+	mustRegister(v, "role", func(fl validator.FieldLevel) bool {
+		_, err := entity.ParseRole(fl.Field().String())
+		return err == nil
+	})
+	mustRegister(v, "phone", func(fl validator.FieldLevel) bool {
+		return phoneRe.MatchString(fl.Field().String())
+	})
+	return v
+}
+
+func mustRegister(v *validator.Validate, tag string, fn validator.Func) {
+	if err := v.RegisterValidation(tag, fn); err != nil {
+		panic(err)
+	}
+}
+
+// Struct checks the validate tags of the struct, the failed rules are returned
+// as the field violations of ErrBadReq
+func Struct(s interface{}) error {
+	err := validate.Struct(s)
+	if err == nil {
+		return nil
+	}
+	var fieldErrs validator.ValidationErrors
+	if !errors.As(err, &fieldErrs) {
+		return errorStatus.ErrBadReq
+	}
+	violations := make([]errorStatus.FieldViolation, len(fieldErrs))
+	for idx, fe := range fieldErrs {
+		violations[idx] = errorStatus.FieldViolation{
+			Field:       fieldPath(fe),
+			Description: describe(fe),
+		}
+	}
+	return errorStatus.ErrBadReq.WithViolations(violations...)
+}
+
+// fieldPath drops the struct name from the namespace, nested fields keep their parent
+func fieldPath(fe validator.FieldError) string {
+	ns := fe.Namespace()
+	if idx := strings.Index(ns, "."); idx >= 0 {
+		return ns[idx+1:]
+	}
+	return fe.Field()
+}
+
+func describe(fe validator.FieldError) string {
+	isString := fe.Kind() == reflect.String
+	switch fe.Tag() {
+	case "required", "required_without", "required_if":
+		return "required"
+	case "email":
+		return "must be a valid email"
+	case "phone":
+		return "must be a valid phone number"
+	case "numeric":
+		return "must contain digits only"
+	case "uuid", "uuid4":
+		return "must be a valid uuid"
+	case "datetime":
+		return "must be a date like " + fe.Param()
+	case "state":
+		return fmt.Sprintf("must be one of %s, %s, %s", entity.Enabled, entity.Disabled, entity.Deleted)
+	case "role":
+		roles := make([]string, 0, len(entity.Roles)+1)
+		for _, role := range append(entity.Roles, entity.UserRoleGuest) {
+			roles = append(roles, string(role))
+		}
+		return "must be one of " + strings.Join(roles, ", ")
+	case "oneof":
+		return "must be one of " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	case "min", "gte":
+		if isString {
+			return fmt.Sprintf("must be at least %s characters", fe.Param())
+		}
+		return "must be at least " + fe.Param()
+	case "max", "lte":
+		if isString {
+			return fmt.Sprintf("must be at most %s characters", fe.Param())
+		}
+		return "must be at most " + fe.Param()
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "lt":
+		return "must be less than " + fe.Param()
+	case "len":
+		return fmt.Sprintf("must be %s characters", fe.Param())
+	case "latitude":
+		return "must be a valid latitude"
+	case "longitude":
+		return "must be a valid longitude"
+	}
+	return "failed the " + fe.Tag() + " rule"
+}
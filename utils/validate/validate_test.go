@@ -0,0 +1,95 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"go-store/internal/entity"
+	errorStatus "go-store/utils/errors"
+)
+
+type signUp struct {
+	Email   string          `json:"email" validate:"required,email"`
+	Phone   string          `json:"phone" validate:"omitempty,phone"`
+	Name    string          `form:"name" validate:"min=2,max=5"`
+	Age     int             `validate:"gte=18"`
+	Role    entity.UserRole `json:"role" validate:"omitempty,role"`
+	State   entity.State    `json:"state" validate:"omitempty,state"`
+	Size    string          `json:"size" validate:"omitempty,oneof=s m l"`
+	Address *struct {
+		City string `json:"city" validate:"required"`
+	} `json:"address"`
+	Internal string `json:"-" validate:"max=1"`
+}
+
+func violations(t *testing.T, s interface{}) []errorStatus.FieldViolation {
+	err := Struct(s)
+	require.ErrorIs(t, err, errorStatus.ErrBadReq)
+	return errorStatus.AsError(err).Violations
+}
+
+func TestStruct(t *testing.T) {
+	req := require.New(t)
+	valid := func() *signUp {
+		return &signUp{Email: "john@example.com", Name: "John", Age: 30}
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		req.NoError(Struct(valid()))
+	})
+
+	t.Run("fields are named like the clients send them", func(t *testing.T) {
+		s := valid()
+		s.Email = ""
+		s.Name = "J"
+		s.Age = 17
+		req.ElementsMatch([]errorStatus.FieldViolation{
+			{Field: "email", Description: "required"},
+			{Field: "name", Description: "must be at least 2 characters"},
+			{Field: "Age", Description: "must be at least 18"},
+		}, violations(t, s))
+	})
+
+	t.Run("custom rules", func(t *testing.T) {
+		s := valid()
+		s.Email = "john"
+		s.Phone = "call me"
+		s.Role = "root"
+		s.State = "gone"
+		s.Size = "xl"
+		got := violations(t, s)
+		req.Len(got, 5)
+		req.Contains(got, errorStatus.FieldViolation{Field: "email", Description: "must be a valid email"})
+		req.Contains(got, errorStatus.FieldViolation{Field: "phone", Description: "must be a valid phone number"})
+		req.Contains(got, errorStatus.FieldViolation{Field: "state", Description: "must be one of enabled, disabled, deleted"})
+		req.Contains(got, errorStatus.FieldViolation{Field: "size", Description: "must be one of s, m, l"})
+	})
+
+	t.Run("known role and phone", func(t *testing.T) {
+		s := valid()
+		s.Phone = "+100200300"
+		s.Role = entity.UserRoleUser
+		req.NoError(Struct(s))
+	})
+
+	t.Run("fields hidden from the clients keep their go name", func(t *testing.T) {
+		s := valid()
+		s.Internal = "long"
+		req.Equal([]errorStatus.FieldViolation{{Field: "Internal", Description: "must be at most 1 characters"}}, violations(t, s))
+	})
+
+	t.Run("nested fields keep their parent", func(t *testing.T) {
+		s := valid()
+		s.Address = &struct {
+			City string `json:"city" validate:"required"`
+		}{}
+		req.Equal([]errorStatus.FieldViolation{{Field: "address.city", Description: "required"}}, violations(t, s))
+	})
+
+	t.Run("string length", func(t *testing.T) {
+		s := valid()
+		s.Name = "Johnny"
+		req.Equal([]errorStatus.FieldViolation{{Field: "name", Description: "must be at most 5 characters"}}, violations(t, s))
+	})
+}